@@ -0,0 +1,31 @@
+package spotlike
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+)
+
+// checkLikeAlbumsUseCase is a struct that contains the use case of checking for the albums at once.
+type checkLikeAlbumsUseCase struct {
+	albumRepo albumDomain.AlbumRepository
+}
+
+// NewCheckLikeAlbumsUseCase returns a new instance of the checkLikeAlbumsUseCase struct.
+func NewCheckLikeAlbumsUseCase(albumRepo albumDomain.AlbumRepository) *checkLikeAlbumsUseCase {
+	return &checkLikeAlbumsUseCase{
+		albumRepo: albumRepo,
+	}
+}
+
+// Run returns the check results of the albums in the order of the IDs.
+func (uc *checkLikeAlbumsUseCase) Run(ctx context.Context, ids []string) ([]bool, error) {
+	spotifyIds := make([]spotify.ID, len(ids))
+	for i, id := range ids {
+		spotifyIds[i] = spotify.ID(id)
+	}
+
+	return uc.albumRepo.AreLiked(ctx, spotifyIds)
+}
@@ -0,0 +1,108 @@
+package spotlike
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCheckLikeAlbumsUseCase(t *testing.T) {
+	type args struct {
+		albumRepo albumDomain.AlbumRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *checkLikeAlbumsUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *checkLikeAlbumsUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				albumRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *checkLikeAlbumsUseCase {
+				mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+				tt.albumRepo = mockAlbumRepo
+				return &checkLikeAlbumsUseCase{
+					albumRepo: mockAlbumRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewCheckLikeAlbumsUseCase(tt.args.albumRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCheckLikeAlbumsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkLikeAlbumsUseCase_Run(t *testing.T) {
+	type fields struct {
+		albumRepo albumDomain.AlbumRepository
+	}
+	type args struct {
+		ctx context.Context
+		ids []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				albumRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []string{"test_album_id_1", "test_album_id_2"},
+			},
+			want:    []bool{true, false},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+				mockAlbumRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_album_id_1", "test_album_id_2"}).Return([]bool{true, false}, nil)
+				tt.albumRepo = mockAlbumRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &checkLikeAlbumsUseCase{
+				albumRepo: tt.fields.albumRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkLikeAlbumsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkLikeAlbumsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package spotlike
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+)
+
+// checkLikeArtistsUseCase is a struct that contains the use case of checking for the artists at once.
+type checkLikeArtistsUseCase struct {
+	artistRepo artistDomain.ArtistRepository
+}
+
+// NewCheckLikeArtistsUseCase returns a new instance of the checkLikeArtistsUseCase struct.
+func NewCheckLikeArtistsUseCase(artistRepo artistDomain.ArtistRepository) *checkLikeArtistsUseCase {
+	return &checkLikeArtistsUseCase{
+		artistRepo: artistRepo,
+	}
+}
+
+// Run returns the check results of the artists in the order of the IDs.
+func (uc *checkLikeArtistsUseCase) Run(ctx context.Context, ids []string) ([]bool, error) {
+	spotifyIds := make([]spotify.ID, len(ids))
+	for i, id := range ids {
+		spotifyIds[i] = spotify.ID(id)
+	}
+
+	return uc.artistRepo.AreLiked(ctx, spotifyIds)
+}
@@ -0,0 +1,108 @@
+package spotlike
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCheckLikeArtistsUseCase(t *testing.T) {
+	type args struct {
+		artistRepo artistDomain.ArtistRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *checkLikeArtistsUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *checkLikeArtistsUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				artistRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *checkLikeArtistsUseCase {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				tt.artistRepo = mockArtistRepo
+				return &checkLikeArtistsUseCase{
+					artistRepo: mockArtistRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewCheckLikeArtistsUseCase(tt.args.artistRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCheckLikeArtistsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkLikeArtistsUseCase_Run(t *testing.T) {
+	type fields struct {
+		artistRepo artistDomain.ArtistRepository
+	}
+	type args struct {
+		ctx context.Context
+		ids []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				artistRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []string{"test_artist_id_1", "test_artist_id_2"},
+			},
+			want:    []bool{true, false},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_artist_id_1", "test_artist_id_2"}).Return([]bool{true, false}, nil)
+				tt.artistRepo = mockArtistRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &checkLikeArtistsUseCase{
+				artistRepo: tt.fields.artistRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkLikeArtistsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkLikeArtistsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
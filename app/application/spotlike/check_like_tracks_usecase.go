@@ -0,0 +1,31 @@
+package spotlike
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+// checkLikeTracksUseCase is a struct that contains the use case of checking for the tracks at once.
+type checkLikeTracksUseCase struct {
+	trackRepo trackDomain.TrackRepository
+}
+
+// NewCheckLikeTracksUseCase returns a new instance of the checkLikeTracksUseCase struct.
+func NewCheckLikeTracksUseCase(trackRepo trackDomain.TrackRepository) *checkLikeTracksUseCase {
+	return &checkLikeTracksUseCase{
+		trackRepo: trackRepo,
+	}
+}
+
+// Run returns the check results of the tracks in the order of the IDs.
+func (uc *checkLikeTracksUseCase) Run(ctx context.Context, ids []string) ([]bool, error) {
+	spotifyIds := make([]spotify.ID, len(ids))
+	for i, id := range ids {
+		spotifyIds[i] = spotify.ID(id)
+	}
+
+	return uc.trackRepo.AreLiked(ctx, spotifyIds)
+}
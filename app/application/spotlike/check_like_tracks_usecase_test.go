@@ -0,0 +1,108 @@
+package spotlike
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCheckLikeTracksUseCase(t *testing.T) {
+	type args struct {
+		trackRepo trackDomain.TrackRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *checkLikeTracksUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *checkLikeTracksUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				trackRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *checkLikeTracksUseCase {
+				mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+				tt.trackRepo = mockTrackRepo
+				return &checkLikeTracksUseCase{
+					trackRepo: mockTrackRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewCheckLikeTracksUseCase(tt.args.trackRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCheckLikeTracksUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkLikeTracksUseCase_Run(t *testing.T) {
+	type fields struct {
+		trackRepo trackDomain.TrackRepository
+	}
+	type args struct {
+		ctx context.Context
+		ids []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				trackRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: []string{"test_track_id_1", "test_track_id_2"},
+			},
+			want:    []bool{true, false},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+				mockTrackRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_track_id_1", "test_track_id_2"}).Return([]bool{true, false}, nil)
+				tt.trackRepo = mockTrackRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &checkLikeTracksUseCase{
+				trackRepo: tt.fields.trackRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkLikeTracksUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkLikeTracksUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package spotlike
+
+const (
+	// DiagnosisStatusPass is the status of the check passed.
+	DiagnosisStatusPass = "pass"
+	// DiagnosisStatusWarn is the status of the check passed with the problem which may cause the failure of some commands.
+	DiagnosisStatusWarn = "warn"
+	// DiagnosisStatusFail is the status of the check failed.
+	DiagnosisStatusFail = "fail"
+)
+
+// DiagnosisDto is a DTO struct that contains the result of a check of the setup of spotlike.
+type DiagnosisDto struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// NewDiagnosisDto returns a new instance of the DiagnosisDto struct.
+func NewDiagnosisDto(check string, status string, detail string) *DiagnosisDto {
+	return &DiagnosisDto{
+		Check:  check,
+		Status: status,
+		Detail: detail,
+	}
+}
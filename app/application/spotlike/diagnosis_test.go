@@ -0,0 +1,40 @@
+package spotlike
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewDiagnosisDto(t *testing.T) {
+	type args struct {
+		check  string
+		status string
+		detail string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *DiagnosisDto
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				check:  "test_check",
+				status: DiagnosisStatusPass,
+				detail: "test_detail",
+			},
+			want: &DiagnosisDto{
+				Check:  "test_check",
+				Status: DiagnosisStatusPass,
+				Detail: "test_detail",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDiagnosisDto(tt.args.check, tt.args.status, tt.args.detail); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDiagnosisDto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
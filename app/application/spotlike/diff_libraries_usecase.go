@@ -0,0 +1,80 @@
+package spotlike
+
+import (
+	"time"
+)
+
+const (
+	// LibraryChangeAdded is the change of the content added to the library.
+	LibraryChangeAdded = "added"
+	// LibraryChangeRemoved is the change of the content removed from the library.
+	LibraryChangeRemoved = "removed"
+)
+
+// diffLibrariesUseCase is a struct that contains the use case of comparing two libraries of the user.
+type diffLibrariesUseCase struct{}
+
+// NewDiffLibrariesUseCase returns a new instance of the diffLibrariesUseCase struct.
+func NewDiffLibrariesUseCase() *diffLibrariesUseCase {
+	return &diffLibrariesUseCase{}
+}
+
+// DiffLibrariesUseCaseOutputDto is a DTO struct that contains the output data of the diffLibrariesUseCase.
+type DiffLibrariesUseCaseOutputDto struct {
+	Change      string    `json:"change"`
+	Type        string    `json:"type"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Artists     string    `json:"artists,omitempty"`
+	ReleaseDate time.Time `json:"release_date"`
+	LikedAt     time.Time `json:"liked_at"`
+}
+
+// Run returns the contents added to or removed from the library between the from and the to.
+// The changes are returned in the order of the artists, the albums and the tracks, and the removed ones come first for each of them.
+func (uc *diffLibrariesUseCase) Run(from *GetLibraryUseCaseOutputDto, to *GetLibraryUseCaseOutputDto) []*DiffLibrariesUseCaseOutputDto {
+	var diffLibrariesUseCaseOutputDtos []*DiffLibrariesUseCaseOutputDto
+	for _, pair := range []struct {
+		contentType string
+		from        []*LibraryItemDto
+		to          []*LibraryItemDto
+	}{
+		{contentType: "artist", from: from.Artists, to: to.Artists},
+		{contentType: "album", from: from.Albums, to: to.Albums},
+		{contentType: "track", from: from.Tracks, to: to.Tracks},
+	} {
+		diffLibrariesUseCaseOutputDtos = append(diffLibrariesUseCaseOutputDtos, missingItems(LibraryChangeRemoved, pair.contentType, pair.from, pair.to)...)
+		diffLibrariesUseCaseOutputDtos = append(diffLibrariesUseCaseOutputDtos, missingItems(LibraryChangeAdded, pair.contentType, pair.to, pair.from)...)
+	}
+
+	return diffLibrariesUseCaseOutputDtos
+}
+
+// missingItems returns the items in the items which are missing in the others as the change.
+func missingItems(change string, contentType string, items []*LibraryItemDto, others []*LibraryItemDto) []*DiffLibrariesUseCaseOutputDto {
+	found := map[string]bool{}
+	for _, other := range others {
+		found[other.ID] = true
+	}
+
+	var diffLibrariesUseCaseOutputDtos []*DiffLibrariesUseCaseOutputDto
+	for _, item := range items {
+		if found[item.ID] {
+			continue
+		}
+		diffLibrariesUseCaseOutputDtos = append(
+			diffLibrariesUseCaseOutputDtos,
+			&DiffLibrariesUseCaseOutputDto{
+				Change:      change,
+				Type:        contentType,
+				ID:          item.ID,
+				Name:        item.Name,
+				Artists:     item.Artists,
+				ReleaseDate: item.ReleaseDate,
+				LikedAt:     item.LikedAt,
+			},
+		)
+	}
+
+	return diffLibrariesUseCaseOutputDtos
+}
@@ -0,0 +1,67 @@
+package spotlike
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewDiffLibrariesUseCase(t *testing.T) {
+	if got := NewDiffLibrariesUseCase(); !reflect.DeepEqual(got, &diffLibrariesUseCase{}) {
+		t.Errorf("NewDiffLibrariesUseCase() = %v, want %v", got, &diffLibrariesUseCase{})
+	}
+}
+
+func Test_diffLibrariesUseCase_Run(t *testing.T) {
+	releaseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	likedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	artist := &LibraryItemDto{ID: "test_artist_id", Name: "test_artist_name"}
+	album := &LibraryItemDto{ID: "test_album_id", Name: "test_album_name", Artists: "test_artist_name", ReleaseDate: releaseDate, LikedAt: likedAt}
+	track1 := &LibraryItemDto{ID: "test_track_id_1", Name: "test_track_name_1", Artists: "test_artist_name", ReleaseDate: releaseDate, LikedAt: likedAt}
+	track2 := &LibraryItemDto{ID: "test_track_id_2", Name: "test_track_name_2", Artists: "test_artist_name", ReleaseDate: releaseDate, LikedAt: likedAt}
+
+	type args struct {
+		from *GetLibraryUseCaseOutputDto
+		to   *GetLibraryUseCaseOutputDto
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*DiffLibrariesUseCaseOutputDto
+	}{
+		{
+			name: "positive testing (changed)",
+			args: args{
+				from: &GetLibraryUseCaseOutputDto{
+					Artists: []*LibraryItemDto{artist},
+					Tracks:  []*LibraryItemDto{track1},
+				},
+				to: &GetLibraryUseCaseOutputDto{
+					Albums: []*LibraryItemDto{album},
+					Tracks: []*LibraryItemDto{track2, track1},
+				},
+			},
+			want: []*DiffLibrariesUseCaseOutputDto{
+				{Change: LibraryChangeRemoved, Type: "artist", ID: "test_artist_id", Name: "test_artist_name"},
+				{Change: LibraryChangeAdded, Type: "album", ID: "test_album_id", Name: "test_album_name", Artists: "test_artist_name", ReleaseDate: releaseDate, LikedAt: likedAt},
+				{Change: LibraryChangeAdded, Type: "track", ID: "test_track_id_2", Name: "test_track_name_2", Artists: "test_artist_name", ReleaseDate: releaseDate, LikedAt: likedAt},
+			},
+		},
+		{
+			name: "positive testing (not changed)",
+			args: args{
+				from: &GetLibraryUseCaseOutputDto{Tracks: []*LibraryItemDto{track1}},
+				to:   &GetLibraryUseCaseOutputDto{Tracks: []*LibraryItemDto{track1}},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewDiffLibrariesUseCase()
+			if got := uc.Run(tt.args.from, tt.args.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffLibrariesUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -24,10 +24,12 @@ func NewGetAlbumUseCase(albumRepo albumDomain.AlbumRepository) *getAlbumUseCase
 
 // GetAlbumUseCaseOutputDto is a DTO struct that contains the output data of the getAlbumUseCase.
 type GetAlbumUseCaseOutputDto struct {
-	ID          string
-	Name        string
-	Artists     string
-	ReleaseDate time.Time
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Artists     string    `json:"artists"`
+	ReleaseDate time.Time `json:"release_date"`
+	// Liked is whether the album is liked, which is set only when it is checked.
+	Liked *bool `json:"liked,omitempty"`
 }
 
 // Run returns the get result of the album.
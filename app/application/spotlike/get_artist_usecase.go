@@ -32,8 +32,8 @@ func newGetArtistUseCase(artistRepo artistDomain.ArtistRepository) *GetArtistUse
 
 // GetArtistUseCaseOutputDto is a DTO struct that contains the output data of the getArtistUseCase.
 type GetArtistUseCaseOutputDto struct {
-	ID   string
-	Name string
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // Run returns the get result of the artist.
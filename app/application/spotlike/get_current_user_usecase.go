@@ -0,0 +1,38 @@
+package spotlike
+
+import (
+	"context"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+)
+
+// getCurrentUserUseCase is a struct that contains the use case of getting the current user.
+type getCurrentUserUseCase struct {
+	userRepo userDomain.UserRepository
+}
+
+// NewGetCurrentUserUseCase returns a new instance of the GetCurrentUserUseCase struct.
+func NewGetCurrentUserUseCase(userRepo userDomain.UserRepository) *getCurrentUserUseCase {
+	return &getCurrentUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// GetCurrentUserUseCaseOutputDto is a DTO struct that contains the output data of the getCurrentUserUseCase.
+type GetCurrentUserUseCaseOutputDto struct {
+	ID   string
+	Name string
+}
+
+// Run returns the user authenticated the client.
+func (uc *getCurrentUserUseCase) Run(ctx context.Context) (*GetCurrentUserUseCaseOutputDto, error) {
+	user, err := uc.userRepo.FindCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetCurrentUserUseCaseOutputDto{
+		ID:   user.ID.String(),
+		Name: user.Name,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetCurrentUserUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockUserRepo := userDomain.NewMockUserRepository(mockCtrl)
+	want := &getCurrentUserUseCase{
+		userRepo: mockUserRepo,
+	}
+	if got := NewGetCurrentUserUseCase(mockUserRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewGetCurrentUserUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_getCurrentUserUseCase_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    *GetCurrentUserUseCaseOutputDto
+		wantErr bool
+		setup   func(mockUserRepo *userDomain.MockUserRepository)
+	}{
+		{
+			name: "positive testing",
+			want: &GetCurrentUserUseCaseOutputDto{
+				ID:   "test_user_id",
+				Name: "test_user_name",
+			},
+			wantErr: false,
+			setup: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().FindCurrent(gomock.Any()).Return(userDomain.NewUser("test_user_id", "test_user_name"), nil)
+			},
+		},
+		{
+			name:    "negative testing (uc.userRepo.FindCurrent() failed)",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockUserRepo *userDomain.MockUserRepository) {
+				mockUserRepo.EXPECT().FindCurrent(gomock.Any()).Return(nil, errors.New("failed to find the current user"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockUserRepo := userDomain.NewMockUserRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockUserRepo)
+			}
+			uc := NewGetCurrentUserUseCase(mockUserRepo)
+			got, err := uc.Run(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getCurrentUserUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getCurrentUserUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
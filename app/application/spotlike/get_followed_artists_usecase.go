@@ -0,0 +1,46 @@
+package spotlike
+
+import (
+	"context"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+)
+
+// getFollowedArtistsUseCase is a struct that contains the use case of getting the artists followed by the user.
+type getFollowedArtistsUseCase struct {
+	artistRepo artistDomain.ArtistRepository
+}
+
+// NewGetFollowedArtistsUseCase returns a new instance of the getFollowedArtistsUseCase struct.
+func NewGetFollowedArtistsUseCase(artistRepo artistDomain.ArtistRepository) *getFollowedArtistsUseCase {
+	return &getFollowedArtistsUseCase{
+		artistRepo: artistRepo,
+	}
+}
+
+// GetFollowedArtistsUseCaseOutputDto is a DTO struct that contains the output data of the getFollowedArtistsUseCase.
+type GetFollowedArtistsUseCaseOutputDto struct {
+	ID   string
+	Name string
+}
+
+// Run returns the artists followed by the user.
+func (uc *getFollowedArtistsUseCase) Run(ctx context.Context) ([]*GetFollowedArtistsUseCaseOutputDto, error) {
+	artists, err := uc.artistRepo.FindFollowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var getFollowedArtistsUseCaseOutputDtos []*GetFollowedArtistsUseCaseOutputDto
+	for _, artist := range artists {
+		getFollowedArtistsUseCaseOutputDtos = append(
+			getFollowedArtistsUseCaseOutputDtos,
+			&GetFollowedArtistsUseCaseOutputDto{
+				ID:   artist.ID.String(),
+				Name: artist.Name,
+			},
+		)
+	}
+
+	return getFollowedArtistsUseCaseOutputDtos, nil
+}
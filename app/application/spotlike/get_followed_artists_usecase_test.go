@@ -0,0 +1,152 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetFollowedArtistsUseCase(t *testing.T) {
+	type args struct {
+		artistRepo artistDomain.ArtistRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *getFollowedArtistsUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *getFollowedArtistsUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				artistRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *getFollowedArtistsUseCase {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				tt.artistRepo = mockArtistRepo
+				return &getFollowedArtistsUseCase{
+					artistRepo: mockArtistRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewGetFollowedArtistsUseCase(tt.args.artistRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGetFollowedArtistsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getFollowedArtistsUseCase_Run(t *testing.T) {
+	type fields struct {
+		artistRepo artistDomain.ArtistRepository
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*GetFollowedArtistsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				artistRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*GetFollowedArtistsUseCaseOutputDto{
+				{
+					ID:   "test_artist_id_1",
+					Name: "test_artist_name_1",
+				},
+				{
+					ID:   "test_artist_id_2",
+					Name: "test_artist_name_2",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(
+					[]*artistDomain.Artist{
+						artistDomain.NewArtist("test_artist_id_1", "test_artist_name_1"),
+						artistDomain.NewArtist("test_artist_id_2", "test_artist_name_2"),
+					},
+					nil,
+				)
+				tt.artistRepo = mockArtistRepo
+			},
+		},
+		{
+			name: "positive testing (no artists are followed)",
+			fields: fields{
+				artistRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				tt.artistRepo = mockArtistRepo
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.FindFollowed() failed)",
+			fields: fields{
+				artistRepo: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, errors.New("failed to find followed artists"))
+				tt.artistRepo = mockArtistRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getFollowedArtistsUseCase{
+				artistRepo: tt.fields.artistRepo,
+			}
+			got, err := uc.Run(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getFollowedArtistsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getFollowedArtistsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package spotlike
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+// getLibraryStatsUseCase is a struct that contains the use case of computing the statistics of the library of the user.
+type getLibraryStatsUseCase struct {
+	artistRepo artistDomain.ArtistRepository
+	albumRepo  albumDomain.AlbumRepository
+	trackRepo  trackDomain.TrackRepository
+}
+
+// NewGetLibraryStatsUseCase returns a new instance of the getLibraryStatsUseCase struct.
+func NewGetLibraryStatsUseCase(
+	artistRepo artistDomain.ArtistRepository,
+	albumRepo albumDomain.AlbumRepository,
+	trackRepo trackDomain.TrackRepository,
+) *getLibraryStatsUseCase {
+	return &getLibraryStatsUseCase{
+		artistRepo: artistRepo,
+		albumRepo:  albumRepo,
+		trackRepo:  trackRepo,
+	}
+}
+
+// GetLibraryStatsUseCaseOutputDto is a DTO struct that contains the output data of the getLibraryStatsUseCase.
+type GetLibraryStatsUseCaseOutputDto struct {
+	FollowedArtists int                   `json:"followed_artists"`
+	LikedAlbums     int                   `json:"liked_albums"`
+	LikedTracks     int                   `json:"liked_tracks"`
+	Artists         []*LibraryStatDto     `json:"artists"`
+	Years           []*LibraryStatDto     `json:"years"`
+	Decades         []*LibraryStatDto     `json:"decades"`
+	Genres          []*LibraryStatDto     `json:"genres"`
+	Months          []*LibraryStatDto     `json:"months"`
+	Coverages       []*LibraryCoverageDto `json:"coverages"`
+}
+
+// LibraryStatDto is a DTO struct that contains the numbers of the albums and the tracks liked by the user for a key.
+type LibraryStatDto struct {
+	Name   string `json:"name"`
+	Albums int    `json:"albums"`
+	Tracks int    `json:"tracks"`
+}
+
+// LibraryCoverageDto is a DTO struct that contains the number of the tracks liked by the user out of all the tracks by an artist.
+type LibraryCoverageDto struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Liked int     `json:"liked"`
+	Total int     `json:"total"`
+	Ratio float64 `json:"ratio"`
+}
+
+// libraryContent is a struct that represents an album or a track liked by the user to be counted.
+type libraryContent struct {
+	artists     []spotify.SimpleArtist
+	releaseDate time.Time
+	likedAt     time.Time
+	album       bool
+}
+
+// libraryStats is a struct that counts the albums and the tracks liked by the user for each key.
+type libraryStats struct {
+	stats map[string]*LibraryStatDto
+}
+
+// newLibraryStats returns a new instance of the libraryStats struct.
+func newLibraryStats() *libraryStats {
+	return &libraryStats{
+		stats: map[string]*LibraryStatDto{},
+	}
+}
+
+// count counts the album or the track for the key with the name.
+func (s *libraryStats) count(key string, name string, album bool) {
+	stat, ok := s.stats[key]
+	if !ok {
+		stat = &LibraryStatDto{Name: name}
+		s.stats[key] = stat
+	}
+	if album {
+		stat.Albums++
+	} else {
+		stat.Tracks++
+	}
+}
+
+// keys returns the keys of the stats.
+func (s *libraryStats) keys() []string {
+	keys := make([]string, 0, len(s.stats))
+	for key := range s.stats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// all returns all the stats in the order of the keys.
+func (s *libraryStats) all() []*LibraryStatDto {
+	stats := []*LibraryStatDto{}
+	for _, key := range s.keys() {
+		stats = append(stats, s.stats[key])
+	}
+
+	return stats
+}
+
+// ranking returns the keys of the top stats in the descending order of the numbers of the tracks and the albums.
+func (s *libraryStats) ranking(top int) []string {
+	keys := s.keys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := s.stats[keys[i]], s.stats[keys[j]]
+		if a.Tracks != b.Tracks {
+			return a.Tracks > b.Tracks
+		}
+		if a.Albums != b.Albums {
+			return a.Albums > b.Albums
+		}
+		return a.Name < b.Name
+	})
+
+	return keys[:min(top, len(keys))]
+}
+
+// top returns the top stats in the descending order of the numbers of the tracks and the albums.
+func (s *libraryStats) top(top int) []*LibraryStatDto {
+	stats := []*LibraryStatDto{}
+	for _, key := range s.ranking(top) {
+		stats = append(stats, s.stats[key])
+	}
+
+	return stats
+}
+
+// Run returns the statistics of the artists followed and the albums and the tracks liked by the user.
+// The artists and the genres are ranked and limited to the top, and the coverages are computed for the top artists.
+func (uc *getLibraryStatsUseCase) Run(ctx context.Context, top int) (*GetLibraryStatsUseCaseOutputDto, error) {
+	artists, err := uc.artistRepo.FindFollowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	albums, err := uc.albumRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := uc.trackRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genres := map[spotify.ID][]string{}
+	for _, artist := range artists {
+		genres[artist.ID] = artist.Genres
+	}
+	var contents []*libraryContent
+	for _, album := range albums {
+		contents = append(contents, &libraryContent{artists: album.Artists, releaseDate: album.ReleaseDate, likedAt: album.LikedAt, album: true})
+	}
+	for _, track := range tracks {
+		contents = append(contents, &libraryContent{artists: track.Artists, releaseDate: track.ReleaseDate, likedAt: track.LikedAt, album: false})
+	}
+	// the genres of the artists not followed are looked up one by one
+	for _, content := range contents {
+		for _, artist := range content.artists {
+			if _, ok := genres[artist.ID]; ok || artist.ID == "" {
+				continue
+			}
+			a, err := uc.artistRepo.FindById(ctx, artist.ID)
+			if err != nil {
+				return nil, err
+			}
+			genres[artist.ID] = a.Genres
+		}
+	}
+
+	artistStats := newLibraryStats()
+	yearStats := newLibraryStats()
+	decadeStats := newLibraryStats()
+	genreStats := newLibraryStats()
+	monthStats := newLibraryStats()
+	for _, content := range contents {
+		counted := map[string]bool{}
+		for _, artist := range content.artists {
+			artistStats.count(artist.ID.String(), artist.Name, content.album)
+			// the content by the artists in the same genre is counted only once for the genre
+			for _, genre := range genres[artist.ID] {
+				if !counted[genre] {
+					counted[genre] = true
+					genreStats.count(genre, genre, content.album)
+				}
+			}
+		}
+		if !content.releaseDate.IsZero() {
+			year := content.releaseDate.Year()
+			yearStats.count(fmt.Sprint(year), fmt.Sprint(year), content.album)
+			decadeStats.count(fmt.Sprintf("%ds", year/10*10), fmt.Sprintf("%ds", year/10*10), content.album)
+		}
+		if !content.likedAt.IsZero() {
+			month := content.likedAt.Format("2006-01")
+			monthStats.count(month, month, content.album)
+		}
+	}
+
+	likedTracks := map[spotify.ID]bool{}
+	for _, track := range tracks {
+		likedTracks[track.ID] = true
+	}
+	coverages := []*LibraryCoverageDto{}
+	for _, key := range artistStats.ranking(top) {
+		stat := artistStats.stats[key]
+		if stat.Tracks == 0 {
+			continue
+		}
+		artistTracks, err := uc.trackRepo.FindByArtistId(ctx, spotify.ID(key))
+		if err != nil {
+			return nil, err
+		}
+		coverage := &LibraryCoverageDto{ID: key, Name: stat.Name}
+		// only the tracks the artist contributed to are counted, and the same song released on the several albums is counted for each of them
+		found := map[spotify.ID]bool{}
+		for _, track := range artistTracks {
+			if found[track.ID] || !hasArtist(track.Artists, spotify.ID(key)) {
+				continue
+			}
+			found[track.ID] = true
+			coverage.Total++
+			if likedTracks[track.ID] {
+				coverage.Liked++
+			}
+		}
+		if coverage.Total != 0 {
+			coverage.Ratio = float64(coverage.Liked) / float64(coverage.Total)
+		}
+		coverages = append(coverages, coverage)
+	}
+
+	return &GetLibraryStatsUseCaseOutputDto{
+		FollowedArtists: len(artists),
+		LikedAlbums:     len(albums),
+		LikedTracks:     len(tracks),
+		Artists:         artistStats.top(top),
+		Years:           yearStats.all(),
+		Decades:         decadeStats.all(),
+		Genres:          genreStats.top(top),
+		Months:          monthStats.all(),
+		Coverages:       coverages,
+	}, nil
+}
+
+// hasArtist returns whether the artists contain the artist with the ID.
+func hasArtist(artists []spotify.SimpleArtist, id spotify.ID) bool {
+	for _, artist := range artists {
+		if artist.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
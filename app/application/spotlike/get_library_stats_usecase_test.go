@@ -0,0 +1,254 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetLibraryStatsUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	want := &getLibraryStatsUseCase{
+		artistRepo: mockArtistRepo,
+		albumRepo:  mockAlbumRepo,
+		trackRepo:  mockTrackRepo,
+	}
+	if got := NewGetLibraryStatsUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewGetLibraryStatsUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_getLibraryStatsUseCase_Run(t *testing.T) {
+	artist1 := spotify.SimpleArtist{ID: "test_artist_id_1", Name: "test_artist_name_1"}
+	artist2 := spotify.SimpleArtist{ID: "test_artist_id_2", Name: "test_artist_name_2"}
+	followed := &artistDomain.Artist{ID: "test_artist_id_1", Name: "test_artist_name_1", Genres: []string{"test_genre_1", "test_genre_2"}}
+	album := albumDomain.NewAlbum("test_album_id", "test_album_name", []spotify.SimpleArtist{artist1}, time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC))
+	album.LikedAt = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	track1 := trackDomain.NewTrack("test_track_id_1", "test_track_name_1", []spotify.SimpleArtist{artist1, artist2}, spotify.SimpleAlbum{}, 1, time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))
+	track1.LikedAt = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	track2 := trackDomain.NewTrack("test_track_id_2", "test_track_name_2", []spotify.SimpleArtist{artist1}, spotify.SimpleAlbum{}, 2, time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))
+	track2.LikedAt = time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	catalog := []*trackDomain.Track{
+		trackDomain.NewTrack("test_track_id_1", "test_track_name_1", []spotify.SimpleArtist{artist1, artist2}, spotify.SimpleAlbum{}, 1, time.Time{}),
+		trackDomain.NewTrack("test_track_id_2", "test_track_name_2", []spotify.SimpleArtist{artist1}, spotify.SimpleAlbum{}, 2, time.Time{}),
+		trackDomain.NewTrack("test_track_id_2", "test_track_name_2", []spotify.SimpleArtist{artist1}, spotify.SimpleAlbum{}, 2, time.Time{}),
+		trackDomain.NewTrack("test_track_id_3", "test_track_name_3", []spotify.SimpleArtist{artist1}, spotify.SimpleAlbum{}, 3, time.Time{}),
+		trackDomain.NewTrack("test_track_id_4", "test_track_name_4", []spotify.SimpleArtist{artist2}, spotify.SimpleAlbum{}, 1, time.Time{}),
+	}
+
+	type args struct {
+		top int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *GetLibraryStatsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				top: 10,
+			},
+			want: &GetLibraryStatsUseCaseOutputDto{
+				FollowedArtists: 1,
+				LikedAlbums:     1,
+				LikedTracks:     2,
+				Artists: []*LibraryStatDto{
+					{Name: "test_artist_name_1", Albums: 1, Tracks: 2},
+					{Name: "test_artist_name_2", Albums: 0, Tracks: 1},
+				},
+				Years: []*LibraryStatDto{
+					{Name: "1999", Albums: 1, Tracks: 0},
+					{Name: "2001", Albums: 0, Tracks: 2},
+				},
+				Decades: []*LibraryStatDto{
+					{Name: "1990s", Albums: 1, Tracks: 0},
+					{Name: "2000s", Albums: 0, Tracks: 2},
+				},
+				Genres: []*LibraryStatDto{
+					{Name: "test_genre_1", Albums: 1, Tracks: 2},
+					{Name: "test_genre_2", Albums: 1, Tracks: 2},
+					{Name: "test_genre_3", Albums: 0, Tracks: 1},
+				},
+				Months: []*LibraryStatDto{
+					{Name: "2020-01", Albums: 1, Tracks: 1},
+					{Name: "2020-02", Albums: 0, Tracks: 1},
+				},
+				Coverages: []*LibraryCoverageDto{
+					{ID: "test_artist_id_1", Name: "test_artist_name_1", Liked: 2, Total: 3, Ratio: 2.0 / 3.0},
+					{ID: "test_artist_id_2", Name: "test_artist_name_2", Liked: 1, Total: 2, Ratio: 0.5},
+				},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{followed}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return([]*albumDomain.Album{album}, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{track1, track2}, nil)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id_2")).Return(&artistDomain.Artist{ID: "test_artist_id_2", Name: "test_artist_name_2", Genres: []string{"test_genre_1", "test_genre_3"}}, nil)
+				mockTrackRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id_1")).Return(catalog, nil)
+				mockTrackRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id_2")).Return(catalog, nil)
+			},
+		},
+		{
+			name: "positive testing (limited to the top)",
+			args: args{
+				top: 1,
+			},
+			want: &GetLibraryStatsUseCaseOutputDto{
+				FollowedArtists: 1,
+				LikedAlbums:     1,
+				LikedTracks:     2,
+				Artists: []*LibraryStatDto{
+					{Name: "test_artist_name_1", Albums: 1, Tracks: 2},
+				},
+				Years: []*LibraryStatDto{
+					{Name: "1999", Albums: 1, Tracks: 0},
+					{Name: "2001", Albums: 0, Tracks: 2},
+				},
+				Decades: []*LibraryStatDto{
+					{Name: "1990s", Albums: 1, Tracks: 0},
+					{Name: "2000s", Albums: 0, Tracks: 2},
+				},
+				Genres: []*LibraryStatDto{
+					{Name: "test_genre_1", Albums: 1, Tracks: 2},
+				},
+				Months: []*LibraryStatDto{
+					{Name: "2020-01", Albums: 1, Tracks: 1},
+					{Name: "2020-02", Albums: 0, Tracks: 1},
+				},
+				Coverages: []*LibraryCoverageDto{
+					{ID: "test_artist_id_1", Name: "test_artist_name_1", Liked: 2, Total: 3, Ratio: 2.0 / 3.0},
+				},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{followed}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return([]*albumDomain.Album{album}, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{track1, track2}, nil)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id_2")).Return(&artistDomain.Artist{ID: "test_artist_id_2", Name: "test_artist_name_2", Genres: []string{"test_genre_1", "test_genre_3"}}, nil)
+				mockTrackRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id_1")).Return(catalog, nil)
+			},
+		},
+		{
+			name: "positive testing (empty library)",
+			args: args{
+				top: 10,
+			},
+			want: &GetLibraryStatsUseCaseOutputDto{
+				Artists:   []*LibraryStatDto{},
+				Years:     []*LibraryStatDto{},
+				Decades:   []*LibraryStatDto{},
+				Genres:    []*LibraryStatDto{},
+				Months:    []*LibraryStatDto{},
+				Coverages: []*LibraryCoverageDto{},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.FindFollowed() failed)",
+			args: args{
+				top: 10,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, errors.New("failed to find the followed artists"))
+			},
+		},
+		{
+			name: "negative testing (uc.albumRepo.FindLiked() failed)",
+			args: args{
+				top: 10,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked albums"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.FindLiked() failed)",
+			args: args{
+				top: 10,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked tracks"))
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.FindById() failed)",
+			args: args{
+				top: 10,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{track2}, nil)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id_1")).Return(nil, errors.New("failed to find the artist"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.FindByArtistId() failed)",
+			args: args{
+				top: 10,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{followed}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{track2}, nil)
+				mockTrackRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id_1")).Return(nil, errors.New("failed to find the tracks"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+			mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			}
+			uc := NewGetLibraryStatsUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			got, err := uc.Run(context.Background(), tt.args.top)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getLibraryStatsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getLibraryStatsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
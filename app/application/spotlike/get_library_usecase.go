@@ -0,0 +1,106 @@
+package spotlike
+
+import (
+	"context"
+	"time"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+// getLibraryUseCase is a struct that contains the use case of getting the library of the user.
+type getLibraryUseCase struct {
+	artistRepo artistDomain.ArtistRepository
+	albumRepo  albumDomain.AlbumRepository
+	trackRepo  trackDomain.TrackRepository
+}
+
+// NewGetLibraryUseCase returns a new instance of the getLibraryUseCase struct.
+func NewGetLibraryUseCase(
+	artistRepo artistDomain.ArtistRepository,
+	albumRepo albumDomain.AlbumRepository,
+	trackRepo trackDomain.TrackRepository,
+) *getLibraryUseCase {
+	return &getLibraryUseCase{
+		artistRepo: artistRepo,
+		albumRepo:  albumRepo,
+		trackRepo:  trackRepo,
+	}
+}
+
+// GetLibraryUseCaseOutputDto is a DTO struct that contains the output data of the getLibraryUseCase.
+// It is also the format of the snapshots of the library saved in JSON.
+type GetLibraryUseCaseOutputDto struct {
+	ExportedAt time.Time         `json:"exported_at"`
+	Artists    []*LibraryItemDto `json:"artists"`
+	Albums     []*LibraryItemDto `json:"albums"`
+	Tracks     []*LibraryItemDto `json:"tracks"`
+}
+
+// LibraryItemDto is a DTO struct that contains the data of an artist followed or an album or a track liked by the user.
+type LibraryItemDto struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Artists     string    `json:"artists,omitempty"`
+	ReleaseDate time.Time `json:"release_date"`
+	LikedAt     time.Time `json:"liked_at"`
+}
+
+// Run returns the artists followed and the albums and the tracks liked by the user.
+// The exported at is left zero to be set by the caller.
+func (uc *getLibraryUseCase) Run(ctx context.Context) (*GetLibraryUseCaseOutputDto, error) {
+	artists, err := uc.artistRepo.FindFollowed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	albums, err := uc.albumRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := uc.trackRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	getLibraryUseCaseOutputDto := &GetLibraryUseCaseOutputDto{
+		Artists: []*LibraryItemDto{},
+		Albums:  []*LibraryItemDto{},
+		Tracks:  []*LibraryItemDto{},
+	}
+	for _, artist := range artists {
+		getLibraryUseCaseOutputDto.Artists = append(
+			getLibraryUseCaseOutputDto.Artists,
+			&LibraryItemDto{
+				ID:   artist.ID.String(),
+				Name: artist.Name,
+			},
+		)
+	}
+	for _, album := range albums {
+		getLibraryUseCaseOutputDto.Albums = append(
+			getLibraryUseCaseOutputDto.Albums,
+			&LibraryItemDto{
+				ID:          album.ID.String(),
+				Name:        album.Name,
+				Artists:     joinArtistNames(album.Artists),
+				ReleaseDate: album.ReleaseDate,
+				LikedAt:     album.LikedAt,
+			},
+		)
+	}
+	for _, track := range tracks {
+		getLibraryUseCaseOutputDto.Tracks = append(
+			getLibraryUseCaseOutputDto.Tracks,
+			&LibraryItemDto{
+				ID:          track.ID.String(),
+				Name:        track.Name,
+				Artists:     joinArtistNames(track.Artists),
+				ReleaseDate: track.ReleaseDate,
+				LikedAt:     track.LikedAt,
+			},
+		)
+	}
+
+	return getLibraryUseCaseOutputDto, nil
+}
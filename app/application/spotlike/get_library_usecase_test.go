@@ -0,0 +1,153 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetLibraryUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	want := &getLibraryUseCase{
+		artistRepo: mockArtistRepo,
+		albumRepo:  mockAlbumRepo,
+		trackRepo:  mockTrackRepo,
+	}
+	if got := NewGetLibraryUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewGetLibraryUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_getLibraryUseCase_Run(t *testing.T) {
+	releaseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	likedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	artists := []spotify.SimpleArtist{
+		{
+			ID:   "test_artist_id",
+			Name: "test_artist_name",
+		},
+	}
+	album := albumDomain.NewAlbum("test_album_id", "test_album_name", artists, releaseDate)
+	album.LikedAt = likedAt
+	track := trackDomain.NewTrack("test_track_id", "test_track_name", artists, spotify.SimpleAlbum{}, 1, releaseDate)
+	track.LikedAt = likedAt
+
+	tests := []struct {
+		name    string
+		want    *GetLibraryUseCaseOutputDto
+		wantErr bool
+		setup   func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing",
+			want: &GetLibraryUseCaseOutputDto{
+				Artists: []*LibraryItemDto{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				Albums: []*LibraryItemDto{
+					{
+						ID:          "test_album_id",
+						Name:        "test_album_name",
+						Artists:     "test_artist_name",
+						ReleaseDate: releaseDate,
+						LikedAt:     likedAt,
+					},
+				},
+				Tracks: []*LibraryItemDto{
+					{
+						ID:          "test_track_id",
+						Name:        "test_track_name",
+						Artists:     "test_artist_name",
+						ReleaseDate: releaseDate,
+						LikedAt:     likedAt,
+					},
+				},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return([]*albumDomain.Album{album}, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{track}, nil)
+			},
+		},
+		{
+			name: "positive testing (empty library)",
+			want: &GetLibraryUseCaseOutputDto{
+				Artists: []*LibraryItemDto{},
+				Albums:  []*LibraryItemDto{},
+				Tracks:  []*LibraryItemDto{},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:    "negative testing (uc.artistRepo.FindFollowed() failed)",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, errors.New("failed to find the followed artists"))
+			},
+		},
+		{
+			name:    "negative testing (uc.albumRepo.FindLiked() failed)",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked albums"))
+			},
+		},
+		{
+			name:    "negative testing (uc.trackRepo.FindLiked() failed)",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked tracks"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+			mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			}
+			uc := NewGetLibraryUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			got, err := uc.Run(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getLibraryUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getLibraryUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package spotlike
+
+import (
+	"context"
+	"time"
+
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+)
+
+// getOperationsUseCase is a struct that contains the use case of getting for operations.
+type getOperationsUseCase struct {
+	operationRepo operationDomain.OperationRepository
+}
+
+// NewGetOperationsUseCase returns a new instance of the GetOperationsUseCase struct.
+func NewGetOperationsUseCase(operationRepo operationDomain.OperationRepository) *getOperationsUseCase {
+	return &getOperationsUseCase{
+		operationRepo: operationRepo,
+	}
+}
+
+// GetOperationsUseCaseOutputDto is a DTO struct that contains the output data of the getOperationsUseCase.
+type GetOperationsUseCaseOutputDto struct {
+	BatchID     string    `json:"batch_id"`
+	Timestamp   time.Time `json:"timestamp"`
+	Type        string    `json:"type"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Action      string    `json:"action"`
+	CommandLine string    `json:"command_line"`
+	Account     string    `json:"account,omitempty"`
+}
+
+// Run returns the operations from the newest one.
+// If the batch ID is not empty, only the operations in the batch are returned.
+// If the max is less than 1, all the operations are returned.
+func (uc *getOperationsUseCase) Run(ctx context.Context, batchID string, max int) ([]*GetOperationsUseCaseOutputDto, error) {
+	operations, err := uc.operationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var getOperationsUseCaseOutputDtos []*GetOperationsUseCaseOutputDto
+	for i := len(operations) - 1; i >= 0; i-- {
+		if max > 0 && len(getOperationsUseCaseOutputDtos) >= max {
+			break
+		}
+
+		operation := operations[i]
+		if batchID != "" && operation.BatchID != batchID {
+			continue
+		}
+
+		getOperationsUseCaseOutputDtos = append(
+			getOperationsUseCaseOutputDtos,
+			&GetOperationsUseCaseOutputDto{
+				BatchID:     operation.BatchID,
+				Timestamp:   operation.Timestamp,
+				Type:        operation.Type,
+				ID:          operation.ID.String(),
+				Name:        operation.Name,
+				Action:      operation.Action,
+				CommandLine: operation.CommandLine,
+				Account:     operation.Account,
+			},
+		)
+	}
+
+	return getOperationsUseCaseOutputDtos, nil
+}
@@ -0,0 +1,201 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGetOperationsUseCase(t *testing.T) {
+	type args struct {
+		operationRepo operationDomain.OperationRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *getOperationsUseCase
+		setup func(mockCtrl *gomock.Controller, tt *args) *getOperationsUseCase
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				operationRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *getOperationsUseCase {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				tt.operationRepo = mockOperationRepo
+				return &getOperationsUseCase{
+					operationRepo: mockOperationRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewGetOperationsUseCase(tt.args.operationRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewGetOperationsUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getOperationsUseCase_Run(t *testing.T) {
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	operations := []*operationDomain.Operation{
+		operationDomain.NewOperation("test_batch_id_1", timestamp, "artist", "test_artist_id", "test_artist_name", "like", "spotlike like artist test_artist_id", "test_user_id"),
+		operationDomain.NewOperation("test_batch_id_2", timestamp, "album", "test_album_id", "test_album_name", "like", "spotlike like album test_album_id", "test_user_id"),
+		operationDomain.NewOperation("test_batch_id_2", timestamp, "track", "test_track_id", "test_track_name", "unlike", "spotlike unlike track test_track_id", "test_user_id"),
+	}
+	artistDto := &GetOperationsUseCaseOutputDto{
+		BatchID:     "test_batch_id_1",
+		Timestamp:   timestamp,
+		Type:        "artist",
+		ID:          "test_artist_id",
+		Name:        "test_artist_name",
+		Action:      "like",
+		CommandLine: "spotlike like artist test_artist_id",
+		Account:     "test_user_id",
+	}
+	albumDto := &GetOperationsUseCaseOutputDto{
+		BatchID:     "test_batch_id_2",
+		Timestamp:   timestamp,
+		Type:        "album",
+		ID:          "test_album_id",
+		Name:        "test_album_name",
+		Action:      "like",
+		CommandLine: "spotlike like album test_album_id",
+		Account:     "test_user_id",
+	}
+	trackDto := &GetOperationsUseCaseOutputDto{
+		BatchID:     "test_batch_id_2",
+		Timestamp:   timestamp,
+		Type:        "track",
+		ID:          "test_track_id",
+		Name:        "test_track_name",
+		Action:      "unlike",
+		CommandLine: "spotlike unlike track test_track_id",
+		Account:     "test_user_id",
+	}
+
+	type fields struct {
+		operationRepo operationDomain.OperationRepository
+	}
+	type args struct {
+		ctx     context.Context
+		batchID string
+		max     int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*GetOperationsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing (all operations)",
+			fields: fields{
+				operationRepo: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				batchID: "",
+				max:     0,
+			},
+			want:    []*GetOperationsUseCaseOutputDto{trackDto, albumDto, artistDto},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().FindAll(gomock.Any()).Return(operations, nil)
+				tt.operationRepo = mockOperationRepo
+			},
+		},
+		{
+			name: "positive testing (max is set)",
+			fields: fields{
+				operationRepo: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				batchID: "",
+				max:     1,
+			},
+			want:    []*GetOperationsUseCaseOutputDto{trackDto},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().FindAll(gomock.Any()).Return(operations, nil)
+				tt.operationRepo = mockOperationRepo
+			},
+		},
+		{
+			name: "positive testing (batch id is set)",
+			fields: fields{
+				operationRepo: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				batchID: "test_batch_id_1",
+				max:     0,
+			},
+			want:    []*GetOperationsUseCaseOutputDto{artistDto},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().FindAll(gomock.Any()).Return(operations, nil)
+				tt.operationRepo = mockOperationRepo
+			},
+		},
+		{
+			name: "negative testing (uc.operationRepo.FindAll() failed)",
+			fields: fields{
+				operationRepo: nil,
+			},
+			args: args{
+				ctx:     context.Background(),
+				batchID: "",
+				max:     0,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("failed to find operations"))
+				tt.operationRepo = mockOperationRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &getOperationsUseCase{
+				operationRepo: tt.fields.operationRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.batchID, tt.args.max)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getOperationsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getOperationsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -24,12 +24,14 @@ func NewGetTrackUseCase(trackRepo trackDomain.TrackRepository) *getTrackUseCase
 
 // GetTrackUseCaseOutputDto is a DTO struct that contains the output data of the getTrackUseCase.
 type GetTrackUseCaseOutputDto struct {
-	ID          string
-	Name        string
-	Artists     string
-	Album       string
-	TrackNumber spotify.Numeric
-	ReleaseDate time.Time
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Artists     string          `json:"artists"`
+	Album       string          `json:"album"`
+	TrackNumber spotify.Numeric `json:"track_number"`
+	ReleaseDate time.Time       `json:"release_date"`
+	// Liked is whether the track is liked, which is set only when it is checked.
+	Liked *bool `json:"liked,omitempty"`
 }
 
 // Run returns the get result of the track.
@@ -0,0 +1,37 @@
+package spotlike
+
+const (
+	// LikeStateLiked is the state of the album or the track liked.
+	LikeStateLiked = "liked"
+	// LikeStateNotLiked is the state of the album or the track not liked.
+	LikeStateNotLiked = "not_liked"
+	// LikeStateFollowed is the state of the artist followed.
+	LikeStateFollowed = "followed"
+	// LikeStateNotFollowed is the state of the artist not followed.
+	LikeStateNotFollowed = "not_followed"
+	// LikeStateNotFound is the state of the content not found on Spotify.
+	LikeStateNotFound = "not_found"
+)
+
+// LikeStateDto is a DTO struct that contains whether a content is liked or followed by the user.
+type LikeStateDto struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// NewLikeStateDto returns a new instance of the LikeStateDto struct.
+func NewLikeStateDto(contentType string, id string, name string, state string) *LikeStateDto {
+	return &LikeStateDto{
+		Type:  contentType,
+		ID:    id,
+		Name:  name,
+		State: state,
+	}
+}
+
+// IsLiked returns whether the content is liked or followed.
+func (dto *LikeStateDto) IsLiked() bool {
+	return dto.State == LikeStateLiked || dto.State == LikeStateFollowed
+}
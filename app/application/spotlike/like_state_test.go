@@ -0,0 +1,90 @@
+package spotlike
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewLikeStateDto(t *testing.T) {
+	type args struct {
+		contentType string
+		id          string
+		name        string
+		state       string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *LikeStateDto
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				contentType: "track",
+				id:          "test_track_id",
+				name:        "test_track_name",
+				state:       LikeStateLiked,
+			},
+			want: &LikeStateDto{
+				Type:  "track",
+				ID:    "test_track_id",
+				Name:  "test_track_name",
+				State: LikeStateLiked,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewLikeStateDto(
+				tt.args.contentType,
+				tt.args.id,
+				tt.args.name,
+				tt.args.state,
+			); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewLikeStateDto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLikeStateDto_IsLiked(t *testing.T) {
+	tests := []struct {
+		name  string
+		state string
+		want  bool
+	}{
+		{
+			name:  "positive testing (liked)",
+			state: LikeStateLiked,
+			want:  true,
+		},
+		{
+			name:  "positive testing (followed)",
+			state: LikeStateFollowed,
+			want:  true,
+		},
+		{
+			name:  "positive testing (not liked)",
+			state: LikeStateNotLiked,
+			want:  false,
+		},
+		{
+			name:  "positive testing (not followed)",
+			state: LikeStateNotFollowed,
+			want:  false,
+		},
+		{
+			name:  "positive testing (not found)",
+			state: LikeStateNotFound,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dto := &LikeStateDto{State: tt.state}
+			if got := dto.IsLiked(); got != tt.want {
+				t.Errorf("LikeStateDto.IsLiked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package spotlike
+
+const (
+	// OperationResultStatusLiked is the status of the content liked.
+	OperationResultStatusLiked = "liked"
+	// OperationResultStatusUnliked is the status of the content unliked.
+	OperationResultStatusUnliked = "unliked"
+	// OperationResultStatusPlanned is the status of the content which would be liked or unliked in the dry run.
+	OperationResultStatusPlanned = "planned"
+	// OperationResultStatusSkipped is the status of the content skipped such as it is already liked.
+	OperationResultStatusSkipped = "skipped"
+	// OperationResultStatusNotFound is the status of the content not found on Spotify.
+	OperationResultStatusNotFound = "not_found"
+	// OperationResultStatusCanceled is the status of the content canceled by the user.
+	OperationResultStatusCanceled = "canceled"
+	// OperationResultStatusFailed is the status of the content failed to be liked or unliked.
+	OperationResultStatusFailed = "failed"
+)
+
+// OperationResultDto is a DTO struct that contains the result of liking or unliking a content.
+type OperationResultDto struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewOperationResultDto returns a new instance of the OperationResultDto struct.
+func NewOperationResultDto(contentType string, id string, name string, action string, status string, err error) *OperationResultDto {
+	dto := &OperationResultDto{
+		Type:   contentType,
+		ID:     id,
+		Name:   name,
+		Action: action,
+		Status: status,
+	}
+	if err != nil {
+		dto.Error = err.Error()
+	}
+
+	return dto
+}
@@ -0,0 +1,76 @@
+package spotlike
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewOperationResultDto(t *testing.T) {
+	type args struct {
+		contentType string
+		id          string
+		name        string
+		action      string
+		status      string
+		err         error
+	}
+	tests := []struct {
+		name string
+		args args
+		want *OperationResultDto
+	}{
+		{
+			name: "positive testing (without error)",
+			args: args{
+				contentType: "track",
+				id:          "test_track_id",
+				name:        "test_track_name",
+				action:      "like",
+				status:      OperationResultStatusLiked,
+				err:         nil,
+			},
+			want: &OperationResultDto{
+				Type:   "track",
+				ID:     "test_track_id",
+				Name:   "test_track_name",
+				Action: "like",
+				Status: OperationResultStatusLiked,
+				Error:  "",
+			},
+		},
+		{
+			name: "positive testing (with error)",
+			args: args{
+				contentType: "track",
+				id:          "test_track_id",
+				name:        "test_track_name",
+				action:      "like",
+				status:      OperationResultStatusFailed,
+				err:         errors.New("test error"),
+			},
+			want: &OperationResultDto{
+				Type:   "track",
+				ID:     "test_track_id",
+				Name:   "test_track_name",
+				Action: "like",
+				Status: OperationResultStatusFailed,
+				Error:  "test error",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewOperationResultDto(
+				tt.args.contentType,
+				tt.args.id,
+				tt.args.name,
+				tt.args.action,
+				tt.args.status,
+				tt.args.err,
+			); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewOperationResultDto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package spotlike
+
+import (
+	"context"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+)
+
+// planNeverRulesUseCase is a struct that contains the use case of planning the contents to be unliked by the never patterns of the rules.
+type planNeverRulesUseCase struct {
+	ruleRepo  ruleDomain.RuleRepository
+	albumRepo albumDomain.AlbumRepository
+	trackRepo trackDomain.TrackRepository
+}
+
+// NewPlanNeverRulesUseCase returns a new instance of the planNeverRulesUseCase struct.
+func NewPlanNeverRulesUseCase(
+	ruleRepo ruleDomain.RuleRepository,
+	albumRepo albumDomain.AlbumRepository,
+	trackRepo trackDomain.TrackRepository,
+) *planNeverRulesUseCase {
+	return &planNeverRulesUseCase{
+		ruleRepo:  ruleRepo,
+		albumRepo: albumRepo,
+		trackRepo: trackRepo,
+	}
+}
+
+const (
+	// neverRuleName is the name of the rule in the plan to unlike the contents never to be liked.
+	neverRuleName = "never"
+)
+
+// Run returns the albums and the tracks liked in the library whose names match the never patterns of the rule set in this order.
+// The library is not fetched if the rule set has no never patterns.
+func (uc *planNeverRulesUseCase) Run(ctx context.Context) ([]*PlanRulesUseCaseOutputDto, error) {
+	ruleSet, err := uc.ruleRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ruleSet.Never) == 0 {
+		return nil, nil
+	}
+
+	var planRulesUseCaseOutputDtos []*PlanRulesUseCaseOutputDto
+	albums, err := uc.albumRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, album := range albums {
+		if !ruleSet.Forbids(album.Name) {
+			continue
+		}
+		planRulesUseCaseOutputDtos = append(
+			planRulesUseCaseOutputDtos,
+			&PlanRulesUseCaseOutputDto{
+				Rule:        neverRuleName,
+				Type:        "album",
+				ID:          album.ID.String(),
+				Name:        album.Name,
+				Artists:     joinArtistNames(album.Artists),
+				ReleaseDate: album.ReleaseDate,
+				Liked:       true,
+			},
+		)
+	}
+
+	tracks, err := uc.trackRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, track := range tracks {
+		if !ruleSet.Forbids(track.Name) {
+			continue
+		}
+		planRulesUseCaseOutputDtos = append(
+			planRulesUseCaseOutputDtos,
+			&PlanRulesUseCaseOutputDto{
+				Rule:        neverRuleName,
+				Type:        "track",
+				ID:          track.ID.String(),
+				Name:        track.Name,
+				Artists:     joinArtistNames(track.Artists),
+				ReleaseDate: track.ReleaseDate,
+				Liked:       true,
+			},
+		)
+	}
+
+	return planRulesUseCaseOutputDtos, nil
+}
@@ -0,0 +1,165 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewPlanNeverRulesUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRuleRepo := ruleDomain.NewMockRuleRepository(mockCtrl)
+	mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	want := &planNeverRulesUseCase{
+		ruleRepo:  mockRuleRepo,
+		albumRepo: mockAlbumRepo,
+		trackRepo: mockTrackRepo,
+	}
+	if got := NewPlanNeverRulesUseCase(mockRuleRepo, mockAlbumRepo, mockTrackRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewPlanNeverRulesUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_planNeverRulesUseCase_Run(t *testing.T) {
+	releaseDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	artists := []spotify.SimpleArtist{
+		{
+			ID:   "test_artist_id",
+			Name: "test_artist_name",
+		},
+	}
+	ruleSet := ruleDomain.NewRuleSet(nil, []*regexp.Regexp{regexp.MustCompile("(?i)karaoke")}, nil)
+	albums := []*albumDomain.Album{
+		albumDomain.NewAlbum("test_album_id", "test_album_name", artists, releaseDate),
+		albumDomain.NewAlbum("test_karaoke_album_id", "test_album_name (Karaoke)", artists, releaseDate),
+	}
+	tracks := []*trackDomain.Track{
+		trackDomain.NewTrack("test_karaoke_track_id", "test_track_name (Karaoke Version)", artists, spotify.SimpleAlbum{}, 1, releaseDate),
+		trackDomain.NewTrack("test_track_id", "test_track_name", artists, spotify.SimpleAlbum{}, 2, releaseDate),
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*PlanRulesUseCaseOutputDto
+		wantErr bool
+		setup   func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*PlanRulesUseCaseOutputDto{
+				{
+					Rule:        "never",
+					Type:        "album",
+					ID:          "test_karaoke_album_id",
+					Name:        "test_album_name (Karaoke)",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate,
+					Liked:       true,
+				},
+				{
+					Rule:        "never",
+					Type:        "track",
+					ID:          "test_karaoke_track_id",
+					Name:        "test_track_name (Karaoke Version)",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate,
+					Liked:       true,
+				},
+			},
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleSet, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(albums, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(tracks, nil)
+			},
+		},
+		{
+			name: "positive testing (no never patterns)",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, nil), nil)
+			},
+		},
+		{
+			name: "negative testing (uc.ruleRepo.Find() failed)",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("failed to find the rules"))
+			},
+		},
+		{
+			name: "negative testing (uc.albumRepo.FindLiked() failed)",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleSet, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked albums"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.FindLiked() failed)",
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleSet, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(albums, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked tracks"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRuleRepo := ruleDomain.NewMockRuleRepository(mockCtrl)
+			mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockRuleRepo, mockAlbumRepo, mockTrackRepo)
+			}
+			uc := NewPlanNeverRulesUseCase(mockRuleRepo, mockAlbumRepo, mockTrackRepo)
+			got, err := uc.Run(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("planNeverRulesUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planNeverRulesUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package spotlike
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+// planPurgeArtistsUseCase is a struct that contains the use case of planning the contents to be unliked to purge the artists from the library.
+type planPurgeArtistsUseCase struct {
+	artistRepo artistDomain.ArtistRepository
+	albumRepo  albumDomain.AlbumRepository
+	trackRepo  trackDomain.TrackRepository
+}
+
+// NewPlanPurgeArtistsUseCase returns a new instance of the planPurgeArtistsUseCase struct.
+func NewPlanPurgeArtistsUseCase(
+	artistRepo artistDomain.ArtistRepository,
+	albumRepo albumDomain.AlbumRepository,
+	trackRepo trackDomain.TrackRepository,
+) *planPurgeArtistsUseCase {
+	return &planPurgeArtistsUseCase{
+		artistRepo: artistRepo,
+		albumRepo:  albumRepo,
+		trackRepo:  trackRepo,
+	}
+}
+
+// PlanPurgeArtistsUseCaseOutputDto is a DTO struct that contains the output data of the planPurgeArtistsUseCase.
+type PlanPurgeArtistsUseCaseOutputDto struct {
+	Type    string
+	ID      string
+	Name    string
+	Artists string
+}
+
+// Run returns the artists followed, and the albums and the tracks liked in the library by any of the artists in this order.
+// The albums and the tracks are found from the library, so the tracks on the albums by the other artists (e.g. compilations) are also returned.
+func (uc *planPurgeArtistsUseCase) Run(ctx context.Context, artists []*GetArtistUseCaseOutputDto) ([]*PlanPurgeArtistsUseCaseOutputDto, error) {
+	purged := map[spotify.ID]bool{}
+	var purgedArtists []*GetArtistUseCaseOutputDto
+	var ids []spotify.ID
+	for _, artist := range artists {
+		if purged[spotify.ID(artist.ID)] {
+			continue
+		}
+		purged[spotify.ID(artist.ID)] = true
+		purgedArtists = append(purgedArtists, artist)
+		ids = append(ids, spotify.ID(artist.ID))
+	}
+	followed, err := uc.artistRepo.AreLiked(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var planPurgeArtistsUseCaseOutputDtos []*PlanPurgeArtistsUseCaseOutputDto
+	for i, artist := range purgedArtists {
+		if !followed[i] {
+			continue
+		}
+		planPurgeArtistsUseCaseOutputDtos = append(
+			planPurgeArtistsUseCaseOutputDtos,
+			&PlanPurgeArtistsUseCaseOutputDto{
+				Type:    "artist",
+				ID:      artist.ID,
+				Name:    artist.Name,
+				Artists: artist.Name,
+			},
+		)
+	}
+
+	albums, err := uc.albumRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, album := range albums {
+		if !hasAnyArtist(album.Artists, purged) {
+			continue
+		}
+		planPurgeArtistsUseCaseOutputDtos = append(
+			planPurgeArtistsUseCaseOutputDtos,
+			&PlanPurgeArtistsUseCaseOutputDto{
+				Type:    "album",
+				ID:      album.ID.String(),
+				Name:    album.Name,
+				Artists: joinArtistNames(album.Artists),
+			},
+		)
+	}
+
+	tracks, err := uc.trackRepo.FindLiked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, track := range tracks {
+		if !hasAnyArtist(track.Artists, purged) {
+			continue
+		}
+		planPurgeArtistsUseCaseOutputDtos = append(
+			planPurgeArtistsUseCaseOutputDtos,
+			&PlanPurgeArtistsUseCaseOutputDto{
+				Type:    "track",
+				ID:      track.ID.String(),
+				Name:    track.Name,
+				Artists: joinArtistNames(track.Artists),
+			},
+		)
+	}
+
+	return planPurgeArtistsUseCaseOutputDtos, nil
+}
+
+// hasAnyArtist returns whether the artists contain any of the artists with the IDs.
+func hasAnyArtist(artists []spotify.SimpleArtist, ids map[spotify.ID]bool) bool {
+	for _, artist := range artists {
+		if ids[artist.ID] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,201 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewPlanPurgeArtistsUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	want := &planPurgeArtistsUseCase{
+		artistRepo: mockArtistRepo,
+		albumRepo:  mockAlbumRepo,
+		trackRepo:  mockTrackRepo,
+	}
+	if got := NewPlanPurgeArtistsUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewPlanPurgeArtistsUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_planPurgeArtistsUseCase_Run(t *testing.T) {
+	releaseDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	artist := spotify.SimpleArtist{
+		ID:   "test_artist_id",
+		Name: "test_artist_name",
+	}
+	otherArtist := spotify.SimpleArtist{
+		ID:   "test_other_artist_id",
+		Name: "test_other_artist_name",
+	}
+	albums := []*albumDomain.Album{
+		albumDomain.NewAlbum("test_album_id", "test_album_name", []spotify.SimpleArtist{artist}, releaseDate),
+		albumDomain.NewAlbum("test_compilation_id", "test_compilation_name", []spotify.SimpleArtist{otherArtist}, releaseDate),
+	}
+	tracks := []*trackDomain.Track{
+		trackDomain.NewTrack("test_track_id", "test_track_name", []spotify.SimpleArtist{otherArtist, artist}, spotify.SimpleAlbum{}, 1, releaseDate),
+		trackDomain.NewTrack("test_other_track_id", "test_other_track_name", []spotify.SimpleArtist{otherArtist}, spotify.SimpleAlbum{}, 2, releaseDate),
+	}
+
+	type args struct {
+		ctx     context.Context
+		artists []*GetArtistUseCaseOutputDto
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*PlanPurgeArtistsUseCaseOutputDto
+		wantErr bool
+		setup   func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				ctx: context.Background(),
+				artists: []*GetArtistUseCaseOutputDto{
+					{ID: "test_artist_id", Name: "test_artist_name"},
+					{ID: "test_artist_id", Name: "test_artist_name"},
+				},
+			},
+			want: []*PlanPurgeArtistsUseCaseOutputDto{
+				{Type: "artist", ID: "test_artist_id", Name: "test_artist_name", Artists: "test_artist_name"},
+				{Type: "album", ID: "test_album_id", Name: "test_album_name", Artists: "test_artist_name"},
+				{Type: "track", ID: "test_track_id", Name: "test_track_name", Artists: "test_other_artist_name, test_artist_name"},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_artist_id"}).Return([]bool{true}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(albums, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(tracks, nil)
+			},
+		},
+		{
+			name: "positive testing (the artist is not followed)",
+			args: args{
+				ctx: context.Background(),
+				artists: []*GetArtistUseCaseOutputDto{
+					{ID: "test_artist_id", Name: "test_artist_name"},
+				},
+			},
+			want: []*PlanPurgeArtistsUseCaseOutputDto{
+				{Type: "track", ID: "test_track_id", Name: "test_track_name", Artists: "test_other_artist_name, test_artist_name"},
+			},
+			wantErr: false,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_artist_id"}).Return([]bool{false}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(tracks, nil)
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.AreLiked() failed)",
+			args: args{
+				ctx: context.Background(),
+				artists: []*GetArtistUseCaseOutputDto{
+					{ID: "test_artist_id", Name: "test_artist_name"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to check the artists"))
+			},
+		},
+		{
+			name: "negative testing (uc.albumRepo.FindLiked() failed)",
+			args: args{
+				ctx: context.Background(),
+				artists: []*GetArtistUseCaseOutputDto{
+					{ID: "test_artist_id", Name: "test_artist_name"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), gomock.Any()).Return([]bool{true}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked albums"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.FindLiked() failed)",
+			args: args{
+				ctx: context.Background(),
+				artists: []*GetArtistUseCaseOutputDto{
+					{ID: "test_artist_id", Name: "test_artist_name"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockArtistRepo.EXPECT().AreLiked(gomock.Any(), gomock.Any()).Return([]bool{true}, nil)
+				mockAlbumRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, nil)
+				mockTrackRepo.EXPECT().FindLiked(gomock.Any()).Return(nil, errors.New("failed to find the liked tracks"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+			mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			}
+			uc := NewPlanPurgeArtistsUseCase(mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			got, err := uc.Run(tt.args.ctx, tt.args.artists)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("planPurgeArtistsUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planPurgeArtistsUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_hasAnyArtist(t *testing.T) {
+	artists := []spotify.SimpleArtist{
+		{ID: "test_artist_id_1"},
+		{ID: "test_artist_id_2"},
+	}
+	tests := []struct {
+		name string
+		ids  map[spotify.ID]bool
+		want bool
+	}{
+		{
+			name: "positive testing (contained)",
+			ids:  map[spotify.ID]bool{"test_artist_id_2": true},
+			want: true,
+		},
+		{
+			name: "positive testing (not contained)",
+			ids:  map[spotify.ID]bool{"test_artist_id_3": true},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasAnyArtist(artists, tt.ids); got != tt.want {
+				t.Errorf("hasAnyArtist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
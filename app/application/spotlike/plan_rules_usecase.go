@@ -0,0 +1,187 @@
+package spotlike
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+)
+
+// planRulesUseCase is a struct that contains the use case of planning the contents to be liked by the rules.
+type planRulesUseCase struct {
+	ruleRepo   ruleDomain.RuleRepository
+	artistRepo artistDomain.ArtistRepository
+	albumRepo  albumDomain.AlbumRepository
+	trackRepo  trackDomain.TrackRepository
+}
+
+// NewPlanRulesUseCase returns a new instance of the planRulesUseCase struct.
+func NewPlanRulesUseCase(
+	ruleRepo ruleDomain.RuleRepository,
+	artistRepo artistDomain.ArtistRepository,
+	albumRepo albumDomain.AlbumRepository,
+	trackRepo trackDomain.TrackRepository,
+) *planRulesUseCase {
+	return &planRulesUseCase{
+		ruleRepo:   ruleRepo,
+		artistRepo: artistRepo,
+		albumRepo:  albumRepo,
+		trackRepo:  trackRepo,
+	}
+}
+
+const (
+	// followRuleName is the name of the rule in the plan to follow the artists listed to be followed.
+	followRuleName = "follow"
+)
+
+// PlanRulesUseCaseOutputDto is a DTO struct that contains the output data of the planRulesUseCase.
+type PlanRulesUseCaseOutputDto struct {
+	Rule        string
+	Type        string
+	ID          string
+	Name        string
+	Artists     string
+	ReleaseDate time.Time
+	Liked       bool
+}
+
+// Run returns the artists to be followed and the albums or the tracks matched by the rules with whether they are already liked.
+// The albums released before the since are not matched, and the content matched by multiple rules is returned once for the first rule.
+// The albums and the tracks never to be liked by the rule set are not returned.
+func (uc *planRulesUseCase) Run(ctx context.Context, since time.Time) ([]*PlanRulesUseCaseOutputDto, error) {
+	ruleSet, err := uc.ruleRepo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := map[string]bool{}
+	var planRulesUseCaseOutputDtos []*PlanRulesUseCaseOutputDto
+	for _, artistId := range ruleSet.Follow {
+		if planned["artist:"+artistId.String()] {
+			continue
+		}
+		planned["artist:"+artistId.String()] = true
+		artist, err := uc.artistRepo.FindById(ctx, artistId)
+		if err != nil {
+			return nil, err
+		}
+		liked, err := uc.artistRepo.IsLiked(ctx, artistId)
+		if err != nil {
+			return nil, err
+		}
+		planRulesUseCaseOutputDtos = append(
+			planRulesUseCaseOutputDtos,
+			&PlanRulesUseCaseOutputDto{
+				Rule:    followRuleName,
+				Type:    "artist",
+				ID:      artist.ID.String(),
+				Name:    artist.Name,
+				Artists: artist.Name,
+				Liked:   liked,
+			},
+		)
+	}
+
+	var followed []spotify.ID
+	followedFound := false
+	// the albums are shared by the rules on the same artist in the same groups
+	albumsByArtist := map[string][]*albumDomain.Album{}
+	for _, rule := range ruleSet.Rules {
+		artistIds := append([]spotify.ID{}, rule.Artists...)
+		if rule.Followed {
+			if !followedFound {
+				artists, err := uc.artistRepo.FindFollowed(ctx)
+				if err != nil {
+					return nil, err
+				}
+				for _, artist := range artists {
+					followed = append(followed, artist.ID)
+				}
+				followedFound = true
+			}
+			artistIds = append(artistIds, followed...)
+		}
+
+		for _, artistId := range artistIds {
+			key := artistId.String() + ":" + strings.Join(rule.IncludeGroups, ",")
+			albums, ok := albumsByArtist[key]
+			if !ok {
+				albums, err = uc.albumRepo.FindByArtistId(ctx, artistId, rule.IncludeGroups...)
+				if err != nil {
+					return nil, err
+				}
+				sort.SliceStable(albums, func(i, j int) bool {
+					return albums[i].ReleaseDate.Before(albums[j].ReleaseDate)
+				})
+				albumsByArtist[key] = albums
+			}
+
+			for _, album := range albums {
+				if !rule.MatchAlbum(album.Name, album.Group, album.ReleaseDate, since) {
+					continue
+				}
+
+				if rule.Like == ruleDomain.LikeAlbums {
+					if ruleSet.Forbids(album.Name) || planned["album:"+album.ID.String()] {
+						continue
+					}
+					planned["album:"+album.ID.String()] = true
+					liked, err := uc.albumRepo.IsLiked(ctx, album.ID)
+					if err != nil {
+						return nil, err
+					}
+					planRulesUseCaseOutputDtos = append(
+						planRulesUseCaseOutputDtos,
+						&PlanRulesUseCaseOutputDto{
+							Rule:        rule.Name,
+							Type:        "album",
+							ID:          album.ID.String(),
+							Name:        album.Name,
+							Artists:     joinArtistNames(album.Artists),
+							ReleaseDate: album.ReleaseDate,
+							Liked:       liked,
+						},
+					)
+					continue
+				}
+
+				tracks, err := uc.trackRepo.FindByAlbumId(ctx, album.ID)
+				if err != nil {
+					return nil, err
+				}
+				for _, track := range tracks {
+					if !rule.MatchTrack(track.Name) || ruleSet.Forbids(track.Name) || planned["track:"+track.ID.String()] {
+						continue
+					}
+					planned["track:"+track.ID.String()] = true
+					liked, err := uc.trackRepo.IsLiked(ctx, track.ID)
+					if err != nil {
+						return nil, err
+					}
+					planRulesUseCaseOutputDtos = append(
+						planRulesUseCaseOutputDtos,
+						&PlanRulesUseCaseOutputDto{
+							Rule:        rule.Name,
+							Type:        "track",
+							ID:          track.ID.String(),
+							Name:        track.Name,
+							Artists:     joinArtistNames(track.Artists),
+							ReleaseDate: album.ReleaseDate,
+							Liked:       liked,
+						},
+					)
+				}
+			}
+		}
+	}
+
+	return planRulesUseCaseOutputDtos, nil
+}
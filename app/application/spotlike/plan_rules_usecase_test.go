@@ -0,0 +1,400 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewPlanRulesUseCase(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRuleRepo := ruleDomain.NewMockRuleRepository(mockCtrl)
+	mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	want := &planRulesUseCase{
+		ruleRepo:   mockRuleRepo,
+		artistRepo: mockArtistRepo,
+		albumRepo:  mockAlbumRepo,
+		trackRepo:  mockTrackRepo,
+	}
+	if got := NewPlanRulesUseCase(mockRuleRepo, mockArtistRepo, mockAlbumRepo, mockTrackRepo); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewPlanRulesUseCase() = %v, want %v", got, want)
+	}
+}
+
+func Test_planRulesUseCase_Run(t *testing.T) {
+	releaseDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	artists := []spotify.SimpleArtist{
+		{
+			ID:   "test_artist_id",
+			Name: "test_artist_name",
+		},
+	}
+	newAlbum := func(id spotify.ID, name string, group string, releaseDate time.Time) *albumDomain.Album {
+		album := albumDomain.NewAlbum(id, name, artists, releaseDate)
+		album.Group = group
+		return album
+	}
+	albumsRule := ruleDomain.NewRule(
+		"test_albums_rule",
+		[]spotify.ID{"test_artist_id"},
+		false,
+		[]string{"album"},
+		time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		[]*regexp.Regexp{regexp.MustCompile("(?i)live")},
+		ruleDomain.LikeAlbums,
+	)
+	tracksRule := ruleDomain.NewRule(
+		"test_tracks_rule",
+		nil,
+		true,
+		nil,
+		time.Time{},
+		[]*regexp.Regexp{regexp.MustCompile("(?i)karaoke")},
+		ruleDomain.LikeTracks,
+	)
+	expectAlbums := func(mockAlbumRepo *albumDomain.MockAlbumRepository, groups ...any) {
+		mockAlbumRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id"), groups...).Return(
+			[]*albumDomain.Album{
+				newAlbum("test_album_id_2", "test_album_name_2", "album", releaseDate.AddDate(1, 0, 0)),
+				newAlbum("test_album_id_1", "test_album_name_1", "album", releaseDate),
+				newAlbum("test_live_album_id", "test_album_name (Live)", "album", releaseDate),
+				newAlbum("test_single_id", "test_single_name", "single", releaseDate),
+				newAlbum("test_old_album_id", "test_old_album_name", "album", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			nil,
+		)
+	}
+	allAlbumsRule := ruleDomain.NewRule(
+		"test_all_albums_rule",
+		[]spotify.ID{"test_artist_id"},
+		false,
+		nil,
+		time.Time{},
+		nil,
+		ruleDomain.LikeAlbums,
+	)
+	// more albums than the 20 items on the first page of the albums of the artist
+	var allAlbums []*albumDomain.Album
+	var allAlbumsPlanned []*PlanRulesUseCaseOutputDto
+	for i := range 25 {
+		id := spotify.ID("test_album_id_" + strconv.Itoa(i+1))
+		name := "test_album_name_" + strconv.Itoa(i+1)
+		allAlbums = append(allAlbums, newAlbum(id, name, "album", releaseDate.AddDate(0, 0, i)))
+		allAlbumsPlanned = append(allAlbumsPlanned, &PlanRulesUseCaseOutputDto{
+			Rule:        "test_all_albums_rule",
+			Type:        "album",
+			ID:          id.String(),
+			Name:        name,
+			Artists:     "test_artist_name",
+			ReleaseDate: releaseDate.AddDate(0, 0, i),
+			Liked:       false,
+		})
+	}
+
+	type args struct {
+		ctx   context.Context
+		since time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*PlanRulesUseCaseOutputDto
+		wantErr bool
+		setup   func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing (like albums)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want: []*PlanRulesUseCaseOutputDto{
+				{
+					Rule:        "test_albums_rule",
+					Type:        "album",
+					ID:          "test_album_id_1",
+					Name:        "test_album_name_1",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate,
+					Liked:       true,
+				},
+				{
+					Rule:        "test_albums_rule",
+					Type:        "album",
+					ID:          "test_album_id_2",
+					Name:        "test_album_name_2",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate.AddDate(1, 0, 0),
+					Liked:       false,
+				},
+			},
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{albumsRule, albumsRule}), nil)
+				expectAlbums(mockAlbumRepo, "album")
+				mockAlbumRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id_1")).Return(true, nil)
+				mockAlbumRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id_2")).Return(false, nil)
+			},
+		},
+		{
+			name: "positive testing (like albums more than a page)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    allAlbumsPlanned,
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{allAlbumsRule}), nil)
+				mockAlbumRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id")).Return(allAlbums, nil)
+				mockAlbumRepo.EXPECT().IsLiked(gomock.Any(), gomock.Any()).Return(false, nil).Times(len(allAlbums))
+			},
+		},
+		{
+			name: "positive testing (like tracks of the followed artists since the date)",
+			args: args{
+				ctx:   context.Background(),
+				since: releaseDate.AddDate(0, 6, 0),
+			},
+			want: []*PlanRulesUseCaseOutputDto{
+				{
+					Rule:        "test_tracks_rule",
+					Type:        "track",
+					ID:          "test_track_id",
+					Name:        "test_track_name",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate.AddDate(1, 0, 0),
+					Liked:       false,
+				},
+			},
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{tracksRule}), nil)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil)
+				expectAlbums(mockAlbumRepo)
+				mockTrackRepo.EXPECT().FindByAlbumId(gomock.Any(), spotify.ID("test_album_id_2")).Return(
+					[]*trackDomain.Track{
+						trackDomain.NewTrack("test_track_id", "test_track_name", artists, spotify.SimpleAlbum{}, 1, releaseDate.AddDate(1, 0, 0)),
+						trackDomain.NewTrack("test_karaoke_track_id", "test_track_name (Karaoke)", artists, spotify.SimpleAlbum{}, 2, releaseDate.AddDate(1, 0, 0)),
+					},
+					nil,
+				)
+				mockTrackRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_track_id")).Return(false, nil)
+			},
+		},
+		{
+			name: "positive testing (follow the artists and never like the albums)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want: []*PlanRulesUseCaseOutputDto{
+				{
+					Rule:    "follow",
+					Type:    "artist",
+					ID:      "test_artist_id",
+					Name:    "test_artist_name",
+					Artists: "test_artist_name",
+					Liked:   false,
+				},
+				{
+					Rule:        "test_albums_rule",
+					Type:        "album",
+					ID:          "test_album_id_1",
+					Name:        "test_album_name_1",
+					Artists:     "test_artist_name",
+					ReleaseDate: releaseDate,
+					Liked:       false,
+				},
+			},
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(
+					ruleDomain.NewRuleSet(
+						[]spotify.ID{"test_artist_id", "test_artist_id"},
+						[]*regexp.Regexp{regexp.MustCompile("_2$")},
+						[]*ruleDomain.Rule{albumsRule},
+					),
+					nil,
+				)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id")).Return(artistDomain.NewArtist("test_artist_id", "test_artist_name"), nil)
+				mockArtistRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_artist_id")).Return(false, nil)
+				expectAlbums(mockAlbumRepo, "album")
+				mockAlbumRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id_1")).Return(false, nil)
+			},
+		},
+		{
+			name: "positive testing (never like the tracks)",
+			args: args{
+				ctx:   context.Background(),
+				since: releaseDate.AddDate(0, 6, 0),
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, []*regexp.Regexp{regexp.MustCompile("^test_track_name$")}, []*ruleDomain.Rule{tracksRule}), nil)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil)
+				expectAlbums(mockAlbumRepo)
+				mockTrackRepo.EXPECT().FindByAlbumId(gomock.Any(), spotify.ID("test_album_id_2")).Return(
+					[]*trackDomain.Track{
+						trackDomain.NewTrack("test_track_id", "test_track_name", artists, spotify.SimpleAlbum{}, 1, releaseDate.AddDate(1, 0, 0)),
+					},
+					nil,
+				)
+			},
+		},
+		{
+			name: "negative testing (uc.ruleRepo.Find() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(nil, errors.New("failed to find the rules"))
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.FindById() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet([]spotify.ID{"test_artist_id"}, nil, nil), nil)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id")).Return(nil, errors.New("failed to find the artist"))
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.IsLiked() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet([]spotify.ID{"test_artist_id"}, nil, nil), nil)
+				mockArtistRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id")).Return(artistDomain.NewArtist("test_artist_id", "test_artist_name"), nil)
+				mockArtistRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_artist_id")).Return(false, errors.New("failed to check the artist"))
+			},
+		},
+		{
+			name: "negative testing (uc.artistRepo.FindFollowed() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{tracksRule}), nil)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return(nil, errors.New("failed to find the followed artists"))
+			},
+		},
+		{
+			name: "negative testing (uc.albumRepo.FindByArtistId() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{albumsRule}), nil)
+				mockAlbumRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id"), "album").Return(nil, errors.New("failed to find the albums"))
+			},
+		},
+		{
+			name: "negative testing (uc.albumRepo.IsLiked() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{albumsRule}), nil)
+				expectAlbums(mockAlbumRepo, "album")
+				mockAlbumRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id_1")).Return(false, errors.New("failed to check the album"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.FindByAlbumId() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: time.Time{},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{tracksRule}), nil)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil)
+				expectAlbums(mockAlbumRepo)
+				mockTrackRepo.EXPECT().FindByAlbumId(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to find the tracks"))
+			},
+		},
+		{
+			name: "negative testing (uc.trackRepo.IsLiked() failed)",
+			args: args{
+				ctx:   context.Background(),
+				since: releaseDate.AddDate(0, 6, 0),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRuleRepo *ruleDomain.MockRuleRepository, mockArtistRepo *artistDomain.MockArtistRepository, mockAlbumRepo *albumDomain.MockAlbumRepository, mockTrackRepo *trackDomain.MockTrackRepository) {
+				mockRuleRepo.EXPECT().Find(gomock.Any()).Return(ruleDomain.NewRuleSet(nil, nil, []*ruleDomain.Rule{tracksRule}), nil)
+				mockArtistRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil)
+				expectAlbums(mockAlbumRepo)
+				mockTrackRepo.EXPECT().FindByAlbumId(gomock.Any(), spotify.ID("test_album_id_2")).Return(
+					[]*trackDomain.Track{
+						trackDomain.NewTrack("test_track_id", "test_track_name", artists, spotify.SimpleAlbum{}, 1, releaseDate.AddDate(1, 0, 0)),
+					},
+					nil,
+				)
+				mockTrackRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_track_id")).Return(false, errors.New("failed to check the track"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRuleRepo := ruleDomain.NewMockRuleRepository(mockCtrl)
+			mockArtistRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+			mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			mockTrackRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			if tt.setup != nil {
+				tt.setup(mockRuleRepo, mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			}
+			uc := NewPlanRulesUseCase(mockRuleRepo, mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+			got, err := uc.Run(tt.args.ctx, tt.args.since)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("planRulesUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planRulesUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package spotlike
+
+import (
+	"context"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+)
+
+// recordOperationUseCase is a struct that contains the use case of recording for an operation.
+type recordOperationUseCase struct {
+	operationRepo operationDomain.OperationRepository
+	userRepo      userDomain.UserRepository
+	batchID       string
+}
+
+// NewRecordOperationUseCase returns a new instance of the RecordOperationUseCase struct.
+// All the operations recorded by the same instance belong to the same batch.
+func NewRecordOperationUseCase(operationRepo operationDomain.OperationRepository, userRepo userDomain.UserRepository) *recordOperationUseCase {
+	return &recordOperationUseCase{
+		operationRepo: operationRepo,
+		userRepo:      userRepo,
+		batchID:       operationDomain.NewBatchID(time.Now()),
+	}
+}
+
+// RecordOperationUseCaseInputDto is a DTO struct that contains the input data of the recordOperationUseCase.
+type RecordOperationUseCaseInputDto struct {
+	Type        string
+	ID          string
+	Name        string
+	Action      string
+	CommandLine string
+}
+
+// Run records the operation with the account of the current user.
+func (uc *recordOperationUseCase) Run(ctx context.Context, dto *RecordOperationUseCaseInputDto) error {
+	user, err := uc.userRepo.FindCurrent(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.operationRepo.Save(
+		ctx,
+		operationDomain.NewOperation(
+			uc.batchID,
+			time.Now(),
+			dto.Type,
+			spotify.ID(dto.ID),
+			dto.Name,
+			dto.Action,
+			dto.CommandLine,
+			user.ID.String(),
+		),
+	)
+}
@@ -0,0 +1,183 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewRecordOperationUseCase(t *testing.T) {
+	type args struct {
+		operationRepo operationDomain.OperationRepository
+		userRepo      userDomain.UserRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		setup func(mockCtrl *gomock.Controller, tt *args)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				operationRepo: nil,
+				userRepo:      nil,
+			},
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				tt.operationRepo = operationDomain.NewMockOperationRepository(mockCtrl)
+				tt.userRepo = userDomain.NewMockUserRepository(mockCtrl)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.args)
+			}
+			got := NewRecordOperationUseCase(tt.args.operationRepo, tt.args.userRepo)
+			if got.operationRepo != tt.args.operationRepo {
+				t.Errorf("NewRecordOperationUseCase().operationRepo = %v, want %v", got.operationRepo, tt.args.operationRepo)
+			}
+			if got.userRepo != tt.args.userRepo {
+				t.Errorf("NewRecordOperationUseCase().userRepo = %v, want %v", got.userRepo, tt.args.userRepo)
+			}
+			if got.batchID == "" {
+				t.Errorf("NewRecordOperationUseCase().batchID is empty")
+			}
+		})
+	}
+}
+
+func Test_recordOperationUseCase_Run(t *testing.T) {
+	type fields struct {
+		operationRepo operationDomain.OperationRepository
+		userRepo      userDomain.UserRepository
+	}
+	type args struct {
+		ctx context.Context
+		dto *RecordOperationUseCaseInputDto
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				operationRepo: nil,
+				userRepo:      nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				dto: &RecordOperationUseCaseInputDto{
+					Type:        "track",
+					ID:          "test_track_id",
+					Name:        "test_track_name",
+					Action:      "like",
+					CommandLine: "spotlike like track test_track_id",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, operation *operationDomain.Operation) error {
+						if operation.BatchID != "test_batch_id" ||
+							operation.Type != "track" ||
+							operation.ID != spotify.ID("test_track_id") ||
+							operation.Name != "test_track_name" ||
+							operation.Action != "like" ||
+							operation.CommandLine != "spotlike like track test_track_id" ||
+							operation.Account != "test_user_id" ||
+							operation.Timestamp.IsZero() {
+							t.Errorf("unexpected operation = %v", operation)
+						}
+						return nil
+					},
+				)
+				tt.operationRepo = mockOperationRepo
+				mockUserRepo := userDomain.NewMockUserRepository(mockCtrl)
+				mockUserRepo.EXPECT().FindCurrent(gomock.Any()).Return(userDomain.NewUser("test_user_id", "test_user_name"), nil)
+				tt.userRepo = mockUserRepo
+			},
+		},
+		{
+			name: "negative testing (uc.userRepo.FindCurrent() failed)",
+			fields: fields{
+				operationRepo: nil,
+				userRepo:      nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				dto: &RecordOperationUseCaseInputDto{
+					Type:        "track",
+					ID:          "test_track_id",
+					Name:        "test_track_name",
+					Action:      "like",
+					CommandLine: "spotlike like track test_track_id",
+				},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				tt.operationRepo = operationDomain.NewMockOperationRepository(mockCtrl)
+				mockUserRepo := userDomain.NewMockUserRepository(mockCtrl)
+				mockUserRepo.EXPECT().FindCurrent(gomock.Any()).Return(nil, errors.New("failed to find the current user"))
+				tt.userRepo = mockUserRepo
+			},
+		},
+		{
+			name: "negative testing (uc.operationRepo.Save() failed)",
+			fields: fields{
+				operationRepo: nil,
+				userRepo:      nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				dto: &RecordOperationUseCaseInputDto{
+					Type:        "track",
+					ID:          "test_track_id",
+					Name:        "test_track_name",
+					Action:      "like",
+					CommandLine: "spotlike like track test_track_id",
+				},
+			},
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockOperationRepo := operationDomain.NewMockOperationRepository(mockCtrl)
+				mockOperationRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("failed to save operation"))
+				tt.operationRepo = mockOperationRepo
+				mockUserRepo := userDomain.NewMockUserRepository(mockCtrl)
+				mockUserRepo.EXPECT().FindCurrent(gomock.Any()).Return(userDomain.NewUser("test_user_id", "test_user_name"), nil)
+				tt.userRepo = mockUserRepo
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &recordOperationUseCase{
+				operationRepo: tt.fields.operationRepo,
+				userRepo:      tt.fields.userRepo,
+				batchID:       "test_batch_id",
+			}
+			if err := uc.Run(tt.args.ctx, tt.args.dto); (err != nil) != tt.wantErr {
+				t.Errorf("recordOperationUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
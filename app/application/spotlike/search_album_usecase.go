@@ -32,10 +32,12 @@ func newSearchAlbumUseCase(albumRepo albumDomain.AlbumRepository) *SearchAlbumUs
 
 // SearchAlbumUseCaseOutputDto is a DTO struct that contains the output data of the SearchAlbumUseCase.
 type SearchAlbumUseCaseOutputDto struct {
-	ID          string
-	Artists     string
-	Name        string
-	ReleaseDate time.Time
+	ID          string    `json:"id"`
+	Artists     string    `json:"artists"`
+	Name        string    `json:"name"`
+	ReleaseDate time.Time `json:"release_date"`
+	// Liked is whether the album is liked, which is set only when it is checked.
+	Liked *bool `json:"liked,omitempty"`
 }
 
 // Run returns the search result of the album.
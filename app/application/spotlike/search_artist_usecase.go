@@ -31,8 +31,10 @@ func newSearchArtistUseCase(artistRepo artistDomain.ArtistRepository) *SearchArt
 
 // SearchArtistUseCaseOutputDto is a DTO struct that contains the output data of the SearchArtistUseCase.
 type SearchArtistUseCaseOutputDto struct {
-	ID   string
-	Name string
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Liked is whether the artist is followed, which is set only when it is checked.
+	Liked *bool `json:"liked,omitempty"`
 }
 
 // Run returns the search result of the artist.
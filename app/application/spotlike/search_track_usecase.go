@@ -34,12 +34,14 @@ func newSearchTrackUseCase(trackRepo trackDomain.TrackRepository) *SearchTrackUs
 
 // SearchTrackUseCaseOutputDto is a DTO struct that contains the output data of the SearchTrackUseCase.
 type SearchTrackUseCaseOutputDto struct {
-	ID          string
-	Artists     string
-	Album       string
-	Name        string
-	TrackNumber spotify.Numeric
-	ReleaseDate time.Time
+	ID          string          `json:"id"`
+	Artists     string          `json:"artists"`
+	Album       string          `json:"album"`
+	Name        string          `json:"name"`
+	TrackNumber spotify.Numeric `json:"track_number"`
+	ReleaseDate time.Time       `json:"release_date"`
+	// Liked is whether the track is liked, which is set only when it is checked.
+	Liked *bool `json:"liked,omitempty"`
 }
 
 // Run returns the search result of the track.
@@ -0,0 +1,97 @@
+package spotlike
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zmb3/spotify/v2"
+
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+)
+
+// SearchUseCase is a struct that contains the use case of searching for multiple types of contents at once.
+type SearchUseCase interface {
+	Run(ctx context.Context, keywords []string, searchType spotify.SearchType, max int) (*SearchUseCaseOutputDto, error)
+}
+
+// SearchUseCaseStruct is a struct that contains the use case of searching for multiple types of contents at once.
+type SearchUseCaseStruct struct {
+	searchRepo searchDomain.SearchRepository
+}
+
+var (
+	// NewSearchUseCase is a function that returns a new instance of the SearchUseCase struct.
+	NewSearchUseCase = newSearchUseCase
+)
+
+// newSearchUseCase returns a new instance of the SearchUseCase struct.
+func newSearchUseCase(searchRepo searchDomain.SearchRepository) *SearchUseCaseStruct {
+	return &SearchUseCaseStruct{
+		searchRepo: searchRepo,
+	}
+}
+
+// SearchUseCaseOutputDto is a DTO struct that contains the output data of the SearchUseCase.
+// The sections of the types not searched are nil and omitted in json, and the sections of the types searched are empty if nothing is found.
+type SearchUseCaseOutputDto struct {
+	Artists []*SearchArtistUseCaseOutputDto `json:"artists,omitzero"`
+	Albums  []*SearchAlbumUseCaseOutputDto  `json:"albums,omitzero"`
+	Tracks  []*SearchTrackUseCaseOutputDto  `json:"tracks,omitzero"`
+}
+
+// Run returns the search result of the artists, albums, and tracks grouped by the type.
+func (uc *SearchUseCaseStruct) Run(ctx context.Context, keywords []string, searchType spotify.SearchType, max int) (*SearchUseCaseOutputDto, error) {
+	query := strings.Join(keywords, " ")
+
+	result, err := uc.searchRepo.FindByNameLimit(ctx, query, searchType, max)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResultDto := &SearchUseCaseOutputDto{}
+	if searchType&spotify.SearchTypeArtist != 0 {
+		searchResultDto.Artists = []*SearchArtistUseCaseOutputDto{}
+	}
+	if searchType&spotify.SearchTypeAlbum != 0 {
+		searchResultDto.Albums = []*SearchAlbumUseCaseOutputDto{}
+	}
+	if searchType&spotify.SearchTypeTrack != 0 {
+		searchResultDto.Tracks = []*SearchTrackUseCaseOutputDto{}
+	}
+	for _, artist := range result.Artists {
+		searchResultDto.Artists = append(searchResultDto.Artists, &SearchArtistUseCaseOutputDto{
+			ID:   artist.ID.String(),
+			Name: artist.Name,
+		})
+	}
+	for _, album := range result.Albums {
+		searchResultDto.Albums = append(searchResultDto.Albums, &SearchAlbumUseCaseOutputDto{
+			ID:          album.ID.String(),
+			Artists:     joinArtistNames(album.Artists),
+			Name:        album.Name,
+			ReleaseDate: album.ReleaseDate,
+		})
+	}
+	for _, track := range result.Tracks {
+		searchResultDto.Tracks = append(searchResultDto.Tracks, &SearchTrackUseCaseOutputDto{
+			ID:          track.ID.String(),
+			Artists:     joinArtistNames(track.Artists),
+			Album:       track.Album.Name,
+			Name:        track.Name,
+			TrackNumber: track.TrackNumber,
+			ReleaseDate: track.Album.ReleaseDateTime(),
+		})
+	}
+
+	return searchResultDto, nil
+}
+
+// joinArtistNames returns the names of the artists joined with a comma.
+func joinArtistNames(artists []spotify.SimpleArtist) string {
+	artistNames := make([]string, len(artists))
+	for i, artist := range artists {
+		artistNames[i] = artist.Name
+	}
+
+	return strings.Join(artistNames, ", ")
+}
@@ -0,0 +1,224 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewSearchUseCase(t *testing.T) {
+	type args struct {
+		searchRepo searchDomain.SearchRepository
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  *SearchUseCaseStruct
+		setup func(mockCtrl *gomock.Controller, tt *args) *SearchUseCaseStruct
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				searchRepo: nil,
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *args) *SearchUseCaseStruct {
+				mockSearchRepo := searchDomain.NewMockSearchRepository(mockCtrl)
+				tt.searchRepo = mockSearchRepo
+				return &SearchUseCaseStruct{
+					searchRepo: mockSearchRepo,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.args)
+			}
+			if got := NewSearchUseCase(tt.args.searchRepo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSearchUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_searchUseCase_Run(t *testing.T) {
+	type fields struct {
+		searchRepo searchDomain.SearchRepository
+	}
+	type args struct {
+		ctx        context.Context
+		keywords   []string
+		searchType spotify.SearchType
+		max        int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *SearchUseCaseOutputDto
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields) *SearchUseCaseOutputDto
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				searchRepo: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				keywords:   []string{"test", "keyword"},
+				searchType: spotify.SearchTypeArtist | spotify.SearchTypeAlbum | spotify.SearchTypeTrack,
+				max:        5,
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) *SearchUseCaseOutputDto {
+				mockSearchRepo := searchDomain.NewMockSearchRepository(mockCtrl)
+				artists := []spotify.SimpleArtist{
+					{Name: "test_artist_name1"},
+					{Name: "test_artist_name2"},
+				}
+				album := spotify.SimpleAlbum{
+					ID:                   "test_album_id",
+					Name:                 "test_album_name",
+					Artists:              artists,
+					ReleaseDate:          "2000-01-01",
+					ReleaseDatePrecision: "day",
+				}
+				mockSearchRepo.EXPECT().FindByNameLimit(
+					gomock.Any(),
+					"test keyword",
+					spotify.SearchTypeArtist|spotify.SearchTypeAlbum|spotify.SearchTypeTrack,
+					5,
+				).Return(
+					searchDomain.NewSearchResult(
+						[]*artistDomain.Artist{
+							artistDomain.NewArtist("test_artist_id", "test_artist_name1"),
+						},
+						[]*albumDomain.Album{
+							albumDomain.NewAlbum("test_album_id", "test_album_name", artists, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+						[]*trackDomain.Track{
+							trackDomain.NewTrack("test_track_id", "test_track_name", artists, album, 1, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+					),
+					nil,
+				)
+				tt.searchRepo = mockSearchRepo
+				return &SearchUseCaseOutputDto{
+					Artists: []*SearchArtistUseCaseOutputDto{
+						{
+							ID:   "test_artist_id",
+							Name: "test_artist_name1",
+						},
+					},
+					Albums: []*SearchAlbumUseCaseOutputDto{
+						{
+							ID:          "test_album_id",
+							Artists:     "test_artist_name1, test_artist_name2",
+							Name:        "test_album_name",
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					Tracks: []*SearchTrackUseCaseOutputDto{
+						{
+							ID:          "test_track_id",
+							Artists:     "test_artist_name1, test_artist_name2",
+							Album:       "test_album_name",
+							Name:        "test_track_name",
+							TrackNumber: 1,
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "positive testing (nothing found)",
+			fields: fields{
+				searchRepo: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				keywords:   []string{"test", "keyword"},
+				searchType: spotify.SearchTypeArtist,
+				max:        5,
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) *SearchUseCaseOutputDto {
+				mockSearchRepo := searchDomain.NewMockSearchRepository(mockCtrl)
+				mockSearchRepo.EXPECT().FindByNameLimit(
+					gomock.Any(),
+					"test keyword",
+					spotify.SearchType(spotify.SearchTypeArtist),
+					5,
+				).Return(searchDomain.NewSearchResult(nil, nil, nil), nil)
+				tt.searchRepo = mockSearchRepo
+				return &SearchUseCaseOutputDto{
+					Artists: []*SearchArtistUseCaseOutputDto{},
+				}
+			},
+		},
+		{
+			name: "negative testing (uc.searchRepo.FindByNameLimit() failed)",
+			fields: fields{
+				searchRepo: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				keywords:   []string{"test", "keyword"},
+				searchType: spotify.SearchTypeArtist | spotify.SearchTypeAlbum,
+				max:        5,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) *SearchUseCaseOutputDto {
+				mockSearchRepo := searchDomain.NewMockSearchRepository(mockCtrl)
+				mockSearchRepo.EXPECT().FindByNameLimit(
+					gomock.Any(),
+					"test keyword",
+					spotify.SearchTypeArtist|spotify.SearchTypeAlbum,
+					5,
+				).Return(nil, errors.New("failed to search"))
+				tt.searchRepo = mockSearchRepo
+				return nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.fields)
+			}
+			uc := &SearchUseCaseStruct{
+				searchRepo: tt.fields.searchRepo,
+			}
+			got, err := uc.Run(tt.args.ctx, tt.args.keywords, tt.args.searchType, tt.args.max)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("searchUseCase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchUseCase.Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
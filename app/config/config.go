@@ -24,6 +24,14 @@ type SpotlikeConfig struct {
 	SpotifyRedirectUri string
 	// SpotifyRefreshToken is the Spotify refresh token.
 	SpotifyRefreshToken string
+	// SpotifyApiBaseUrl is the base URL of the Spotify Web API.
+	SpotifyApiBaseUrl string
+	// SpotifyAccountsBaseUrl is the base URL of the Spotify accounts service.
+	SpotifyAccountsBaseUrl string
+	// DataDir is the directory to store the data of spotlike.
+	DataDir string
+	// CacheDir is the directory to store the cache of spotlike.
+	CacheDir string
 }
 
 // NewConfigurator creates a new Configurator.
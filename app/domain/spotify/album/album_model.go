@@ -16,6 +16,12 @@ type Album struct {
 	Artists []spotify.SimpleArtist
 	// ReleaseDate is the release date of the album.
 	ReleaseDate time.Time
+	// Group is the relation of the album to the artist (e.g. "album", "single", "compilation", "appears_on").
+	// It is set only for the albums found by the artist.
+	Group string
+	// LikedAt is the time when the album is saved to the library of the user.
+	// It is set only for the albums found in the library.
+	LikedAt time.Time
 }
 
 // NewAlbum returns a new instance of Album struct.
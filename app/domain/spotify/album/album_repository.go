@@ -8,9 +8,11 @@ import (
 
 // AlbumRepository is an interface that provides the repository for the album on Spotify.
 type AlbumRepository interface {
-	FindByArtistId(ctx context.Context, id spotify.ID) ([]*Album, error)
+	FindByArtistId(ctx context.Context, id spotify.ID, groups ...string) ([]*Album, error)
 	FindById(ctx context.Context, id spotify.ID) (*Album, error)
 	FindByNameLimit(ctx context.Context, name string, limit int) ([]*Album, error)
+	FindLiked(ctx context.Context) ([]*Album, error)
+	AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error)
 	IsLiked(ctx context.Context, id spotify.ID) (bool, error)
 	Like(ctx context.Context, id spotify.ID) error
 	Unlike(ctx context.Context, id spotify.ID) error
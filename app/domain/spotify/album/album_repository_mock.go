@@ -41,19 +41,39 @@ func (m *MockAlbumRepository) EXPECT() *MockAlbumRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AreLiked mocks base method.
+func (m *MockAlbumRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreLiked", ctx, ids)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreLiked indicates an expected call of AreLiked.
+func (mr *MockAlbumRepositoryMockRecorder) AreLiked(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreLiked", reflect.TypeOf((*MockAlbumRepository)(nil).AreLiked), ctx, ids)
+}
+
 // FindByArtistId mocks base method.
-func (m *MockAlbumRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]*Album, error) {
+func (m *MockAlbumRepository) FindByArtistId(ctx context.Context, id spotify.ID, groups ...string) ([]*Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByArtistId", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range groups {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByArtistId", varargs...)
 	ret0, _ := ret[0].([]*Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByArtistId indicates an expected call of FindByArtistId.
-func (mr *MockAlbumRepositoryMockRecorder) FindByArtistId(ctx, id any) *gomock.Call {
+func (mr *MockAlbumRepositoryMockRecorder) FindByArtistId(ctx, id any, groups ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArtistId", reflect.TypeOf((*MockAlbumRepository)(nil).FindByArtistId), ctx, id)
+	varargs := append([]any{ctx, id}, groups...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArtistId", reflect.TypeOf((*MockAlbumRepository)(nil).FindByArtistId), varargs...)
 }
 
 // FindById mocks base method.
@@ -86,6 +106,21 @@ func (mr *MockAlbumRepositoryMockRecorder) FindByNameLimit(ctx, name, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNameLimit", reflect.TypeOf((*MockAlbumRepository)(nil).FindByNameLimit), ctx, name, limit)
 }
 
+// FindLiked mocks base method.
+func (m *MockAlbumRepository) FindLiked(ctx context.Context) ([]*Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLiked", ctx)
+	ret0, _ := ret[0].([]*Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLiked indicates an expected call of FindLiked.
+func (mr *MockAlbumRepositoryMockRecorder) FindLiked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLiked", reflect.TypeOf((*MockAlbumRepository)(nil).FindLiked), ctx)
+}
+
 // IsLiked mocks base method.
 func (m *MockAlbumRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,9 @@ type Artist struct {
 	ID spotify.ID
 	// Name is the name of the artist.
 	Name string
+	// Genres is a list of the genres the artist is associated with.
+	// It is set only for the artists found by the ID or in the library.
+	Genres []string
 }
 
 // NewArtist returns a new instance of Artist struct.
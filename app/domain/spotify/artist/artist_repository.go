@@ -10,6 +10,8 @@ import (
 type ArtistRepository interface {
 	FindById(ctx context.Context, id spotify.ID) (*Artist, error)
 	FindByNameLimit(ctx context.Context, name string, limit int) ([]*Artist, error)
+	FindFollowed(ctx context.Context) ([]*Artist, error)
+	AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error)
 	IsLiked(ctx context.Context, id spotify.ID) (bool, error)
 	Like(ctx context.Context, id spotify.ID) error
 	Unlike(ctx context.Context, id spotify.ID) error
@@ -41,6 +41,21 @@ func (m *MockArtistRepository) EXPECT() *MockArtistRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AreLiked mocks base method.
+func (m *MockArtistRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreLiked", ctx, ids)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreLiked indicates an expected call of AreLiked.
+func (mr *MockArtistRepositoryMockRecorder) AreLiked(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreLiked", reflect.TypeOf((*MockArtistRepository)(nil).AreLiked), ctx, ids)
+}
+
 // FindById mocks base method.
 func (m *MockArtistRepository) FindById(ctx context.Context, id spotify.ID) (*Artist, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +86,21 @@ func (mr *MockArtistRepositoryMockRecorder) FindByNameLimit(ctx, name, limit any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNameLimit", reflect.TypeOf((*MockArtistRepository)(nil).FindByNameLimit), ctx, name, limit)
 }
 
+// FindFollowed mocks base method.
+func (m *MockArtistRepository) FindFollowed(ctx context.Context) ([]*Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFollowed", ctx)
+	ret0, _ := ret[0].([]*Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFollowed indicates an expected call of FindFollowed.
+func (mr *MockArtistRepositoryMockRecorder) FindFollowed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowed", reflect.TypeOf((*MockArtistRepository)(nil).FindFollowed), ctx)
+}
+
 // IsLiked mocks base method.
 func (m *MockArtistRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
 	m.ctrl.T.Helper()
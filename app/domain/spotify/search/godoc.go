@@ -0,0 +1,2 @@
+// Package search provides the domain of the search.
+package search
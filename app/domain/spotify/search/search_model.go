@@ -0,0 +1,30 @@
+package search
+
+import (
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+// SearchResult is a struct that represents the result of the search across the types of contents.
+type SearchResult struct {
+	// Artists is the artists found by the search.
+	Artists []*artistDomain.Artist
+	// Albums is the albums found by the search.
+	Albums []*albumDomain.Album
+	// Tracks is the tracks found by the search.
+	Tracks []*trackDomain.Track
+}
+
+// NewSearchResult returns a new instance of SearchResult struct.
+func NewSearchResult(
+	artists []*artistDomain.Artist,
+	albums []*albumDomain.Album,
+	tracks []*trackDomain.Track,
+) *SearchResult {
+	return &SearchResult{
+		Artists: artists,
+		Albums:  albums,
+		Tracks:  tracks,
+	}
+}
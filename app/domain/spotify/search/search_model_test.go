@@ -0,0 +1,57 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+)
+
+func TestNewSearchResult(t *testing.T) {
+	artists := []*artistDomain.Artist{
+		artistDomain.NewArtist("1", "artist"),
+	}
+	albums := []*albumDomain.Album{
+		albumDomain.NewAlbum("2", "album", []spotify.SimpleArtist{{ID: "1", Name: "artist"}}, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	tracks := []*trackDomain.Track{
+		trackDomain.NewTrack("3", "track", []spotify.SimpleArtist{{ID: "1", Name: "artist"}}, spotify.SimpleAlbum{ID: "2", Name: "album"}, 1, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	type args struct {
+		artists []*artistDomain.Artist
+		albums  []*albumDomain.Album
+		tracks  []*trackDomain.Track
+	}
+	tests := []struct {
+		name string
+		args args
+		want *SearchResult
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				artists: artists,
+				albums:  albums,
+				tracks:  tracks,
+			},
+			want: &SearchResult{
+				Artists: artists,
+				Albums:  albums,
+				Tracks:  tracks,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSearchResult(tt.args.artists, tt.args.albums, tt.args.tracks); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSearchResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
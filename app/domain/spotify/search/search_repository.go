@@ -0,0 +1,12 @@
+package search
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+// SearchRepository is an interface that provides the repository for the search across the types of contents on Spotify.
+type SearchRepository interface {
+	FindByNameLimit(ctx context.Context, name string, searchType spotify.SearchType, limit int) (*SearchResult, error)
+}
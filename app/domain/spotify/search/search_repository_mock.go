@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./app/domain/spotify/search/search_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./app/domain/spotify/search/search_repository.go -destination=./app/domain/spotify/search/search_repository_mock.go -package=search
+//
+
+// Package search is a generated GoMock package.
+package search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	spotify "github.com/zmb3/spotify/v2"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchRepository is a mock of SearchRepository interface.
+type MockSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchRepositoryMockRecorder is the mock recorder for MockSearchRepository.
+type MockSearchRepositoryMockRecorder struct {
+	mock *MockSearchRepository
+}
+
+// NewMockSearchRepository creates a new mock instance.
+func NewMockSearchRepository(ctrl *gomock.Controller) *MockSearchRepository {
+	mock := &MockSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchRepository) EXPECT() *MockSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByNameLimit mocks base method.
+func (m *MockSearchRepository) FindByNameLimit(ctx context.Context, name string, searchType spotify.SearchType, limit int) (*SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNameLimit", ctx, name, searchType, limit)
+	ret0, _ := ret[0].(*SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNameLimit indicates an expected call of FindByNameLimit.
+func (mr *MockSearchRepositoryMockRecorder) FindByNameLimit(ctx, name, searchType, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNameLimit", reflect.TypeOf((*MockSearchRepository)(nil).FindByNameLimit), ctx, name, searchType, limit)
+}
@@ -20,6 +20,9 @@ type Track struct {
 	TrackNumber spotify.Numeric
 	// ReleaseDate is the release date of the track.
 	ReleaseDate time.Time
+	// LikedAt is the time when the track is saved to the library of the user.
+	// It is set only for the tracks found in the library.
+	LikedAt time.Time
 }
 
 // NewTrack returns a new instance of Track struct.
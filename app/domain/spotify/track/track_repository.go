@@ -12,6 +12,8 @@ type TrackRepository interface {
 	FindByArtistId(ctx context.Context, id spotify.ID) ([]*Track, error)
 	FindById(ctx context.Context, id spotify.ID) (*Track, error)
 	FindByNameLimit(ctx context.Context, name string, limit int) ([]*Track, error)
+	FindLiked(ctx context.Context) ([]*Track, error)
+	AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error)
 	IsLiked(ctx context.Context, id spotify.ID) (bool, error)
 	Like(ctx context.Context, id spotify.ID) error
 	Unlike(ctx context.Context, id spotify.ID) error
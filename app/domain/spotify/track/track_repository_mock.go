@@ -41,6 +41,21 @@ func (m *MockTrackRepository) EXPECT() *MockTrackRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AreLiked mocks base method.
+func (m *MockTrackRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreLiked", ctx, ids)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AreLiked indicates an expected call of AreLiked.
+func (mr *MockTrackRepositoryMockRecorder) AreLiked(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreLiked", reflect.TypeOf((*MockTrackRepository)(nil).AreLiked), ctx, ids)
+}
+
 // FindByAlbumId mocks base method.
 func (m *MockTrackRepository) FindByAlbumId(ctx context.Context, id spotify.ID) ([]*Track, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +116,21 @@ func (mr *MockTrackRepositoryMockRecorder) FindByNameLimit(ctx, name, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNameLimit", reflect.TypeOf((*MockTrackRepository)(nil).FindByNameLimit), ctx, name, limit)
 }
 
+// FindLiked mocks base method.
+func (m *MockTrackRepository) FindLiked(ctx context.Context) ([]*Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLiked", ctx)
+	ret0, _ := ret[0].([]*Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLiked indicates an expected call of FindLiked.
+func (mr *MockTrackRepositoryMockRecorder) FindLiked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLiked", reflect.TypeOf((*MockTrackRepository)(nil).FindLiked), ctx)
+}
+
 // IsLiked mocks base method.
 func (m *MockTrackRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
 	m.ctrl.T.Helper()
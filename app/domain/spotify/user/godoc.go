@@ -0,0 +1,2 @@
+// Package user provides the domain of the user.
+package user
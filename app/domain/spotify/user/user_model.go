@@ -0,0 +1,24 @@
+package user
+
+import (
+	"github.com/zmb3/spotify/v2"
+)
+
+// User is a struct that represents a Spotify user.
+type User struct {
+	// ID is the Spotify ID of the user.
+	ID spotify.ID
+	// Name is the display name of the user.
+	Name string
+}
+
+// NewUser returns a new instance of User struct.
+func NewUser(
+	id spotify.ID,
+	name string,
+) *User {
+	return &User{
+		ID:   id,
+		Name: name,
+	}
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestNewUser(t *testing.T) {
+	type args struct {
+		id   spotify.ID
+		name string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *User
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				id:   "1",
+				name: "user",
+			},
+			want: &User{
+				ID:   "1",
+				Name: "user",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewUser(tt.args.id, tt.args.name); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewUser() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
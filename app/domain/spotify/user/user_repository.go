@@ -0,0 +1,10 @@
+package user
+
+import (
+	"context"
+)
+
+// UserRepository is an interface that provides the repository for the user on Spotify.
+type UserRepository interface {
+	FindCurrent(ctx context.Context) (*User, error)
+}
@@ -0,0 +1,2 @@
+// Package operation provides the domain of the operation.
+package operation
@@ -0,0 +1,57 @@
+package operation
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+// Operation is a struct that represents a mutation of the Spotify library executed by spotlike.
+type Operation struct {
+	// BatchID is the ID of the batch that the operation belongs to.
+	BatchID string
+	// Timestamp is the time when the operation was executed.
+	Timestamp time.Time
+	// Type is the type of the resource (e.g. "artist", "album", "track").
+	Type string
+	// ID is the Spotify ID of the resource.
+	ID spotify.ID
+	// Name is the name of the resource.
+	Name string
+	// Action is the action executed to the resource (e.g. "like", "unlike").
+	Action string
+	// CommandLine is the command line that executed the operation.
+	CommandLine string
+	// Account is the Spotify ID of the user whose library the operation was executed to.
+	// It is empty for the operations recorded before the account was recorded.
+	Account string
+}
+
+// NewOperation returns a new instance of Operation struct.
+func NewOperation(
+	batchID string,
+	timestamp time.Time,
+	resourceType string,
+	id spotify.ID,
+	name string,
+	action string,
+	commandLine string,
+	account string,
+) *Operation {
+	return &Operation{
+		BatchID:     batchID,
+		Timestamp:   timestamp,
+		Type:        resourceType,
+		ID:          id,
+		Name:        name,
+		Action:      action,
+		CommandLine: commandLine,
+		Account:     account,
+	}
+}
+
+// NewBatchID returns a new ID of the batch from the given time.
+func NewBatchID(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 36)
+}
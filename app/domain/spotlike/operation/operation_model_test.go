@@ -0,0 +1,84 @@
+package operation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestNewOperation(t *testing.T) {
+	type args struct {
+		batchID      string
+		timestamp    time.Time
+		resourceType string
+		id           spotify.ID
+		name         string
+		action       string
+		commandLine  string
+		account      string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Operation
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				batchID:      "batch",
+				timestamp:    time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				resourceType: "track",
+				id:           "1",
+				name:         "track",
+				action:       "like",
+				commandLine:  "spotlike like track 1",
+				account:      "user",
+			},
+			want: &Operation{
+				BatchID:     "batch",
+				Timestamp:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				Type:        "track",
+				ID:          "1",
+				Name:        "track",
+				Action:      "like",
+				CommandLine: "spotlike like track 1",
+				Account:     "user",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewOperation(tt.args.batchID, tt.args.timestamp, tt.args.resourceType, tt.args.id, tt.args.name, tt.args.action, tt.args.commandLine, tt.args.account); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewOperation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBatchID(t *testing.T) {
+	type args struct {
+		t time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				t: time.Unix(0, 36*36),
+			},
+			want: "100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewBatchID(tt.args.t); got != tt.want {
+				t.Errorf("NewBatchID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
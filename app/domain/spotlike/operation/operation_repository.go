@@ -0,0 +1,11 @@
+package operation
+
+import (
+	"context"
+)
+
+// OperationRepository is an interface that provides the repository for the operation of spotlike.
+type OperationRepository interface {
+	FindAll(ctx context.Context) ([]*Operation, error)
+	Save(ctx context.Context, operation *Operation) error
+}
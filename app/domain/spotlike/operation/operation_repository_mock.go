@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./app/domain/spotlike/operation/operation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./app/domain/spotlike/operation/operation_repository.go -destination=./app/domain/spotlike/operation/operation_repository_mock.go -package=operation
+//
+
+// Package operation is a generated GoMock package.
+package operation
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOperationRepository is a mock of OperationRepository interface.
+type MockOperationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOperationRepositoryMockRecorder is the mock recorder for MockOperationRepository.
+type MockOperationRepositoryMockRecorder struct {
+	mock *MockOperationRepository
+}
+
+// NewMockOperationRepository creates a new mock instance.
+func NewMockOperationRepository(ctrl *gomock.Controller) *MockOperationRepository {
+	mock := &MockOperationRepository{ctrl: ctrl}
+	mock.recorder = &MockOperationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationRepository) EXPECT() *MockOperationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockOperationRepository) FindAll(ctx context.Context) ([]*Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockOperationRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOperationRepository)(nil).FindAll), ctx)
+}
+
+// Save mocks base method.
+func (m *MockOperationRepository) Save(ctx context.Context, operation *Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, operation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOperationRepositoryMockRecorder) Save(ctx, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOperationRepository)(nil).Save), ctx, operation)
+}
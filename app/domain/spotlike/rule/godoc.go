@@ -0,0 +1,2 @@
+// Package rule provides the domain of the rule to like the contents.
+package rule
@@ -0,0 +1,118 @@
+package rule
+
+import (
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+const (
+	// LikeAlbums is the target of the rule to like the albums.
+	LikeAlbums = "albums"
+	// LikeTracks is the target of the rule to like all tracks on the albums.
+	LikeTracks = "tracks"
+)
+
+var (
+	// AlbumGroups is the relations of the albums to the artist which can be included by the rule.
+	AlbumGroups = []string{"album", "single", "compilation", "appears_on"}
+)
+
+// RuleSet is a struct that represents the rules of spotlike.
+type RuleSet struct {
+	// Follow is the IDs of the artists to be followed.
+	Follow []spotify.ID
+	// Never is the patterns of the names of the albums and the tracks never to be liked by any rule.
+	Never []*regexp.Regexp
+	// Rules is the rules to like the albums or the tracks released by the artists.
+	Rules []*Rule
+}
+
+// NewRuleSet returns a new instance of RuleSet struct.
+func NewRuleSet(follow []spotify.ID, never []*regexp.Regexp, rules []*Rule) *RuleSet {
+	return &RuleSet{
+		Follow: follow,
+		Never:  never,
+		Rules:  rules,
+	}
+}
+
+// Forbids returns whether the album or the track with the name is never to be liked.
+func (rs *RuleSet) Forbids(name string) bool {
+	return matchAny(rs.Never, name)
+}
+
+// Rule is a struct that represents a rule to like the albums or the tracks released by the artists.
+type Rule struct {
+	// Name is the name of the rule.
+	Name string
+	// Artists is the IDs of the artists whose releases are liked.
+	Artists []spotify.ID
+	// Followed is whether the releases of the artists followed by the user are also liked.
+	Followed bool
+	// IncludeGroups is the relations of the albums to the artists to be liked, which includes all if empty.
+	IncludeGroups []string
+	// ReleasedAfter is the date after which the albums are released to be liked, which includes all if zero.
+	ReleasedAfter time.Time
+	// Exclude is the patterns of the names of the albums and the tracks not to be liked.
+	Exclude []*regexp.Regexp
+	// Like is the target to be liked (e.g. "albums", "tracks").
+	Like string
+}
+
+// NewRule returns a new instance of Rule struct.
+func NewRule(
+	name string,
+	artists []spotify.ID,
+	followed bool,
+	includeGroups []string,
+	releasedAfter time.Time,
+	exclude []*regexp.Regexp,
+	like string,
+) *Rule {
+	return &Rule{
+		Name:          name,
+		Artists:       artists,
+		Followed:      followed,
+		IncludeGroups: includeGroups,
+		ReleasedAfter: releasedAfter,
+		Exclude:       exclude,
+		Like:          like,
+	}
+}
+
+// MatchAlbum returns whether the album released by the artists of the rule should be liked or its tracks should be liked.
+// The albums released before the since are not matched as well as the ones released before the released after.
+func (r *Rule) MatchAlbum(name string, group string, releaseDate time.Time, since time.Time) bool {
+	if len(r.IncludeGroups) != 0 && !slices.Contains(r.IncludeGroups, group) {
+		return false
+	}
+	if releaseDate.Before(r.ReleasedAfter) || releaseDate.Before(since) {
+		return false
+	}
+
+	return !r.excludes(name)
+}
+
+// MatchTrack returns whether the track on the album matched by the rule should be liked.
+func (r *Rule) MatchTrack(name string) bool {
+	return !r.excludes(name)
+}
+
+// excludes returns whether the name matches any of the patterns to exclude.
+func (r *Rule) excludes(name string) bool {
+	return matchAny(r.Exclude, name)
+}
+
+// matchAny returns whether the name matches any of the patterns.
+func matchAny(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,234 @@
+package rule
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestNewRuleSet(t *testing.T) {
+	rules := []*Rule{
+		{
+			Name: "test_rule",
+		},
+	}
+	follow := []spotify.ID{"test_artist_id"}
+	never := []*regexp.Regexp{regexp.MustCompile("(?i)karaoke")}
+	want := &RuleSet{Follow: follow, Never: never, Rules: rules}
+	if got := NewRuleSet(follow, never, rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewRuleSet() = %v, want %v", got, want)
+	}
+}
+
+func TestRuleSet_Forbids(t *testing.T) {
+	ruleSet := NewRuleSet(nil, []*regexp.Regexp{regexp.MustCompile("(?i)karaoke")}, nil)
+
+	tests := []struct {
+		name    string
+		ruleSet *RuleSet
+		content string
+		want    bool
+	}{
+		{
+			name:    "positive testing (forbidden)",
+			ruleSet: ruleSet,
+			content: "test_track_name (Karaoke Version)",
+			want:    true,
+		},
+		{
+			name:    "positive testing (not forbidden)",
+			ruleSet: ruleSet,
+			content: "test_track_name",
+			want:    false,
+		},
+		{
+			name:    "positive testing (no patterns)",
+			ruleSet: &RuleSet{},
+			content: "test_track_name (Karaoke Version)",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ruleSet.Forbids(tt.content); got != tt.want {
+				t.Errorf("RuleSet.Forbids() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRule(t *testing.T) {
+	type args struct {
+		name          string
+		artists       []spotify.ID
+		followed      bool
+		includeGroups []string
+		releasedAfter time.Time
+		exclude       []*regexp.Regexp
+		like          string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Rule
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				name:          "test_rule",
+				artists:       []spotify.ID{"test_artist_id"},
+				followed:      true,
+				includeGroups: []string{"album"},
+				releasedAfter: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+				exclude:       []*regexp.Regexp{regexp.MustCompile("(?i)live")},
+				like:          LikeAlbums,
+			},
+			want: &Rule{
+				Name:          "test_rule",
+				Artists:       []spotify.ID{"test_artist_id"},
+				Followed:      true,
+				IncludeGroups: []string{"album"},
+				ReleasedAfter: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+				Exclude:       []*regexp.Regexp{regexp.MustCompile("(?i)live")},
+				Like:          LikeAlbums,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRule(tt.args.name, tt.args.artists, tt.args.followed, tt.args.includeGroups, tt.args.releasedAfter, tt.args.exclude, tt.args.like); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_MatchAlbum(t *testing.T) {
+	rule := NewRule(
+		"test_rule",
+		[]spotify.ID{"test_artist_id"},
+		false,
+		[]string{"album", "single"},
+		time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		[]*regexp.Regexp{regexp.MustCompile(`(?i)\blive\b`)},
+		LikeAlbums,
+	)
+
+	type args struct {
+		name        string
+		group       string
+		releaseDate time.Time
+		since       time.Time
+	}
+	tests := []struct {
+		name string
+		rule *Rule
+		args args
+		want bool
+	}{
+		{
+			name: "positive testing (matched)",
+			rule: rule,
+			args: args{
+				name:        "test_album_name",
+				group:       "album",
+				releaseDate: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+				since:       time.Time{},
+			},
+			want: true,
+		},
+		{
+			name: "positive testing (all groups are included)",
+			rule: &Rule{Name: "test_rule"},
+			args: args{
+				name:        "test_album_name",
+				group:       "appears_on",
+				releaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				since:       time.Time{},
+			},
+			want: true,
+		},
+		{
+			name: "negative testing (group is not included)",
+			rule: rule,
+			args: args{
+				name:        "test_album_name",
+				group:       "compilation",
+				releaseDate: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+				since:       time.Time{},
+			},
+			want: false,
+		},
+		{
+			name: "negative testing (released before the released after)",
+			rule: rule,
+			args: args{
+				name:        "test_album_name",
+				group:       "album",
+				releaseDate: time.Date(2014, 12, 31, 0, 0, 0, 0, time.UTC),
+				since:       time.Time{},
+			},
+			want: false,
+		},
+		{
+			name: "negative testing (released before the since)",
+			rule: rule,
+			args: args{
+				name:        "test_album_name",
+				group:       "album",
+				releaseDate: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+				since:       time.Date(2016, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+			want: false,
+		},
+		{
+			name: "negative testing (name is excluded)",
+			rule: rule,
+			args: args{
+				name:        "test_album_name (Live)",
+				group:       "album",
+				releaseDate: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+				since:       time.Time{},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.MatchAlbum(tt.args.name, tt.args.group, tt.args.releaseDate, tt.args.since); got != tt.want {
+				t.Errorf("Rule.MatchAlbum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_MatchTrack(t *testing.T) {
+	rule := NewRule("test_rule", nil, true, nil, time.Time{}, []*regexp.Regexp{regexp.MustCompile("(?i)karaoke")}, LikeTracks)
+
+	tests := []struct {
+		name  string
+		track string
+		want  bool
+	}{
+		{
+			name:  "positive testing (matched)",
+			track: "test_track_name",
+			want:  true,
+		},
+		{
+			name:  "negative testing (name is excluded)",
+			track: "test_track_name (Karaoke Version)",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rule.MatchTrack(tt.track); got != tt.want {
+				t.Errorf("Rule.MatchTrack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
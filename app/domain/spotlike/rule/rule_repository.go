@@ -0,0 +1,10 @@
+package rule
+
+import (
+	"context"
+)
+
+// RuleRepository is an interface that provides the repository for the rules of spotlike.
+type RuleRepository interface {
+	Find(ctx context.Context) (*RuleSet, error)
+}
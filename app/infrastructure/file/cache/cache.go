@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache is an interface that provides the cache stored in the local files.
+type Cache interface {
+	Clear() error
+	Get(key string, value any) (bool, error)
+	Set(key string, value any, ttl time.Duration) error
+	Stats() (*Stats, error)
+}
+
+// Stats is a struct that contains the statistics of the cache.
+type Stats struct {
+	// Dir is the directory of the cache.
+	Dir string
+	// Entries is the number of the entries in the cache.
+	Entries int
+	// Expired is the number of the expired entries in the cache.
+	Expired int
+	// Size is the total size of the entries in bytes.
+	Size int64
+}
+
+// cache is a struct that implements the Cache interface.
+type cache struct {
+	dir string
+	now func() time.Time
+}
+
+// entry is a struct that represents a file of the cache.
+type entry struct {
+	Key       string          `json:"key"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+const (
+	// entryExt is the extension of the files of the cache.
+	entryExt = ".json"
+)
+
+// NewCache returns a new instance of the cache struct.
+// If the directory is empty, the values are neither stored nor found.
+func NewCache(dir string) Cache {
+	return &cache{
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+// Clear removes all the entries in the cache.
+func (c *cache) Clear() error {
+	if c.dir == "" {
+		return nil
+	}
+
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get reads the value of the key into the value, and returns false if the key is not found or expired.
+func (c *cache) Get(key string, value any) (bool, error) {
+	if c.dir == "" {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil && errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		// the broken entry is treated as a miss and overwritten later
+		return false, nil
+	}
+	if e.Key != key || c.isExpired(&e) {
+		return false, nil
+	}
+	if err := json.Unmarshal(e.Value, value); err != nil {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Set stores the value of the key, which never expires if the ttl is zero.
+func (c *cache) Set(key string, value any, ttl time.Duration) error {
+	if c.dir == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	e := entry{
+		Key:   key,
+		Value: raw,
+	}
+	if ttl > 0 {
+		e.ExpiresAt = c.now().Add(ttl)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	// write to the temporary file and rename it not to leave the broken entry
+	tmp, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path(key))
+}
+
+// Stats returns the statistics of the cache.
+func (c *cache) Stats() (*Stats, error) {
+	stats := &Stats{
+		Dir: c.dir,
+	}
+	if c.dir == "" {
+		return stats, nil
+	}
+
+	files, err := c.files()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil && errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		stats.Entries++
+		stats.Size += int64(len(data))
+
+		var e entry
+		if err := json.Unmarshal(data, &e); err != nil || c.isExpired(&e) {
+			stats.Expired++
+		}
+	}
+
+	return stats, nil
+}
+
+// files returns the files of the entries in the cache.
+func (c *cache) files() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(c.dir, "*"+entryExt))
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// isExpired checks if the entry is expired.
+func (c *cache) isExpired(e *entry) bool {
+	return !e.ExpiresAt.IsZero() && !c.now().Before(e.ExpiresAt)
+}
+
+// path returns the path of the file of the key.
+func (c *cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+entryExt)
+}
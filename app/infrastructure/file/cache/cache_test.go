@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testValue is a struct to store in the cache in testing.
+type testValue struct {
+	ID   string
+	Name string
+}
+
+func TestNewCache(t *testing.T) {
+	c := NewCache("test_dir")
+	got, ok := c.(*cache)
+	if !ok || got.dir != "test_dir" || got.now == nil {
+		t.Errorf("NewCache() = %v, want the cache in test_dir", c)
+	}
+}
+
+func Test_cache_GetAndSet(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dir     string
+		key     string
+		ttl     time.Duration
+		elapsed time.Duration
+		want    *testValue
+		wantOk  bool
+	}{
+		{
+			name:    "positive testing (fresh entry)",
+			dir:     t.TempDir(),
+			key:     "test_key",
+			ttl:     time.Hour,
+			elapsed: 59 * time.Minute,
+			want:    &testValue{ID: "test_id", Name: "test_name"},
+			wantOk:  true,
+		},
+		{
+			name:    "positive testing (expired entry)",
+			dir:     t.TempDir(),
+			key:     "test_key",
+			ttl:     time.Hour,
+			elapsed: time.Hour,
+			want:    &testValue{},
+			wantOk:  false,
+		},
+		{
+			name:    "positive testing (entry never expires)",
+			dir:     t.TempDir(),
+			key:     "test_key",
+			ttl:     0,
+			elapsed: 24 * 365 * time.Hour,
+			want:    &testValue{ID: "test_id", Name: "test_name"},
+			wantOk:  true,
+		},
+		{
+			name:    "positive testing (dir is empty)",
+			dir:     "",
+			key:     "test_key",
+			ttl:     time.Hour,
+			elapsed: 0,
+			want:    &testValue{},
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cache{
+				dir: tt.dir,
+				now: func() time.Time { return now },
+			}
+			if err := c.Set(tt.key, &testValue{ID: "test_id", Name: "test_name"}, tt.ttl); err != nil {
+				t.Errorf("cache.Set() error = %v", err)
+			}
+			c.now = func() time.Time { return now.Add(tt.elapsed) }
+			got := &testValue{}
+			ok, err := c.Get(tt.key, got)
+			if err != nil {
+				t.Errorf("cache.Get() error = %v", err)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("cache.Get() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cache.Get() value = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cache_Get(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(c *cache)
+		wantOk  bool
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (entry is not found)",
+			setup:   nil,
+			wantOk:  false,
+			wantErr: false,
+		},
+		{
+			name: "positive testing (entry is broken)",
+			setup: func(c *cache) {
+				if err := os.WriteFile(c.path("test_key"), []byte("{"), 0644); err != nil {
+					t.Errorf("Failed to write the entry: %v", err)
+				}
+			},
+			wantOk:  false,
+			wantErr: false,
+		},
+		{
+			name: "negative testing (entry is not readable)",
+			setup: func(c *cache) {
+				if err := os.Mkdir(c.path("test_key"), 0755); err != nil {
+					t.Errorf("Failed to make the directory: %v", err)
+				}
+			},
+			wantOk:  false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cache{
+				dir: t.TempDir(),
+				now: time.Now,
+			}
+			if tt.setup != nil {
+				tt.setup(c)
+			}
+			ok, err := c.Get("test_key", &testValue{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("cache.Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOk {
+				t.Errorf("cache.Get() ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_cache_StatsAndClear(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dir := filepath.Join(t.TempDir(), "cache")
+	c := &cache{
+		dir: dir,
+		now: func() time.Time { return now },
+	}
+
+	stats, err := c.Stats()
+	if err != nil {
+		t.Errorf("cache.Stats() error = %v", err)
+	}
+	if stats.Entries != 0 || stats.Expired != 0 || stats.Size != 0 {
+		t.Errorf("cache.Stats() = %v, want no entries", stats)
+	}
+
+	if err := c.Set("test_key_1", &testValue{ID: "test_id_1"}, time.Minute); err != nil {
+		t.Errorf("cache.Set() error = %v", err)
+	}
+	if err := c.Set("test_key_2", &testValue{ID: "test_id_2"}, time.Hour); err != nil {
+		t.Errorf("cache.Set() error = %v", err)
+	}
+	c.now = func() time.Time { return now.Add(30 * time.Minute) }
+
+	stats, err = c.Stats()
+	if err != nil {
+		t.Errorf("cache.Stats() error = %v", err)
+	}
+	if stats.Dir != dir || stats.Entries != 2 || stats.Expired != 1 || stats.Size == 0 {
+		t.Errorf("cache.Stats() = %v, want 2 entries with 1 expired entry in %v", stats, dir)
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Errorf("cache.Clear() error = %v", err)
+	}
+	stats, err = c.Stats()
+	if err != nil {
+		t.Errorf("cache.Stats() error = %v", err)
+	}
+	if stats.Entries != 0 {
+		t.Errorf("cache.Stats() entries = %v after clear, want 0", stats.Entries)
+	}
+
+	empty := NewCache("")
+	if err := empty.Clear(); err != nil {
+		t.Errorf("cache.Clear() error = %v", err)
+	}
+	if stats, err := empty.Stats(); err != nil || stats.Entries != 0 {
+		t.Errorf("cache.Stats() = %v, %v, want no entries", stats, err)
+	}
+}
@@ -0,0 +1,2 @@
+// Package cache provides a cache stored in the local files.
+package cache
@@ -0,0 +1,2 @@
+// Package repository provides a repository for using the local files.
+package repository
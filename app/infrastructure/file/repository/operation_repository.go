@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+)
+
+const (
+	// journalFileName is the name of the file to store the operations.
+	journalFileName = "journal.jsonl"
+)
+
+// operationRepository is a struct that implements the OperationRepository interface.
+type operationRepository struct {
+	path string
+}
+
+// NewOperationRepository returns a new instance of the operationRepository struct.
+// If the directory is empty, the operations are neither stored nor found.
+func NewOperationRepository(dir string) operationDomain.OperationRepository {
+	var path string
+	if dir != "" {
+		path = filepath.Join(dir, journalFileName)
+	}
+
+	return &operationRepository{
+		path: path,
+	}
+}
+
+// operationRecord is a struct that represents a line of the journal file.
+type operationRecord struct {
+	BatchID     string    `json:"batch_id"`
+	Timestamp   time.Time `json:"timestamp"`
+	Type        string    `json:"type"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Action      string    `json:"action"`
+	CommandLine string    `json:"command_line"`
+	Account     string    `json:"account,omitempty"`
+}
+
+// FindAll returns all the operations in the order they were saved.
+func (r *operationRepository) FindAll(ctx context.Context) ([]*operationDomain.Operation, error) {
+	if r.path == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(r.path)
+	if err != nil && errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var operations []*operationDomain.Operation
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record operationRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, err
+		}
+
+		operations = append(
+			operations,
+			operationDomain.NewOperation(
+				record.BatchID,
+				record.Timestamp,
+				record.Type,
+				spotify.ID(record.ID),
+				record.Name,
+				record.Action,
+				record.CommandLine,
+				record.Account,
+			),
+		)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// Save appends the operation to the journal file.
+func (r *operationRepository) Save(ctx context.Context, operation *operationDomain.Operation) error {
+	if r.path == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0700); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(
+		&operationRecord{
+			BatchID:     operation.BatchID,
+			Timestamp:   operation.Timestamp,
+			Type:        operation.Type,
+			ID:          operation.ID.String(),
+			Name:        operation.Name,
+			Action:      operation.Action,
+			CommandLine: operation.CommandLine,
+			Account:     operation.Account,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
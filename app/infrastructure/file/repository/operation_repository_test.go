@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+)
+
+func TestNewOperationRepository(t *testing.T) {
+	type args struct {
+		dir string
+	}
+	tests := []struct {
+		name string
+		args args
+		want operationDomain.OperationRepository
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				dir: "/test/data",
+			},
+			want: &operationRepository{
+				path: filepath.Join("/test/data", "journal.jsonl"),
+			},
+		},
+		{
+			name: "positive testing (dir is empty)",
+			args: args{
+				dir: "",
+			},
+			want: &operationRepository{
+				path: "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewOperationRepository(tt.args.dir); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewOperationRepository() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_operationRepository_FindAll(t *testing.T) {
+	op := operationDomain.NewOperation(
+		"test_batch_id",
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		"track",
+		"test_track_id",
+		"test_track_name",
+		"like",
+		"spotlike like track test_track_id",
+		"test_user_id",
+	)
+
+	type fields struct {
+		path string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []*operationDomain.Operation
+		wantErr bool
+		setup   func(tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				path: "",
+			},
+			want:    []*operationDomain.Operation{op, op},
+			wantErr: false,
+			setup: func(tt *fields) {
+				dir := t.TempDir()
+				r := NewOperationRepository(dir)
+				for range 2 {
+					if err := r.Save(context.Background(), op); err != nil {
+						t.Errorf("Failed to save operation: %v", err)
+					}
+				}
+				tt.path = filepath.Join(dir, "journal.jsonl")
+			},
+		},
+		{
+			name: "positive testing (the operation was recorded without the account)",
+			fields: fields{
+				path: "",
+			},
+			want: []*operationDomain.Operation{
+				operationDomain.NewOperation(
+					"test_batch_id",
+					time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+					"track",
+					"test_track_id",
+					"test_track_name",
+					"like",
+					"spotlike like track test_track_id",
+					"",
+				),
+			},
+			wantErr: false,
+			setup: func(tt *fields) {
+				tt.path = filepath.Join(t.TempDir(), "journal.jsonl")
+				if err := os.WriteFile(tt.path, []byte(`{"batch_id":"test_batch_id","timestamp":"2000-01-01T00:00:00Z","type":"track","id":"test_track_id","name":"test_track_name","action":"like","command_line":"spotlike like track test_track_id"}`+"\n"), 0600); err != nil {
+					t.Errorf("Failed to write file: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (path is empty)",
+			fields: fields{
+				path: "",
+			},
+			want:    nil,
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name: "positive testing (file does not exist)",
+			fields: fields{
+				path: "",
+			},
+			want:    nil,
+			wantErr: false,
+			setup: func(tt *fields) {
+				tt.path = filepath.Join(t.TempDir(), "journal.jsonl")
+			},
+		},
+		{
+			name: "negative testing (os.Open() failed)",
+			fields: fields{
+				path: "",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(tt *fields) {
+				tt.path = filepath.Join(t.TempDir(), "journal.jsonl", "invalid")
+				if err := os.WriteFile(filepath.Dir(tt.path), []byte{}, 0600); err != nil {
+					t.Errorf("Failed to write file: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (json.Unmarshal() failed)",
+			fields: fields{
+				path: "",
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(tt *fields) {
+				tt.path = filepath.Join(t.TempDir(), "journal.jsonl")
+				if err := os.WriteFile(tt.path, []byte("invalid\n"), 0600); err != nil {
+					t.Errorf("Failed to write file: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.fields)
+			}
+			r := &operationRepository{
+				path: tt.fields.path,
+			}
+			got, err := r.FindAll(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("operationRepository.FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("operationRepository.FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_operationRepository_Save(t *testing.T) {
+	op := operationDomain.NewOperation(
+		"test_batch_id",
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		"track",
+		"test_track_id",
+		"test_track_name",
+		"like",
+		"spotlike like track test_track_id",
+		"test_user_id",
+	)
+
+	type fields struct {
+		path string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr bool
+		setup   func(tt *fields)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				path: "",
+			},
+			want:    `{"batch_id":"test_batch_id","timestamp":"2000-01-01T00:00:00Z","type":"track","id":"test_track_id","name":"test_track_name","action":"like","command_line":"spotlike like track test_track_id","account":"test_user_id"}` + "\n",
+			wantErr: false,
+			setup: func(tt *fields) {
+				tt.path = filepath.Join(t.TempDir(), "spotlike", "journal.jsonl")
+			},
+		},
+		{
+			name: "positive testing (path is empty)",
+			fields: fields{
+				path: "",
+			},
+			want:    "",
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name: "negative testing (os.MkdirAll() failed)",
+			fields: fields{
+				path: "",
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(tt *fields) {
+				dir := t.TempDir()
+				if err := os.WriteFile(filepath.Join(dir, "spotlike"), []byte{}, 0600); err != nil {
+					t.Errorf("Failed to write file: %v", err)
+				}
+				tt.path = filepath.Join(dir, "spotlike", "journal.jsonl")
+			},
+		},
+		{
+			name: "negative testing (os.OpenFile() failed)",
+			fields: fields{
+				path: "",
+			},
+			want:    "",
+			wantErr: true,
+			setup: func(tt *fields) {
+				tt.path = t.TempDir()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(&tt.fields)
+			}
+			r := &operationRepository{
+				path: tt.fields.path,
+			}
+			if err := r.Save(context.Background(), op); (err != nil) != tt.wantErr {
+				t.Errorf("operationRepository.Save() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == "" {
+				return
+			}
+			got, err := os.ReadFile(tt.fields.path)
+			if err != nil {
+				t.Errorf("Failed to read file: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("operationRepository.Save() wrote %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+const (
+	// ruleDateLayout is the layout of the dates in the rules file.
+	ruleDateLayout = "2006-01-02"
+)
+
+// ruleRepository is a struct that implements the RuleRepository interface.
+type ruleRepository struct {
+	yaml proxy.Yaml
+	path string
+}
+
+// NewRuleRepository returns a new instance of the ruleRepository struct reading the rules file in the path.
+func NewRuleRepository(yaml proxy.Yaml, path string) ruleDomain.RuleRepository {
+	return &ruleRepository{
+		yaml: yaml,
+		path: path,
+	}
+}
+
+// ruleSetRecord is a struct that represents the rules file.
+type ruleSetRecord struct {
+	Follow []string     `yaml:"follow"`
+	Never  []string     `yaml:"never"`
+	Rules  []ruleRecord `yaml:"rules"`
+}
+
+// ruleRecord is a struct that represents a rule in the rules file.
+type ruleRecord struct {
+	Name          string   `yaml:"name"`
+	Artists       []string `yaml:"artists"`
+	Followed      bool     `yaml:"followed"`
+	IncludeGroups []string `yaml:"include_groups"`
+	ReleasedAfter string   `yaml:"released_after"`
+	Exclude       []string `yaml:"exclude"`
+	Like          string   `yaml:"like"`
+}
+
+// Find reads and validates the rules in the rules file.
+func (r *ruleRepository) Find(ctx context.Context) (*ruleDomain.RuleSet, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var record ruleSetRecord
+	if err := r.yaml.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse the rules file %s : %w", r.path, err)
+	}
+	if len(record.Rules) == 0 && len(record.Follow) == 0 {
+		return nil, errors.New("no rules found in the rules file " + r.path)
+	}
+
+	follow := make([]spotify.ID, len(record.Follow))
+	for i, artist := range record.Follow {
+		follow[i] = spotify.ID(artist)
+	}
+	never, err := compilePatterns(record.Never)
+	if err != nil {
+		return nil, fmt.Errorf("invalid never pattern : %w", err)
+	}
+
+	var rules []*ruleDomain.Rule
+	names := map[string]bool{}
+	for i, rr := range record.Rules {
+		name := rr.Name
+		if name == "" {
+			name = fmt.Sprintf("rule %d", i+1)
+		}
+		if names[name] {
+			return nil, errors.New("the name of the rule is duplicated : " + name)
+		}
+		names[name] = true
+
+		rule, err := rr.toRule(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %q : %w", name, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return ruleDomain.NewRuleSet(follow, never, rules), nil
+}
+
+// toRule validates the record and returns the rule with the name.
+func (rr *ruleRecord) toRule(name string) (*ruleDomain.Rule, error) {
+	if len(rr.Artists) == 0 && !rr.Followed {
+		return nil, errors.New("no artists specified (specify artists or followed)")
+	}
+	artists := make([]spotify.ID, len(rr.Artists))
+	for i, artist := range rr.Artists {
+		artists[i] = spotify.ID(artist)
+	}
+
+	for _, group := range rr.IncludeGroups {
+		if !slices.Contains(ruleDomain.AlbumGroups, group) {
+			return nil, errors.New("unknown include group " + group + " (e.g: " + strings.Join(ruleDomain.AlbumGroups, ", ") + ")")
+		}
+	}
+
+	var releasedAfter time.Time
+	if rr.ReleasedAfter != "" {
+		var err error
+		if releasedAfter, err = time.Parse(ruleDateLayout, rr.ReleasedAfter); err != nil {
+			return nil, errors.New("invalid released after " + rr.ReleasedAfter + " (e.g: 2015-01-01)")
+		}
+	}
+
+	exclude, err := compilePatterns(rr.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude pattern : %w", err)
+	}
+
+	like := rr.Like
+	if like == "" {
+		like = ruleDomain.LikeAlbums
+	}
+	if like != ruleDomain.LikeAlbums && like != ruleDomain.LikeTracks {
+		return nil, errors.New("invalid like " + like + " (e.g: albums, tracks)")
+	}
+
+	return ruleDomain.NewRule(name, artists, rr.Followed, rr.IncludeGroups, releasedAfter, exclude, like), nil
+}
+
+// compilePatterns compiles the patterns of the names in the rules file.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s : %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
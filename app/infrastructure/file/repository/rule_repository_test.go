@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	ruleDomain "github.com/yanosea/spotlike/app/domain/spotlike/rule"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewRuleRepository(t *testing.T) {
+	yaml := proxy.NewYaml()
+	want := &ruleRepository{
+		yaml: yaml,
+		path: "/test/rules.yaml",
+	}
+	if got := NewRuleRepository(yaml, "/test/rules.yaml"); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewRuleRepository() = %v, want %v", got, want)
+	}
+}
+
+func Test_ruleRepository_Find(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *ruleDomain.RuleSet
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller) proxy.Yaml
+	}{
+		{
+			name: "positive testing",
+			content: `follow:
+  - test_artist_id
+never:
+  - "(?i)karaoke"
+rules:
+  - name: studio albums
+    artists:
+      - test_artist_id
+    include_groups:
+      - album
+    released_after: "2015-01-01"
+    exclude:
+      - "(?i)live"
+  - followed: true
+    like: tracks
+`,
+			want: ruleDomain.NewRuleSet(
+				[]spotify.ID{"test_artist_id"},
+				[]*regexp.Regexp{regexp.MustCompile("(?i)karaoke")},
+				[]*ruleDomain.Rule{
+					ruleDomain.NewRule(
+						"studio albums",
+						[]spotify.ID{"test_artist_id"},
+						false,
+						[]string{"album"},
+						time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+						[]*regexp.Regexp{regexp.MustCompile("(?i)live")},
+						ruleDomain.LikeAlbums,
+					),
+					ruleDomain.NewRule(
+						"rule 2",
+						[]spotify.ID{},
+						true,
+						nil,
+						time.Time{},
+						nil,
+						ruleDomain.LikeTracks,
+					),
+				},
+			),
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "positive testing (only the artists to follow)",
+			content: "follow: [test_artist_id]\n",
+			want: ruleDomain.NewRuleSet(
+				[]spotify.ID{"test_artist_id"},
+				nil,
+				nil,
+			),
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (rules file is not found)",
+			content: "",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (yaml.Unmarshal() failed)",
+			content: "rules:",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) proxy.Yaml {
+				mockYaml := proxy.NewMockYaml(mockCtrl)
+				mockYaml.EXPECT().Unmarshal(gomock.Any(), gomock.Any()).Return(errors.New("failed to unmarshal"))
+				return mockYaml
+			},
+		},
+		{
+			name:    "negative testing (no rules)",
+			content: "rules: []\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (name is duplicated)",
+			content: "rules:\n  - name: test\n    followed: true\n  - name: test\n    followed: true\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (no artists)",
+			content: "rules:\n  - name: test\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (include group is unknown)",
+			content: "rules:\n  - followed: true\n    include_groups: [live]\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (released after is invalid)",
+			content: "rules:\n  - followed: true\n    released_after: 2015/01/01\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (exclude pattern is invalid)",
+			content: "rules:\n  - followed: true\n    exclude: [\"(\"]\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (never pattern is invalid)",
+			content: "never: [\"(\"]\nrules:\n  - followed: true\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (like is invalid)",
+			content: "rules:\n  - followed: true\n    like: artists\n",
+			want:    nil,
+			wantErr: true,
+			setup:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			path := filepath.Join(t.TempDir(), "rules.yaml")
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0600); err != nil {
+					t.Fatalf("Failed to write the rules file: %v", err)
+				}
+			}
+			yaml := proxy.NewYaml()
+			if tt.setup != nil {
+				yaml = tt.setup(mockCtrl)
+			}
+			r := NewRuleRepository(yaml, path)
+			got, err := r.Find(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ruleRepository.Find() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ruleRepository.Find() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package state provides a state of the commands stored in the local files.
+package state
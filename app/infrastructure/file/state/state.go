@@ -0,0 +1,114 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State is an interface that provides the state of the commands stored in the local files.
+type State interface {
+	LastRun(command string) (time.Time, error)
+	SaveLastRun(command string, t time.Time) error
+}
+
+// state is a struct that implements the State interface.
+type state struct {
+	path string
+}
+
+// stateRecord is a struct that represents the file of the state.
+type stateRecord struct {
+	LastRuns map[string]time.Time `json:"last_runs"`
+}
+
+const (
+	// stateFileName is the name of the file to store the state.
+	stateFileName = "state.json"
+)
+
+// NewState returns a new instance of the state struct.
+// If the directory is empty, the state is neither stored nor found.
+func NewState(dir string) State {
+	var path string
+	if dir != "" {
+		path = filepath.Join(dir, stateFileName)
+	}
+
+	return &state{
+		path: path,
+	}
+}
+
+// LastRun returns the time the command was run last, which is zero if it is not found.
+func (s *state) LastRun(command string) (time.Time, error) {
+	record, err := s.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return record.LastRuns[command], nil
+}
+
+// SaveLastRun stores the time the command was run last.
+func (s *state) SaveLastRun(command string, t time.Time) error {
+	if s.path == "" {
+		return nil
+	}
+
+	record, err := s.read()
+	if err != nil {
+		return err
+	}
+	if record.LastRuns == nil {
+		record.LastRuns = map[string]time.Time{}
+	}
+	record.LastRuns[command] = t
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	// write to the temporary file and rename it not to leave the broken state
+	tmp, err := os.CreateTemp(dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// read reads the file of the state, and returns the empty state if it is not found.
+func (s *state) read() (*stateRecord, error) {
+	record := &stateRecord{}
+	if s.path == "" {
+		return record, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil && errors.Is(err, os.ErrNotExist) {
+		return record, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
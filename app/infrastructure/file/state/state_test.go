@@ -0,0 +1,152 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewState(t *testing.T) {
+	tests := []struct {
+		name string
+		dir  string
+		want string
+	}{
+		{
+			name: "positive testing",
+			dir:  "test_dir",
+			want: filepath.Join("test_dir", stateFileName),
+		},
+		{
+			name: "positive testing (dir is empty)",
+			dir:  "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewState(tt.dir)
+			got, ok := s.(*state)
+			if !ok || got.path != tt.want {
+				t.Errorf("NewState() = %v, want the state in %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func Test_state_LastRunAndSaveLastRun(t *testing.T) {
+	lastRun := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dir     string
+		save    bool
+		command string
+		want    time.Time
+		wantErr bool
+		setup   func(dir string)
+	}{
+		{
+			name:    "positive testing (saved)",
+			dir:     t.TempDir(),
+			save:    true,
+			command: "test_command",
+			want:    lastRun,
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "positive testing (not saved)",
+			dir:     t.TempDir(),
+			save:    false,
+			command: "test_command",
+			want:    time.Time{},
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "positive testing (dir is empty)",
+			dir:     "",
+			save:    true,
+			command: "test_command",
+			want:    time.Time{},
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "positive testing (other command is saved)",
+			dir:     t.TempDir(),
+			save:    false,
+			command: "test_command",
+			want:    time.Time{},
+			wantErr: false,
+			setup: func(dir string) {
+				if err := NewState(dir).SaveLastRun("test_other_command", lastRun); err != nil {
+					t.Fatalf("SaveLastRun() error = %v", err)
+				}
+			},
+		},
+		{
+			name:    "negative testing (state file is broken)",
+			dir:     t.TempDir(),
+			save:    false,
+			command: "test_command",
+			want:    time.Time{},
+			wantErr: true,
+			setup: func(dir string) {
+				if err := os.WriteFile(filepath.Join(dir, stateFileName), []byte("{"), 0600); err != nil {
+					t.Fatalf("WriteFile() error = %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(tt.dir)
+			}
+			s := NewState(tt.dir)
+			if tt.save {
+				if err := s.SaveLastRun(tt.command, lastRun); err != nil {
+					t.Errorf("state.SaveLastRun() error = %v", err)
+					return
+				}
+			}
+			got, err := s.LastRun(tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("state.LastRun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("state.LastRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_state_SaveLastRun(t *testing.T) {
+	dir := t.TempDir()
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	s := NewState(dir)
+	if err := s.SaveLastRun("test_command", first); err != nil {
+		t.Fatalf("state.SaveLastRun() error = %v", err)
+	}
+	if err := s.SaveLastRun("test_other_command", first); err != nil {
+		t.Fatalf("state.SaveLastRun() error = %v", err)
+	}
+	if err := s.SaveLastRun("test_command", second); err != nil {
+		t.Fatalf("state.SaveLastRun() error = %v", err)
+	}
+
+	if got, err := s.LastRun("test_command"); err != nil || !got.Equal(second) {
+		t.Errorf("state.LastRun() = %v, %v, want %v", got, err, second)
+	}
+	if got, err := s.LastRun("test_other_command"); err != nil || !got.Equal(first) {
+		t.Errorf("state.LastRun() = %v, %v, want %v", got, err, first)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*.tmp")); len(files) != 0 {
+		t.Errorf("temporary files are left : %v", files)
+	}
+}
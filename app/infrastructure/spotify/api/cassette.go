@@ -0,0 +1,322 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Interaction is a struct that contains a pair of the request and the response saved in the cassette.
+type Interaction struct {
+	// Request is the recorded request.
+	Request InteractionRequest `json:"request"`
+	// Response is the recorded response.
+	Response InteractionResponse `json:"response"`
+}
+
+// InteractionRequest is a struct that contains the recorded request.
+type InteractionRequest struct {
+	// Method is the method of the request.
+	Method string `json:"method"`
+	// Url is the url of the request.
+	Url string `json:"url"`
+	// Body is the body of the request.
+	Body string `json:"body,omitempty"`
+}
+
+// InteractionResponse is a struct that contains the recorded response.
+type InteractionResponse struct {
+	// Status is the status code of the response.
+	Status int `json:"status"`
+	// Header is the header of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response.
+	Body string `json:"body,omitempty"`
+}
+
+const (
+	// replayAccessToken is the access token returned to the token requests in replaying.
+	replayAccessToken = "replay_access_token"
+)
+
+var (
+	// sensitiveQueryKeys is the keys of the query parameters stripped from the recorded urls.
+	sensitiveQueryKeys = []string{"access_token", "refresh_token", "code", "client_secret"}
+	// sensitiveHeaderKeys is the keys of the response headers stripped from the recorded responses.
+	sensitiveHeaderKeys = []string{"Set-Cookie", "Authorization"}
+)
+
+// recordTransport is a struct that saves the requests and the responses to the Spotify Web API in the cassette.
+type recordTransport struct {
+	dir       string
+	transport http.RoundTripper
+	count     int
+	mutex     *sync.Mutex
+}
+
+// newRecordTransport returns a new instance of the record transport.
+func newRecordTransport(dir string, transport http.RoundTripper) *recordTransport {
+	return &recordTransport{
+		dir:       dir,
+		transport: transport,
+		count:     -1,
+		mutex:     &sync.Mutex{},
+	}
+}
+
+// RoundTrip sends the request and saves the sanitized pair of the request and the response in the cassette.
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isAccountsRequest(req) {
+		// the requests to the accounts service contain the credentials, so they are never recorded
+		return t.transport.RoundTrip(req)
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, err
+		}
+		reqBody = body
+		cloned := req.Clone(req.Context())
+		cloned.Body = io.NopCloser(bytes.NewReader(reqBody))
+		req = cloned
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Body.Close(); err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	header := res.Header.Clone()
+	for _, key := range sensitiveHeaderKeys {
+		header.Del(key)
+	}
+	if err := t.save(&Interaction{
+		Request: InteractionRequest{
+			Method: req.Method,
+			Url:    sanitizeUrl(req.URL),
+			Body:   string(reqBody),
+		},
+		Response: InteractionResponse{
+			Status: res.StatusCode,
+			Header: header,
+			Body:   string(resBody),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// save saves the interaction in the cassette as the next fixture file.
+func (t *recordTransport) save(interaction *Interaction) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.count < 0 {
+		if err := os.MkdirAll(t.dir, 0755); err != nil {
+			return err
+		}
+		files, err := cassetteFiles(t.dir)
+		if err != nil {
+			return err
+		}
+		t.count = len(files)
+	}
+
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+	t.count++
+
+	return os.WriteFile(filepath.Join(t.dir, fmt.Sprintf("%04d.json", t.count)), append(data, '\n'), 0644)
+}
+
+// replayTransport is a struct that returns the responses saved in the cassette without sending the requests.
+type replayTransport struct {
+	dir          string
+	interactions []*Interaction
+	keys         []string
+	used         []bool
+	err          error
+	once         *sync.Once
+	mutex        *sync.Mutex
+}
+
+// newReplayTransport returns a new instance of the replay transport.
+func newReplayTransport(dir string) *replayTransport {
+	return &replayTransport{
+		dir:   dir,
+		once:  &sync.Once{},
+		mutex: &sync.Mutex{},
+	}
+}
+
+// RoundTrip returns the response saved in the cassette for the request.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isAccountsRequest(req) {
+		return newStoredResponse(
+			req,
+			http.StatusOK,
+			http.Header{"Content-Type": []string{"application/json"}},
+			`{"access_token":"`+replayAccessToken+`","token_type":"Bearer","expires_in":3600}`,
+		), nil
+	}
+
+	t.once.Do(t.load)
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, err
+		}
+		reqBody = body
+	}
+	reqKey := interactionKey(req.URL)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	found := -1
+	for i, interaction := range t.interactions {
+		if interaction.Request.Method != req.Method ||
+			t.keys[i] != reqKey ||
+			interaction.Request.Body != string(reqBody) {
+			continue
+		}
+		found = i
+		if !t.used[i] {
+			break
+		}
+	}
+	if found < 0 {
+		return nil, errors.New("no interaction recorded in the cassette for " + req.Method + " " + sanitizeUrl(req.URL))
+	}
+	t.used[found] = true
+
+	interaction := t.interactions[found]
+	return newStoredResponse(req, interaction.Response.Status, interaction.Response.Header.Clone(), interaction.Response.Body), nil
+}
+
+// load loads the interactions from the cassette.
+func (t *replayTransport) load() {
+	files, err := cassetteFiles(t.dir)
+	if err != nil {
+		t.err = err
+		return
+	}
+	if len(files) == 0 {
+		t.err = errors.New("no interaction found in the cassette " + t.dir)
+		return
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.err = err
+			return
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			t.err = fmt.Errorf("failed to read the interaction %s: %w", file, err)
+			return
+		}
+		u, err := url.Parse(interaction.Request.Url)
+		if err != nil {
+			t.err = fmt.Errorf("failed to read the url of the interaction %s: %w", file, err)
+			return
+		}
+		t.interactions = append(t.interactions, &interaction)
+		t.keys = append(t.keys, interactionKey(u))
+	}
+	t.used = make([]bool, len(t.interactions))
+}
+
+// newStoredResponse returns a new response to the request from the stored status, header and body.
+func newStoredResponse(req *http.Request, status int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// cassetteFiles returns the fixture files in the cassette in the recorded order.
+func cassetteFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// isAccountsRequest checks if the request is for the Spotify accounts service.
+func isAccountsRequest(req *http.Request) bool {
+	return req.URL.Scheme+"://"+req.URL.Host == spotifyAccountsUrl
+}
+
+// interactionKey returns the key to match the request with the interactions, which consists of the path and the sorted query without the sensitive parameters.
+// The host is not included, so that the cassette recorded against a host can be replayed against another host.
+func interactionKey(u *url.URL) string {
+	query := u.Query()
+	for _, key := range sensitiveQueryKeys {
+		query.Del(key)
+	}
+
+	return u.EscapedPath() + "?" + query.Encode()
+}
+
+// sanitizeUrl returns the url without the sensitive query parameters.
+func sanitizeUrl(u *url.URL) string {
+	sanitized := *u
+	query := sanitized.Query()
+	for _, key := range sensitiveQueryKeys {
+		query.Del(key)
+	}
+	sanitized.RawQuery = query.Encode()
+
+	return sanitized.String()
+}
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_recordTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		url       string
+		body      string
+		wantFiles int
+		wantErr   bool
+	}{
+		{
+			name:      "positive testing (request to the web api)",
+			method:    http.MethodGet,
+			url:       "https://api.spotify.com/v1/albums/test_album_id?access_token=test_access_token&market=JP",
+			body:      "",
+			wantFiles: 1,
+			wantErr:   false,
+		},
+		{
+			name:      "positive testing (request to the accounts service)",
+			method:    http.MethodPost,
+			url:       "https://accounts.spotify.com/api/token",
+			body:      "grant_type=refresh_token&refresh_token=test_refresh_token",
+			wantFiles: 0,
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "cassette")
+			tr := newRecordTransport(dir, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header: http.Header{
+						"Content-Type": []string{"application/json"},
+						"Set-Cookie":   []string{"test_cookie"},
+					},
+					Body: io.NopCloser(strings.NewReader(`{"id":"test_album_id"}`)),
+				}, nil
+			}))
+			req, err := http.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			if err != nil {
+				t.Errorf("Failed to create a request: %v", err)
+			}
+			req.Header.Set("Authorization", "Bearer test_access_token")
+			res, err := tr.RoundTrip(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("recordTransport.RoundTrip() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Errorf("Failed to read the body: %v", err)
+			}
+			if string(body) != `{"id":"test_album_id"}` {
+				t.Errorf("recordTransport.RoundTrip() body = %v, want %v", string(body), `{"id":"test_album_id"}`)
+			}
+			files, err := cassetteFiles(dir)
+			if err != nil {
+				t.Errorf("Failed to list the cassette: %v", err)
+			}
+			if len(files) != tt.wantFiles {
+				t.Errorf("recordTransport.RoundTrip() files = %v, want %v", len(files), tt.wantFiles)
+			}
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Errorf("Failed to read the cassette: %v", err)
+				}
+				for _, secret := range []string{"test_access_token", "test_refresh_token", "test_cookie"} {
+					if strings.Contains(string(data), secret) {
+						t.Errorf("recordTransport.RoundTrip() recorded %v in the cassette:\n%s", secret, string(data))
+					}
+				}
+			}
+		})
+	}
+}
+
+func Test_replayTransport_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	recorder := newRecordTransport(dir, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		if strings.Contains(req.URL.Path, "not_found") {
+			status = http.StatusNotFound
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"path":"` + req.URL.Path + `"}`)),
+		}, nil
+	}))
+	for _, u := range []string{
+		"https://api.spotify.com/v1/albums/test_album_id",
+		"https://api.spotify.com/v1/albums/not_found",
+		"https://api.spotify.com/v1/albums/test_album_id/tracks?offset=0&limit=50",
+	} {
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			t.Errorf("Failed to create a request: %v", err)
+		}
+		if _, err := recorder.RoundTrip(req); err != nil {
+			t.Errorf("Failed to record: %v", err)
+		}
+	}
+
+	invalidDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(invalidDir, "0001.json"), []byte(`{"request":{"method":"GET","url":"http://[::1"},"response":{"status":200}}`), 0644); err != nil {
+		t.Errorf("Failed to write the cassette: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		dir        string
+		method     string
+		url        string
+		wantStatus int
+		wantBody   string
+		wantErr    bool
+	}{
+		{
+			name:       "positive testing (recorded request)",
+			dir:        dir,
+			method:     http.MethodGet,
+			url:        "https://api.spotify.com/v1/albums/test_album_id",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"path":"/v1/albums/test_album_id"}`,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (recorded error response)",
+			dir:        dir,
+			method:     http.MethodGet,
+			url:        "https://api.spotify.com/v1/albums/not_found",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"path":"/v1/albums/not_found"}`,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (recorded on another host)",
+			dir:        dir,
+			method:     http.MethodGet,
+			url:        "http://127.0.0.1:8080/v1/albums/test_album_id",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"path":"/v1/albums/test_album_id"}`,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (query in another order)",
+			dir:        dir,
+			method:     http.MethodGet,
+			url:        "http://127.0.0.1:8080/v1/albums/test_album_id/tracks?limit=50&offset=0&access_token=test_access_token",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"path":"/v1/albums/test_album_id/tracks"}`,
+			wantErr:    false,
+		},
+		{
+			name:       "positive testing (request to the accounts service)",
+			dir:        dir,
+			method:     http.MethodPost,
+			url:        "https://accounts.spotify.com/api/token",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"access_token":"replay_access_token","token_type":"Bearer","expires_in":3600}`,
+			wantErr:    false,
+		},
+		{
+			name:    "negative testing (request is not recorded)",
+			dir:     dir,
+			method:  http.MethodGet,
+			url:     "https://api.spotify.com/v1/tracks/test_track_id",
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (query is different)",
+			dir:     dir,
+			method:  http.MethodGet,
+			url:     "https://api.spotify.com/v1/albums/test_album_id/tracks?limit=50&offset=50",
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (url in the cassette is invalid)",
+			dir:     invalidDir,
+			method:  http.MethodGet,
+			url:     "https://api.spotify.com/v1/albums/test_album_id",
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (cassette is empty)",
+			dir:     t.TempDir(),
+			method:  http.MethodGet,
+			url:     "https://api.spotify.com/v1/albums/test_album_id",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newReplayTransport(tt.dir)
+			req, err := http.NewRequest(tt.method, tt.url, nil)
+			if err != nil {
+				t.Errorf("Failed to create a request: %v", err)
+			}
+			res, err := tr.RoundTrip(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("replayTransport.RoundTrip() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("replayTransport.RoundTrip() status = %v, want %v", res.StatusCode, tt.wantStatus)
+			}
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Errorf("Failed to read the body: %v", err)
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("replayTransport.RoundTrip() body = %v, want %v", string(body), tt.wantBody)
+			}
+		})
+	}
+}
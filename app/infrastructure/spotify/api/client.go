@@ -3,14 +3,20 @@ package api
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
 
+	"github.com/zmb3/spotify/v2"
 	"github.com/zmb3/spotify/v2/auth"
 
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+
 	"github.com/yanosea/spotlike/pkg/proxy"
 )
 
@@ -39,6 +45,11 @@ var (
 	dc chan struct{}
 )
 
+const (
+	// spotifyAccountsUrl is the default base URL of the Spotify accounts service.
+	spotifyAccountsUrl = "https://accounts.spotify.com"
+)
+
 // Close closes the client.
 func (c *client) Close() error {
 	c.mutex.Lock()
@@ -61,12 +72,7 @@ func (c *client) Open() proxy.Client {
 	}
 
 	authenticator := c.spotify.NewAuthenticator(
-		spotifyauth.WithScopes(
-			spotifyauth.ScopeUserFollowRead,
-			spotifyauth.ScopeUserFollowModify,
-			spotifyauth.ScopeUserLibraryRead,
-			spotifyauth.ScopeUserLibraryModify,
-		),
+		spotifyauth.WithScopes(Scopes...),
 		spotifyauth.WithClientID(c.config.SpotifyID),
 		spotifyauth.WithClientSecret(c.config.SpotifySecret),
 		spotifyauth.WithRedirectURL(c.config.SpotifyRedirectUri),
@@ -77,7 +83,8 @@ func (c *client) Open() proxy.Client {
 		RefreshToken: c.config.SpotifyRefreshToken,
 	}
 
-	c.client = c.spotify.NewClient(authenticator.Client(c.context, tok))
+	c.client = c.spotify.NewClient(authenticator.Client(c.httpContext(c.context), tok), c.clientOptions()...)
+	logger.DebugContext(c.context, "opened the client", slog.Any("config", c.config))
 
 	return c.client
 }
@@ -88,6 +95,7 @@ func (c *client) UpdateConfig(config *ClientConfig) {
 	defer c.mutex.Unlock()
 
 	c.config = config
+	logger.DebugContext(c.context, "updated the client config", slog.Any("config", config))
 }
 
 // Auth authenticates the client.
@@ -103,19 +111,18 @@ func (c *client) Auth(authUrlChan chan<- string, version string) (proxy.Client,
 	defer cancel()
 
 	authenticator := c.spotify.NewAuthenticator(
-		spotifyauth.WithScopes(
-			spotifyauth.ScopeUserFollowRead,
-			spotifyauth.ScopeUserFollowModify,
-			spotifyauth.ScopeUserLibraryRead,
-			spotifyauth.ScopeUserLibraryModify,
-		),
+		spotifyauth.WithScopes(Scopes...),
 		spotifyauth.WithClientID(c.config.SpotifyID),
 		spotifyauth.WithClientSecret(c.config.SpotifySecret),
 		spotifyauth.WithRedirectURL(c.config.SpotifyRedirectUri),
 	)
 
 	state := c.randstr.Hex(11)
-	authUrlChan <- authenticator.AuthURL(state)
+	authUrl := authenticator.AuthURL(state)
+	if c.config.SpotifyAccountsBaseUrl != "" {
+		authUrl = strings.Replace(authUrl, spotifyAccountsUrl, strings.TrimSuffix(c.config.SpotifyAccountsBaseUrl, "/"), 1)
+	}
+	authUrlChan <- authUrl
 
 	uri, err := c.url.Parse(c.config.SpotifyRedirectUri)
 	if err != nil {
@@ -150,7 +157,7 @@ func (c *client) Auth(authUrlChan chan<- string, version string) (proxy.Client,
 			}
 		}()
 
-		tok, err := authenticator.Token(r.Context(), state, r)
+		tok, err := authenticator.Token(c.httpContext(r.Context()), state, r)
 		if err != nil {
 			errChan <- err
 			http.Error(w, "authentication failed: "+err.Error(), http.StatusForbidden)
@@ -163,7 +170,7 @@ func (c *client) Auth(authUrlChan chan<- string, version string) (proxy.Client,
 			return
 		}
 
-		client = c.spotify.NewClient(authenticator.Client(r.Context(), tok))
+		client = c.spotify.NewClient(authenticator.Client(c.httpContext(r.Context()), tok), c.clientOptions()...)
 		refreshToken = tok.RefreshToken
 		clientChan <- client
 
@@ -232,3 +239,86 @@ func (c *client) Auth(authUrlChan chan<- string, version string) (proxy.Client,
 
 	return client, refreshToken, nil
 }
+
+// clientOptions returns the options of the Spotify client built from the client configuration.
+func (c *client) clientOptions() []spotify.ClientOption {
+	var opts []spotify.ClientOption
+	if c.config.SpotifyApiBaseUrl != "" {
+		opts = append(opts, spotify.WithBaseURL(strings.TrimSuffix(c.config.SpotifyApiBaseUrl, "/")+"/"))
+	}
+
+	return opts
+}
+
+// httpContext returns the context to send the requests through the transports built from the client configuration.
+func (c *client) httpContext(ctx context.Context) context.Context {
+	transport, customized := c.transport(ctx)
+	if !customized {
+		return ctx
+	}
+
+	return context.WithValue(
+		ctx,
+		oauth2.HTTPClient,
+		&http.Client{
+			Transport: transport,
+		},
+	)
+}
+
+// transport returns the transport built from the client configuration and whether it is customized from the default one.
+func (c *client) transport(ctx context.Context) (http.RoundTripper, bool) {
+	var transport http.RoundTripper = http.DefaultTransport
+	customized := false
+	if isLogging(ctx) && c.config.ReplayDir == "" {
+		// the requests are logged after rewritten to the accounts base url, so that the logs show the real destination
+		transport = newLoggingTransport(transport)
+		customized = true
+	}
+	if c.config.SpotifyAccountsBaseUrl != "" {
+		if base, err := url.Parse(strings.TrimSuffix(c.config.SpotifyAccountsBaseUrl, "/")); err == nil {
+			transport = &accountsTransport{
+				base:      base,
+				transport: transport,
+			}
+			customized = true
+		}
+	}
+	if c.config.CacheDir != "" && c.config.CacheTTL > 0 {
+		transport = newEtagTransport(cache.NewCache(c.config.CacheDir), c.config.CacheTTL, transport)
+		customized = true
+	}
+	if c.config.ReplayDir != "" {
+		transport = newReplayTransport(c.config.ReplayDir)
+		if isLogging(ctx) {
+			transport = newLoggingTransport(transport)
+		}
+		customized = true
+	} else if c.config.RecordDir != "" {
+		transport = newRecordTransport(c.config.RecordDir, transport)
+		customized = true
+	}
+
+	return transport, customized
+}
+
+// accountsTransport is a struct that rewrites the requests to the Spotify accounts service to the base URL.
+type accountsTransport struct {
+	base      *url.URL
+	transport http.RoundTripper
+}
+
+// RoundTrip sends the request to the base URL if the request is for the Spotify accounts service.
+func (t *accountsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isAccountsRequest(req) {
+		return t.transport.RoundTrip(req)
+	}
+
+	rewritten := req.Clone(req.Context())
+	rewritten.URL.Scheme = t.base.Scheme
+	rewritten.URL.Host = t.base.Host
+	rewritten.URL.Path = t.base.Path + req.URL.Path
+	rewritten.Host = t.base.Host
+
+	return t.transport.RoundTrip(rewritten)
+}
@@ -1,6 +1,8 @@
 package api
 
-import ()
+import (
+	"time"
+)
 
 // ClientConfig is a struct that contains the configuration of the client.
 type ClientConfig struct {
@@ -12,4 +14,16 @@ type ClientConfig struct {
 	SpotifyRedirectUri string
 	// SpotifyRefreshToken is the Spotify refresh token.
 	SpotifyRefreshToken string
+	// SpotifyApiBaseUrl is the base URL of the Spotify Web API to override the default one.
+	SpotifyApiBaseUrl string
+	// SpotifyAccountsBaseUrl is the base URL of the Spotify accounts service to override the default one.
+	SpotifyAccountsBaseUrl string
+	// CacheDir is the directory of the cache to revalidate the responses of the Spotify Web API with the ETag.
+	CacheDir string
+	// CacheTTL is the time to live of the responses stored to revalidate them with the ETag, which are not stored if it is not positive.
+	CacheTTL time.Duration
+	// RecordDir is the directory of the cassette to record the requests and the responses to the Spotify Web API.
+	RecordDir string
+	// ReplayDir is the directory of the cassette to replay the responses instead of sending the requests.
+	ReplayDir string
 }
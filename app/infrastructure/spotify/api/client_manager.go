@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"sync"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
@@ -106,6 +107,7 @@ func (cm *clientManager) CloseClient() error {
 			return err
 		}
 		cm.client = nil
+		logger.Debug("closed the client")
 	}
 
 	return nil
@@ -117,7 +119,7 @@ func (cm *clientManager) GetClient() (Client, error) {
 	defer cm.mutex.RUnlock()
 
 	if cm.client == nil {
-		return nil, errors.New("client not initialized")
+		return nil, ErrClientNotInitialized
 	}
 
 	return cm.client, nil
@@ -131,6 +133,7 @@ func (cm *clientManager) InitializeClient(ctx context.Context, config *ClientCon
 	if cm.client != nil {
 		return errors.New("client already initialized")
 	}
+	logger.DebugContext(ctx, "initializing the client", slog.Any("config", config))
 
 	cm.client = &client{
 		spotify: cm.spotify,
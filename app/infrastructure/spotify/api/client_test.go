@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"errors"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -1008,3 +1010,211 @@ func Test_client_Auth(t *testing.T) {
 		})
 	}
 }
+
+func Test_client_clientOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *ClientConfig
+		want   int
+	}{
+		{
+			name:   "positive testing (api base url is not specified)",
+			config: &ClientConfig{},
+			want:   0,
+		},
+		{
+			name: "positive testing (api base url is specified)",
+			config: &ClientConfig{
+				SpotifyApiBaseUrl: "http://localhost:8080/v1",
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &client{
+				config: tt.config,
+			}
+			if got := c.clientOptions(); len(got) != tt.want {
+				t.Errorf("client.clientOptions() length = %v, want %v", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func Test_client_httpContext(t *testing.T) {
+	origLogger := logger
+	defer func() {
+		logger = origLogger
+	}()
+
+	tests := []struct {
+		name          string
+		config        *ClientConfig
+		verbose       bool
+		wantTransport string
+	}{
+		{
+			name:          "positive testing (nothing is specified)",
+			config:        &ClientConfig{},
+			wantTransport: "",
+		},
+		{
+			name: "positive testing (accounts base url is specified)",
+			config: &ClientConfig{
+				SpotifyAccountsBaseUrl: "http://localhost:8080/accounts/",
+			},
+			wantTransport: "accounts",
+		},
+		{
+			name: "positive testing (record dir is specified)",
+			config: &ClientConfig{
+				SpotifyAccountsBaseUrl: "http://localhost:8080/accounts/",
+				RecordDir:              "test_record_dir",
+			},
+			wantTransport: "record",
+		},
+		{
+			name: "positive testing (replay dir is specified)",
+			config: &ClientConfig{
+				RecordDir: "test_record_dir",
+				ReplayDir: "test_replay_dir",
+			},
+			wantTransport: "replay",
+		},
+		{
+			name: "positive testing (accounts base url is specified with logging)",
+			config: &ClientConfig{
+				SpotifyAccountsBaseUrl: "http://localhost:8080/accounts/",
+			},
+			verbose:       true,
+			wantTransport: "accounts with logging",
+		},
+		{
+			name: "positive testing (replay dir is specified with logging)",
+			config: &ClientConfig{
+				ReplayDir: "test_replay_dir",
+			},
+			verbose:       true,
+			wantTransport: "replay with logging",
+		},
+		{
+			name: "negative testing (accounts base url is invalid)",
+			config: &ClientConfig{
+				SpotifyAccountsBaseUrl: "http://[::1",
+			},
+			wantTransport: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level := slog.LevelWarn
+			if tt.verbose {
+				level = slog.LevelInfo
+			}
+			SetLogger(NewLogger(io.Discard, level))
+			c := &client{
+				config: tt.config,
+			}
+			got := c.httpContext(context.Background())
+			httpClient, ok := got.Value(oauth2.HTTPClient).(*http.Client)
+			if ok != (tt.wantTransport != "") {
+				t.Errorf("client.httpContext() customized = %v, want %v", ok, tt.wantTransport != "")
+				return
+			}
+			switch tt.wantTransport {
+			case "accounts":
+				transport, ok := httpClient.Transport.(*accountsTransport)
+				if !ok || transport.base.String() != "http://localhost:8080/accounts" {
+					t.Errorf("client.httpContext() transport = %v, want the transport to http://localhost:8080/accounts", httpClient.Transport)
+				}
+			case "record":
+				transport, ok := httpClient.Transport.(*recordTransport)
+				if !ok || transport.dir != "test_record_dir" {
+					t.Errorf("client.httpContext() transport = %v, want the transport recording to test_record_dir", httpClient.Transport)
+					return
+				}
+				if _, ok := transport.transport.(*accountsTransport); !ok {
+					t.Errorf("client.httpContext() record transport wraps %v, want the accounts transport", transport.transport)
+				}
+			case "replay":
+				transport, ok := httpClient.Transport.(*replayTransport)
+				if !ok || transport.dir != "test_replay_dir" {
+					t.Errorf("client.httpContext() transport = %v, want the transport replaying from test_replay_dir", httpClient.Transport)
+				}
+			case "accounts with logging":
+				transport, ok := httpClient.Transport.(*accountsTransport)
+				if !ok {
+					t.Errorf("client.httpContext() transport = %v, want the accounts transport", httpClient.Transport)
+					return
+				}
+				if _, ok := transport.transport.(*loggingTransport); !ok {
+					t.Errorf("client.httpContext() accounts transport wraps %v, want the logging transport", transport.transport)
+				}
+			case "replay with logging":
+				transport, ok := httpClient.Transport.(*loggingTransport)
+				if !ok {
+					t.Errorf("client.httpContext() transport = %v, want the logging transport", httpClient.Transport)
+					return
+				}
+				if _, ok := transport.transport.(*replayTransport); !ok {
+					t.Errorf("client.httpContext() logging transport wraps %v, want the replay transport", transport.transport)
+				}
+			}
+		})
+	}
+}
+
+func Test_accountsTransport_RoundTrip(t *testing.T) {
+	base, err := url.Parse("http://localhost:8080/accounts")
+	if err != nil {
+		t.Errorf("Failed to parse url: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		wantUrl string
+	}{
+		{
+			name:    "positive testing (request to the accounts service)",
+			url:     "https://accounts.spotify.com/api/token",
+			wantUrl: "http://localhost:8080/accounts/api/token",
+		},
+		{
+			name:    "positive testing (request to the other service)",
+			url:     "https://api.spotify.com/v1/search",
+			wantUrl: "https://api.spotify.com/v1/search",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUrl string
+			tr := &accountsTransport{
+				base: base,
+				transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					gotUrl = req.URL.String()
+					return &http.Response{StatusCode: http.StatusOK}, nil
+				}),
+			}
+			req, err := http.NewRequest(http.MethodPost, tt.url, nil)
+			if err != nil {
+				t.Errorf("Failed to create a request: %v", err)
+			}
+			if _, err := tr.RoundTrip(req); err != nil {
+				t.Errorf("accountsTransport.RoundTrip() error = %v", err)
+			}
+			if gotUrl != tt.wantUrl {
+				t.Errorf("accountsTransport.RoundTrip() url = %v, want %v", gotUrl, tt.wantUrl)
+			}
+		})
+	}
+}
+
+// roundTripperFunc is a function that implements the http.RoundTripper interface for testing.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls the function itself.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"golang.org/x/oauth2"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+var (
+	// ErrNotAuthenticated is the error that the client is not authenticated or the token is invalid.
+	ErrNotAuthenticated = errors.New("not authenticated")
+	// ErrNotFound is the error that the requested resource is not found.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited is the error that the requests are rate limited.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrForbidden is the error that the request is forbidden such as the scope is missing.
+	ErrForbidden = errors.New("forbidden")
+	// ErrCanceled is the error that the operation is canceled by the user.
+	ErrCanceled = errors.New("canceled")
+	// ErrClientNotInitialized is the error that the client is not initialized yet.
+	ErrClientNotInitialized = NewError(ErrNotAuthenticated, errors.New("client not initialized"))
+)
+
+// Error is a struct that contains the original error classified into one of the sentinel errors.
+type Error struct {
+	// Kind is the sentinel error that the error is classified into.
+	Kind error
+	// Err is the original error.
+	Err error
+}
+
+// NewError returns a new instance of the Error struct.
+func NewError(kind error, err error) *Error {
+	return &Error{
+		Kind: kind,
+		Err:  err,
+	}
+}
+
+// Error returns the message of the original error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns both the sentinel error and the original error to be checked by errors.Is and errors.As.
+func (e *Error) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// WrapError classifies the error returned by the Spotify Web API into one of the sentinel errors.
+// The error which is not classified is returned as it is.
+func WrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	var spotifyErr spotify.Error
+	if errors.As(err, &spotifyErr) {
+		switch spotifyErr.Status {
+		case http.StatusUnauthorized:
+			return NewError(ErrNotAuthenticated, err)
+		case http.StatusForbidden:
+			return NewError(ErrForbidden, err)
+		case http.StatusNotFound:
+			return NewError(ErrNotFound, err)
+		case http.StatusTooManyRequests:
+			return NewError(ErrRateLimited, err)
+		}
+	}
+
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return NewError(ErrNotAuthenticated, err)
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return NewError(ErrCanceled, err)
+	}
+
+	return err
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestWrapError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantKind error
+		wantMsg  string
+	}{
+		{
+			name:     "positive testing (unauthorized)",
+			err:      spotify.Error{Message: "The access token expired", Status: http.StatusUnauthorized},
+			wantKind: ErrNotAuthenticated,
+			wantMsg:  "The access token expired",
+		},
+		{
+			name:     "positive testing (forbidden)",
+			err:      spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden},
+			wantKind: ErrForbidden,
+			wantMsg:  "Insufficient client scope",
+		},
+		{
+			name:     "positive testing (not found)",
+			err:      spotify.Error{Message: "Resource not found", Status: http.StatusNotFound},
+			wantKind: ErrNotFound,
+			wantMsg:  "Resource not found",
+		},
+		{
+			name:     "positive testing (rate limited)",
+			err:      spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests},
+			wantKind: ErrRateLimited,
+			wantMsg:  "API rate limit exceeded",
+		},
+		{
+			name:     "positive testing (token is not retrieved)",
+			err:      fmt.Errorf("Post \"https://accounts.spotify.com/api/token\": %w", &oauth2.RetrieveError{ErrorCode: "invalid_grant"}),
+			wantKind: ErrNotAuthenticated,
+			wantMsg:  "Post \"https://accounts.spotify.com/api/token\": oauth2: \"invalid_grant\"",
+		},
+		{
+			name:     "positive testing (context is canceled)",
+			err:      context.Canceled,
+			wantKind: ErrCanceled,
+			wantMsg:  "context canceled",
+		},
+		{
+			name:     "positive testing (already classified)",
+			err:      ErrClientNotInitialized,
+			wantKind: ErrNotAuthenticated,
+			wantMsg:  "client not initialized",
+		},
+		{
+			name:     "positive testing (not classified)",
+			err:      spotify.Error{Message: "Internal Server Error", Status: http.StatusInternalServerError},
+			wantKind: nil,
+			wantMsg:  "Internal Server Error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WrapError(tt.err)
+			if got.Error() != tt.wantMsg {
+				t.Errorf("WrapError() = %v, want %v", got.Error(), tt.wantMsg)
+			}
+			if !errors.Is(got, tt.err) {
+				t.Errorf("WrapError() = %v, want to wrap %v", got, tt.err)
+			}
+			for _, kind := range []error{ErrNotAuthenticated, ErrForbidden, ErrNotFound, ErrRateLimited, ErrCanceled} {
+				if errors.Is(got, kind) != (kind == tt.wantKind) {
+					t.Errorf("errors.Is(WrapError(), %v) = %v, want %v", kind, errors.Is(got, kind), kind == tt.wantKind)
+				}
+			}
+		})
+	}
+
+	if WrapError(nil) != nil {
+		t.Errorf("WrapError(nil) = %v, want nil", WrapError(nil))
+	}
+}
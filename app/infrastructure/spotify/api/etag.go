@@ -0,0 +1,99 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+// etagEntry is a struct that contains the response stored to revalidate it with the ETag.
+type etagEntry struct {
+	// ETag is the ETag of the response.
+	ETag string `json:"etag"`
+	// Header is the header of the response.
+	Header http.Header `json:"header"`
+	// Body is the body of the response.
+	Body string `json:"body"`
+}
+
+// etagTransport is a struct that revalidates the responses of the Spotify Web API with the ETag.
+type etagTransport struct {
+	cache     cache.Cache
+	ttl       time.Duration
+	transport http.RoundTripper
+}
+
+// newEtagTransport returns a new instance of the etag transport.
+func newEtagTransport(cache cache.Cache, ttl time.Duration, transport http.RoundTripper) *etagTransport {
+	return &etagTransport{
+		cache:     cache,
+		ttl:       ttl,
+		transport: transport,
+	}
+}
+
+// RoundTrip sends the request with the ETag stored for it, and returns the stored response if it is not modified.
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isEtagCacheable(req) {
+		return t.transport.RoundTrip(req)
+	}
+
+	key := "etag:" + sanitizeUrl(req.URL)
+	var stored etagEntry
+	found, err := t.cache.Get(key, &stored)
+	if err == nil && found && stored.ETag != "" {
+		cloned := req.Clone(req.Context())
+		cloned.Header.Set("If-None-Match", stored.ETag)
+		req = cloned
+	} else {
+		found = false
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && found {
+		if err := res.Body.Close(); err != nil {
+			return nil, err
+		}
+		return newStoredResponse(req, http.StatusOK, stored.Header.Clone(), stored.Body), nil
+	}
+
+	etag := res.Header.Get("ETag")
+	if res.StatusCode != http.StatusOK || etag == "" {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Body.Close(); err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := res.Header.Clone()
+	for _, key := range sensitiveHeaderKeys {
+		header.Del(key)
+	}
+	// the cache is best-effort, so the failure to store the response is ignored
+	_ = t.cache.Set(key, &etagEntry{ETag: etag, Header: header, Body: string(body)}, t.ttl)
+
+	return res, nil
+}
+
+// isEtagCacheable checks if the response to the request can be stored to revalidate it with the ETag.
+// The requests to the current user and the user's library such as the liked-state checks are never stored.
+func isEtagCacheable(req *http.Request) bool {
+	return req.Method == http.MethodGet &&
+		!isAccountsRequest(req) &&
+		req.URL.Path != "/v1/me" &&
+		!strings.HasPrefix(req.URL.Path, "/v1/me/")
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+func Test_etagTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		url             string
+		ttl             time.Duration
+		wantIfNoneMatch string
+		wantStatus      int
+		wantBody        string
+	}{
+		{
+			name:            "positive testing (catalog request is revalidated)",
+			method:          http.MethodGet,
+			url:             "https://api.spotify.com/v1/albums/test_album_id",
+			ttl:             time.Hour,
+			wantIfNoneMatch: `"test_etag"`,
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"id":"test_album_id"}`,
+		},
+		{
+			name:            "positive testing (liked-state check is never revalidated)",
+			method:          http.MethodGet,
+			url:             "https://api.spotify.com/v1/me/albums/contains?ids=test_album_id",
+			ttl:             time.Hour,
+			wantIfNoneMatch: "",
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"id":"test_album_id"}`,
+		},
+		{
+			name:            "positive testing (current user is never revalidated)",
+			method:          http.MethodGet,
+			url:             "https://api.spotify.com/v1/me",
+			ttl:             time.Hour,
+			wantIfNoneMatch: "",
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"id":"test_album_id"}`,
+		},
+		{
+			name:            "positive testing (request other than get is never revalidated)",
+			method:          http.MethodPut,
+			url:             "https://api.spotify.com/v1/albums/test_album_id",
+			ttl:             time.Hour,
+			wantIfNoneMatch: "",
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"id":"test_album_id"}`,
+		},
+		{
+			name:            "positive testing (expired response is never revalidated)",
+			method:          http.MethodGet,
+			url:             "https://api.spotify.com/v1/albums/test_album_id",
+			ttl:             time.Nanosecond,
+			wantIfNoneMatch: "",
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"id":"test_album_id"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIfNoneMatch string
+			requests := 0
+			tr := newEtagTransport(cache.NewCache(t.TempDir()), tt.ttl, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requests++
+				gotIfNoneMatch = req.Header.Get("If-None-Match")
+				if gotIfNoneMatch == `"test_etag"` {
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Header:     http.Header{"Etag": []string{`"test_etag"`}},
+						Body:       io.NopCloser(strings.NewReader("")),
+					}, nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header: http.Header{
+						"Content-Type": []string{"application/json"},
+						"Etag":         []string{`"test_etag"`},
+					},
+					Body: io.NopCloser(strings.NewReader(`{"id":"test_album_id"}`)),
+				}, nil
+			}))
+			// the first request stores the response, and the second one revalidates it
+			for i := 0; i < 2; i++ {
+				if i == 1 {
+					// wait for the response stored with the short ttl to expire
+					time.Sleep(time.Millisecond)
+				}
+				req, err := http.NewRequest(tt.method, tt.url, nil)
+				if err != nil {
+					t.Errorf("Failed to create a request: %v", err)
+				}
+				res, err := tr.RoundTrip(req)
+				if err != nil {
+					t.Errorf("etagTransport.RoundTrip() error = %v", err)
+					return
+				}
+				if res.StatusCode != tt.wantStatus {
+					t.Errorf("etagTransport.RoundTrip() status = %v, want %v", res.StatusCode, tt.wantStatus)
+				}
+				body, err := io.ReadAll(res.Body)
+				if err != nil {
+					t.Errorf("Failed to read the body: %v", err)
+				}
+				if string(body) != tt.wantBody {
+					t.Errorf("etagTransport.RoundTrip() body = %v, want %v", string(body), tt.wantBody)
+				}
+			}
+			if gotIfNoneMatch != tt.wantIfNoneMatch {
+				t.Errorf("etagTransport.RoundTrip() If-None-Match = %v, want %v", gotIfNoneMatch, tt.wantIfNoneMatch)
+			}
+			if requests != 2 {
+				t.Errorf("etagTransport.RoundTrip() requests = %v, want 2", requests)
+			}
+		})
+	}
+}
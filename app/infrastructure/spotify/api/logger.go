@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// redacted is the value logged instead of the secrets.
+	redacted = "REDACTED"
+)
+
+var (
+	// logger is the logger of the Spotify Web API client, which discards the logs by default.
+	logger = slog.New(slog.DiscardHandler)
+	// sensitiveLogKeys is the keys of the log attributes whose values are always redacted.
+	sensitiveLogKeys = []string{"authorization", "access_token", "refresh_token", "client_secret", "code", "secret", "token"}
+	// sensitiveLogHeaderKeys is the keys of the request and response headers whose values are redacted in the logs.
+	sensitiveLogHeaderKeys = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+)
+
+// NewLogger returns a new logger writing the logs at the level or above in the text format.
+// The values of the attributes with the sensitive keys are always redacted.
+func NewLogger(writer io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(
+		slog.NewTextHandler(
+			writer,
+			&slog.HandlerOptions{
+				Level:       level,
+				ReplaceAttr: redactAttr,
+			},
+		),
+	)
+}
+
+// SetLogger sets the logger of the Spotify Web API client, or the one discarding the logs if nil.
+func SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = slog.New(slog.DiscardHandler)
+	}
+	logger = l
+}
+
+// Logger returns the logger of the Spotify Web API client.
+func Logger() *slog.Logger {
+	return logger
+}
+
+// redactAttr redacts the value of the attribute if its key is sensitive.
+func redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	key := strings.ToLower(attr.Key)
+	for _, sensitiveKey := range sensitiveLogKeys {
+		if key == sensitiveKey {
+			return slog.String(attr.Key, redacted)
+		}
+	}
+
+	return attr
+}
+
+// redactHeader returns a copy of the header whose sensitive values are redacted.
+func redactHeader(header http.Header) http.Header {
+	cloned := header.Clone()
+	for _, key := range sensitiveLogHeaderKeys {
+		if cloned.Get(key) != "" {
+			cloned.Set(key, redacted)
+		}
+	}
+
+	return cloned
+}
+
+// LogValue returns the client configuration to be logged with the secrets redacted.
+func (c *ClientConfig) LogValue() slog.Value {
+	secret := func(value string) string {
+		if value == "" {
+			return ""
+		}
+		return redacted
+	}
+
+	return slog.GroupValue(
+		slog.String("spotify_id", c.SpotifyID),
+		slog.String("spotify_secret", secret(c.SpotifySecret)),
+		slog.String("spotify_redirect_uri", c.SpotifyRedirectUri),
+		slog.String("spotify_refresh_token", secret(c.SpotifyRefreshToken)),
+		slog.String("spotify_api_base_url", c.SpotifyApiBaseUrl),
+		slog.String("spotify_accounts_base_url", c.SpotifyAccountsBaseUrl),
+		slog.String("cache_dir", c.CacheDir),
+		slog.String("record_dir", c.RecordDir),
+		slog.String("replay_dir", c.ReplayDir),
+	)
+}
+
+// loggingTransport is a struct that logs the requests to the Spotify Web API and the accounts service.
+type loggingTransport struct {
+	transport http.RoundTripper
+	lastKey   string
+	retries   int
+	mutex     *sync.Mutex
+}
+
+// newLoggingTransport returns a new instance of the logging transport.
+func newLoggingTransport(transport http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		transport: transport,
+		mutex:     &sync.Mutex{},
+	}
+}
+
+// RoundTrip sends the request and logs the method, the endpoint, the status, the latency and the retry count.
+// The request sent again right after its failure is counted as a retry.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := sanitizeUrl(req.URL)
+	retry := t.retry(req.Method + " " + endpoint)
+	logger.DebugContext(
+		req.Context(),
+		"sending the request",
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Any("header", redactHeader(req.Header)),
+	)
+
+	start := time.Now()
+	res, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		t.fail()
+		logger.ErrorContext(
+			req.Context(),
+			"request failed",
+			slog.String("method", req.Method),
+			slog.String("endpoint", endpoint),
+			slog.Duration("latency", latency),
+			slog.Int("retry", retry),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	level := slog.LevelInfo
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		t.fail()
+		level = slog.LevelWarn
+	} else {
+		t.succeed()
+	}
+	logger.Log(
+		req.Context(),
+		level,
+		"request",
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Int("status", res.StatusCode),
+		slog.Duration("latency", latency),
+		slog.Int("retry", retry),
+	)
+	logger.DebugContext(
+		req.Context(),
+		"received the response",
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Any("header", redactHeader(res.Header)),
+	)
+
+	return res, nil
+}
+
+// retry returns the retry count of the request identified by the key.
+func (t *loggingTransport) retry(key string) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if key != t.lastKey {
+		t.lastKey = key
+		t.retries = 0
+	}
+
+	return t.retries
+}
+
+// fail counts the next request with the same key as a retry.
+func (t *loggingTransport) fail() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.retries++
+}
+
+// succeed resets the retry count.
+func (t *loggingTransport) succeed() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lastKey = ""
+	t.retries = 0
+}
+
+// isLogging returns whether the logger writes the logs of the requests.
+func isLogging(ctx context.Context) bool {
+	return logger.Enabled(ctx, slog.LevelInfo)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// stubTransport is a transport returning the responses with the statuses in order.
+type stubTransport struct {
+	statuses []int
+}
+
+// RoundTrip returns the response with the next status, or the error if the status is zero.
+func (t *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := t.statuses[0]
+	t.statuses = t.statuses[1:]
+	if status == 0 {
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Set-Cookie": []string{"test_cookie"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestNewLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	l := NewLogger(buffer, slog.LevelInfo)
+	l.Debug("test debug")
+	l.Info("test info", slog.String("refresh_token", "test_refresh_token"), slog.String("Authorization", "Bearer test_access_token"), slog.String("id", "test_id"))
+
+	got := buffer.String()
+	for _, want := range []string{"test info", "refresh_token=REDACTED", "Authorization=REDACTED", "id=test_id"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("NewLogger() wrote %q, want to contain %q", got, want)
+		}
+	}
+	for _, notWant := range []string{"test debug", "test_refresh_token", "test_access_token"} {
+		if strings.Contains(got, notWant) {
+			t.Errorf("NewLogger() wrote %q, want not to contain %q", got, notWant)
+		}
+	}
+}
+
+func TestSetLogger(t *testing.T) {
+	origLogger := logger
+	defer func() {
+		logger = origLogger
+	}()
+
+	l := NewLogger(&bytes.Buffer{}, slog.LevelInfo)
+	SetLogger(l)
+	if Logger() != l {
+		t.Errorf("Logger() = %v, want %v", Logger(), l)
+	}
+	if !isLogging(context.Background()) {
+		t.Errorf("isLogging() = false, want true")
+	}
+
+	SetLogger(nil)
+	if isLogging(context.Background()) {
+		t.Errorf("isLogging() = true, want false after setting nil")
+	}
+}
+
+func TestClientConfig_LogValue(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	NewLogger(buffer, slog.LevelInfo).Info(
+		"test",
+		slog.Any("config", &ClientConfig{
+			SpotifyID:           "test_client_id",
+			SpotifySecret:       "test_client_secret",
+			SpotifyRefreshToken: "test_refresh_token",
+		}),
+	)
+
+	got := buffer.String()
+	for _, want := range []string{"config.spotify_id=test_client_id", "config.spotify_secret=REDACTED", "config.spotify_refresh_token=REDACTED"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ClientConfig.LogValue() logged %q, want to contain %q", got, want)
+		}
+	}
+	for _, notWant := range []string{"test_client_secret", "test_refresh_token"} {
+		if strings.Contains(got, notWant) {
+			t.Errorf("ClientConfig.LogValue() logged %q, want not to contain %q", got, notWant)
+		}
+	}
+}
+
+func Test_loggingTransport_RoundTrip(t *testing.T) {
+	origLogger := logger
+	defer func() {
+		logger = origLogger
+	}()
+
+	tests := []struct {
+		name     string
+		statuses []int
+		wantLogs []string
+	}{
+		{
+			name:     "positive testing",
+			statuses: []int{http.StatusOK},
+			wantLogs: []string{"level=INFO msg=request method=GET endpoint=https://api.spotify.com/v1/albums/test_album_id status=200"},
+		},
+		{
+			name:     "positive testing (retried after rate limited)",
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			wantLogs: []string{"level=WARN msg=request", "status=429", "retry=0", "status=200", "retry=1"},
+		},
+		{
+			name:     "negative testing (transport failed)",
+			statuses: []int{0},
+			wantLogs: []string{"level=ERROR msg=\"request failed\"", "error=\"connection refused\""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			SetLogger(NewLogger(buffer, slog.LevelDebug))
+			transport := newLoggingTransport(&stubTransport{statuses: tt.statuses})
+			for range tt.statuses {
+				req := &http.Request{
+					Method: http.MethodGet,
+					URL:    &url.URL{Scheme: "https", Host: "api.spotify.com", Path: "/v1/albums/test_album_id"},
+					Header: http.Header{"Authorization": []string{"Bearer test_access_token"}},
+				}
+				_, _ = transport.RoundTrip(req.WithContext(context.Background()))
+			}
+
+			got := buffer.String()
+			for _, want := range tt.wantLogs {
+				if !strings.Contains(got, want) {
+					t.Errorf("loggingTransport.RoundTrip() logged %q, want to contain %q", got, want)
+				}
+			}
+			for _, notWant := range []string{"test_access_token", "test_cookie"} {
+				if strings.Contains(got, notWant) {
+					t.Errorf("loggingTransport.RoundTrip() logged %q, want not to contain %q", got, notWant)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+)
+
+// ProgressFunc is a function type that is called back with the numbers of the done and total items of a long running operation.
+type ProgressFunc func(label string, done int, total int)
+
+// progressKey is the key of the context value carrying the ProgressFunc.
+type progressKey struct{}
+
+// WithProgress returns a copy of the context carrying the function called back with the progress.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// ReportProgress calls back the function carried by the context with the progress if any.
+func ReportProgress(ctx context.Context, label string, done int, total int) {
+	if fn, ok := ctx.Value(progressKey{}).(ProgressFunc); ok && fn != nil {
+		fn(label, done, total)
+	}
+}
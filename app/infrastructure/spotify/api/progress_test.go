@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestReportProgress(t *testing.T) {
+	type progress struct {
+		label string
+		done  int
+		total int
+	}
+	tests := []struct {
+		name     string
+		withFunc bool
+		want     []progress
+	}{
+		{
+			name:     "positive testing (with the progress function)",
+			withFunc: true,
+			want:     []progress{{label: "albums fetched", done: 1, total: 2}},
+		},
+		{
+			name:     "positive testing (without the progress function)",
+			withFunc: false,
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []progress
+			ctx := context.Background()
+			if tt.withFunc {
+				ctx = WithProgress(ctx, func(label string, done int, total int) {
+					got = append(got, progress{label: label, done: done, total: total})
+				})
+			}
+			ReportProgress(ctx, "albums fetched", 1, 2)
+			if len(got) != len(tt.want) || (len(got) != 0 && got[0] != tt.want[0]) {
+				t.Errorf("ReportProgress() reported %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/zmb3/spotify/v2/auth"
+)
+
+var (
+	// Scopes is the scopes requested in authenticating the client.
+	Scopes = []string{
+		spotifyauth.ScopeUserFollowRead,
+		spotifyauth.ScopeUserFollowModify,
+		spotifyauth.ScopeUserLibraryRead,
+		spotifyauth.ScopeUserLibraryModify,
+	}
+)
+
+// TokenStatus is a struct that contains the status of the access token refreshed with the refresh token.
+type TokenStatus struct {
+	// Scopes is the scopes granted to the access token, which is nil if the accounts service does not tell them.
+	Scopes []string
+	// ServerTime is the time of the accounts service, which is zero if the response has no date.
+	ServerTime time.Time
+}
+
+// CheckToken refreshes the access token with the refresh token in the configuration and returns its status.
+func CheckToken(ctx context.Context, config *ClientConfig) (*TokenStatus, error) {
+	if config.SpotifyRefreshToken == "" {
+		return nil, NewError(ErrNotAuthenticated, errors.New("refresh token is empty"))
+	}
+
+	authenticator := spotifyauth.New(
+		spotifyauth.WithScopes(Scopes...),
+		spotifyauth.WithClientID(config.SpotifyID),
+		spotifyauth.WithClientSecret(config.SpotifySecret),
+		spotifyauth.WithRedirectURL(config.SpotifyRedirectUri),
+	)
+
+	transport, _ := (&client{config: config}).transport(ctx)
+	recorder := &dateTransport{
+		transport: transport,
+		mutex:     &sync.Mutex{},
+	}
+	tok, err := authenticator.RefreshToken(
+		context.WithValue(
+			ctx,
+			oauth2.HTTPClient,
+			&http.Client{
+				Transport: recorder,
+			},
+		),
+		&oauth2.Token{
+			TokenType:    "bearer",
+			RefreshToken: config.SpotifyRefreshToken,
+		},
+	)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+
+	status := &TokenStatus{
+		ServerTime: recorder.date(),
+	}
+	if scope, ok := tok.Extra("scope").(string); ok {
+		status.Scopes = strings.Fields(scope)
+	}
+
+	return status, nil
+}
+
+// dateTransport is a struct that records the date of the last response.
+type dateTransport struct {
+	transport http.RoundTripper
+	last      time.Time
+	mutex     *sync.Mutex
+}
+
+// RoundTrip sends the request and records the date header of the response.
+func (t *dateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if date, err := http.ParseTime(res.Header.Get("Date")); err == nil {
+		t.mutex.Lock()
+		t.last = date
+		t.mutex.Unlock()
+	}
+
+	return res, nil
+}
+
+// date returns the date of the last response.
+func (t *dateTransport) date() time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.last
+}
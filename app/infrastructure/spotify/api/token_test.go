@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCheckToken(t *testing.T) {
+	serverTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/api/token" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		switch r.FormValue("refresh_token") {
+		case "test_refresh_token":
+			_, _ = w.Write([]byte(`{"access_token": "test_access_token", "token_type": "Bearer", "expires_in": 3600, "scope": "user-library-read user-library-modify"}`))
+		case "test_refresh_token_without_scope":
+			_, _ = w.Write([]byte(`{"access_token": "test_access_token", "token_type": "Bearer", "expires_in": 3600}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Invalid refresh token"}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		refreshToken string
+		want         *TokenStatus
+		wantErr      error
+	}{
+		{
+			name:         "positive testing",
+			refreshToken: "test_refresh_token",
+			want: &TokenStatus{
+				Scopes:     []string{"user-library-read", "user-library-modify"},
+				ServerTime: serverTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "positive testing (scope is not in the response)",
+			refreshToken: "test_refresh_token_without_scope",
+			want: &TokenStatus{
+				Scopes:     nil,
+				ServerTime: serverTime,
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "negative testing (refresh token is empty)",
+			refreshToken: "",
+			want:         nil,
+			wantErr:      ErrNotAuthenticated,
+		},
+		{
+			name:         "negative testing (refresh token is invalid)",
+			refreshToken: "test_invalid_refresh_token",
+			want:         nil,
+			wantErr:      ErrNotAuthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckToken(
+				context.Background(),
+				&ClientConfig{
+					SpotifyID:              "test_id",
+					SpotifySecret:          "test_secret",
+					SpotifyRedirectUri:     "http://localhost:8080/callback",
+					SpotifyRefreshToken:    tt.refreshToken,
+					SpotifyAccountsBaseUrl: server.URL + "/accounts",
+				},
+			)
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("CheckToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("CheckToken() = %v, want nil", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got.Scopes, tt.want.Scopes) {
+				t.Errorf("CheckToken() scopes = %v, want %v", got.Scopes, tt.want.Scopes)
+			}
+			if !got.ServerTime.Equal(tt.want.ServerTime) {
+				t.Errorf("CheckToken() server time = %v, want %v", got.ServerTime, tt.want.ServerTime)
+			}
+		})
+	}
+}
@@ -2,12 +2,26 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
 
 	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/pkg/proxy"
 	"github.com/zmb3/spotify/v2"
 )
 
+const (
+	// artistAlbumsPageLimit is the maximum number of the albums of the artist fetched at once.
+	artistAlbumsPageLimit = 50
+	// likedAlbumsPageLimit is the maximum number of the liked albums fetched at once.
+	likedAlbumsPageLimit = 50
+	// albumsCheckLimit is the maximum number of the albums checked whether they are liked at once.
+	albumsCheckLimit = 20
+)
+
 // albumRepository is a struct that implements the AlbumRepository interface.
 type albumRepository struct {
 	clientManager api.ClientManager
@@ -20,30 +34,71 @@ func NewAlbumRepository() albumDomain.AlbumRepository {
 	}
 }
 
-// FindByArtistId returns the albums by the artist ID.
-func (r *albumRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]*albumDomain.Album, error) {
+// albumTypes is the album types to filter the albums of the artist with by the relations of the albums to the artist.
+var albumTypes = map[string]spotify.AlbumType{
+	"album":       spotify.AlbumTypeAlbum,
+	"single":      spotify.AlbumTypeSingle,
+	"compilation": spotify.AlbumTypeCompilation,
+	"appears_on":  spotify.AlbumTypeAppearsOn,
+}
+
+// findArtistAlbums returns all the albums of the artist in the groups fetching them page by page.
+// The albums in all the groups are returned if no groups are specified.
+func findArtistAlbums(ctx context.Context, client proxy.Client, id spotify.ID, groups []string) ([]spotify.SimpleAlbum, error) {
+	var ts []spotify.AlbumType
+	for _, group := range groups {
+		if t, ok := albumTypes[group]; ok {
+			ts = append(ts, t)
+		}
+	}
+
+	var albums []spotify.SimpleAlbum
+	for {
+		page, err := client.GetArtistAlbums(ctx, id, ts, spotify.Limit(artistAlbumsPageLimit), spotify.Offset(len(albums)))
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		albums = append(albums, page.Albums...)
+
+		if page.Next == "" {
+			break
+		}
+		if len(page.Albums) == 0 {
+			return nil, errors.New("the albums of the artist " + id.String() + " were listed incompletely")
+		}
+	}
+
+	return albums, nil
+}
+
+// FindByArtistId returns all the albums by the artist ID in the groups fetching them page by page.
+// The albums in all the groups are returned if no groups are specified.
+func (r *albumRepository) FindByArtistId(ctx context.Context, id spotify.ID, groups ...string) ([]*albumDomain.Album, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.FindByArtistId", slog.String("id", id.String()), slog.String("groups", strings.Join(groups, ",")))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
 	}
 
 	client := c.Open()
-	result, err := client.GetArtistAlbums(ctx, id, nil)
+	result, err := findArtistAlbums(ctx, client, id, groups)
 	if err != nil {
 		return nil, err
 	}
 
 	var albums []*albumDomain.Album
-	for _, album := range result.Albums {
-		albums = append(
-			albums,
-			albumDomain.NewAlbum(
-				album.ID,
-				album.Name,
-				album.Artists,
-				album.ReleaseDateTime(),
-			),
+	for _, album := range result {
+		a := albumDomain.NewAlbum(
+			album.ID,
+			album.Name,
+			album.Artists,
+			album.ReleaseDateTime(),
 		)
+		a.Group = album.AlbumGroup
+		if a.Group == "" {
+			a.Group = album.AlbumType
+		}
+		albums = append(albums, a)
 	}
 
 	return albums, nil
@@ -51,6 +106,7 @@ func (r *albumRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]
 
 // FindById returns the album by the ID.
 func (r *albumRepository) FindById(ctx context.Context, id spotify.ID) (*albumDomain.Album, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.FindById", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -59,7 +115,7 @@ func (r *albumRepository) FindById(ctx context.Context, id spotify.ID) (*albumDo
 	client := c.Open()
 	album, err := client.GetAlbum(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	return albumDomain.NewAlbum(
@@ -72,6 +128,7 @@ func (r *albumRepository) FindById(ctx context.Context, id spotify.ID) (*albumDo
 
 // FindByNameLimit returns the album by the name with the limit.
 func (r *albumRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*albumDomain.Album, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.FindByNameLimit", slog.String("name", name), slog.Int("limit", limit))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -80,7 +137,7 @@ func (r *albumRepository) FindByNameLimit(ctx context.Context, name string, limi
 	client := c.Open()
 	result, err := client.Search(ctx, name, spotify.SearchTypeAlbum, spotify.Limit(limit))
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	var albums []*albumDomain.Album
@@ -99,8 +156,68 @@ func (r *albumRepository) FindByNameLimit(ctx context.Context, name string, limi
 	return albums, nil
 }
 
+// FindLiked returns all the albums liked by the user fetching them page by page.
+func (r *albumRepository) FindLiked(ctx context.Context) ([]*albumDomain.Album, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.FindLiked")
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var albums []*albumDomain.Album
+	for {
+		page, err := client.CurrentUsersAlbums(ctx, spotify.Limit(likedAlbumsPageLimit), spotify.Offset(len(albums)))
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		for _, saved := range page.Albums {
+			a := albumDomain.NewAlbum(
+				saved.ID,
+				saved.Name,
+				saved.Artists,
+				saved.ReleaseDateTime(),
+			)
+			// the time is left zero if Spotify returns it in an unexpected layout
+			a.LikedAt, _ = time.Parse(spotify.TimestampLayout, saved.AddedAt)
+			albums = append(albums, a)
+		}
+		api.ReportProgress(ctx, "liked albums fetched", len(albums), int(page.Total))
+
+		if page.Next == "" || len(page.Albums) == 0 {
+			break
+		}
+	}
+
+	return albums, nil
+}
+
+// AreLiked returns whether each of the albums is liked in the order of the IDs.
+func (r *albumRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.AreLiked", slog.Int("ids", len(ids)))
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var liked []bool
+	for start := 0; start < len(ids); start += albumsCheckLimit {
+		chunk := ids[start:min(start+albumsCheckLimit, len(ids))]
+		result, err := client.UserHasAlbums(ctx, chunk...)
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		liked = append(liked, result...)
+		api.ReportProgress(ctx, "albums checked", len(liked), len(ids))
+	}
+
+	return liked, nil
+}
+
 // IsLiked returns whether the album is liked.
 func (r *albumRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	api.Logger().DebugContext(ctx, "albumRepository.IsLiked", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return false, err
@@ -109,7 +226,7 @@ func (r *albumRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, err
 	client := c.Open()
 	result, err := client.UserHasAlbums(ctx, id)
 	if err != nil {
-		return false, err
+		return false, api.WrapError(err)
 	}
 
 	return result[0], nil
@@ -117,22 +234,32 @@ func (r *albumRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, err
 
 // Like likes the album.
 func (r *albumRepository) Like(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "albumRepository.Like", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.AddAlbumsToLibrary(ctx, id)
+	if err := client.AddAlbumsToLibrary(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
 
 // Unlike unlikes the album.
 func (r *albumRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "albumRepository.Unlike", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.RemoveAlbumsFromLibrary(ctx, id)
+	if err := client.RemoveAlbumsFromLibrary(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -59,15 +61,60 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 				},
 			},
 			ReleaseDate: expectedTime,
+			Group:       "album",
+		},
+		{
+			ID:   "test_single_id",
+			Name: "test_single_name",
+			Artists: []spotify.SimpleArtist{
+				{
+					ID:   "test_album_artist_id",
+					Name: "test_album_artist_name",
+				},
+			},
+			ReleaseDate: expectedTime,
+			Group:       "single",
 		},
 	}
 
 	type fields struct {
 		clientManager api.ClientManager
 	}
+	firstPage := &spotify.SimpleAlbumPage{
+		Albums: []spotify.SimpleAlbum{
+			{
+				ID:                   "test_album_id",
+				Name:                 "test_album_name",
+				Artists:              ma[0].Artists,
+				ReleaseDate:          "2000-01-01",
+				ReleaseDatePrecision: "day",
+				AlbumGroup:           "album",
+			},
+		},
+	}
+	firstPage.Next = "test_next"
+	firstPage.Total = 2
+	lastPage := &spotify.SimpleAlbumPage{
+		Albums: []spotify.SimpleAlbum{
+			{
+				ID:                   "test_single_id",
+				Name:                 "test_single_name",
+				Artists:              ma[1].Artists,
+				ReleaseDate:          "2000-01-01",
+				ReleaseDatePrecision: "day",
+				AlbumGroup:           "single",
+			},
+		},
+	}
+	lastPage.Total = 2
+	emptyPage := &spotify.SimpleAlbumPage{}
+	emptyPage.Next = "test_next"
+	emptyPage.Total = 2
+
 	type args struct {
-		ctx context.Context
-		id  spotify.ID
+		ctx    context.Context
+		id     spotify.ID
+		groups []string
 	}
 	tests := []struct {
 		name    string
@@ -91,7 +138,7 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
 				mockApiClient := proxy.NewMockClient(mockCtrl)
-				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil).Return(&spotify.SimpleAlbumPage{
+				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(&spotify.SimpleAlbumPage{
 					Albums: []spotify.SimpleAlbum{
 						{
 							ID:   "test_album_id",
@@ -104,6 +151,20 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 							},
 							ReleaseDate:          "2000-01-01",
 							ReleaseDatePrecision: "day",
+							AlbumGroup:           "album",
+						},
+						{
+							ID:   "test_single_id",
+							Name: "test_single_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_album_artist_id",
+									Name: "test_album_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+							AlbumType:            "single",
 						},
 					},
 				}, nil)
@@ -119,6 +180,37 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "positive testing (the albums span more than one page)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx:    context.Background(),
+				id:     spotify.ID("test"),
+				groups: []string{"album", "single"},
+			},
+			want:    ma,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				types := []spotify.AlbumType{spotify.AlbumTypeAlbum, spotify.AlbumTypeSingle}
+				gomock.InOrder(
+					mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, types, gomock.Any(), gomock.Any()).Return(firstPage, nil),
+					mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, types, gomock.Any(), gomock.Any()).Return(lastPage, nil),
+				)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 		{
 			name: "negative testing (r.clientManager.GetClient() failed)",
 			fields: fields{
@@ -154,7 +246,36 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
 				mockApiClient := proxy.NewMockClient(mockCtrl)
-				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil).Return(nil, errors.New("failed to get artist albums"))
+				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get artist albums"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (the albums were listed incompletely)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				gomock.InOrder(
+					mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(firstPage, nil),
+					mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(emptyPage, nil),
+				)
 				mockClient := api.NewMockClient(mockCtrl)
 				mockClient.EXPECT().Open().Return(mockApiClient)
 				mockClientManager := api.NewMockClientManager(mockCtrl)
@@ -183,7 +304,7 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 			r := &albumRepository{
 				clientManager: tt.fields.clientManager,
 			}
-			got, err := r.FindByArtistId(tt.args.ctx, tt.args.id)
+			got, err := r.FindByArtistId(tt.args.ctx, tt.args.id, tt.args.groups...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("albumRepository.FindByArtistId() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -196,7 +317,8 @@ func Test_albumRepository_FindByArtistId(t *testing.T) {
 				if got[i].ID != tt.want[i].ID ||
 					got[i].Name != tt.want[i].Name ||
 					!reflect.DeepEqual(got[i].Artists, tt.want[i].Artists) ||
-					!got[i].ReleaseDate.Equal(tt.want[i].ReleaseDate) {
+					!got[i].ReleaseDate.Equal(tt.want[i].ReleaseDate) ||
+					got[i].Group != tt.want[i].Group {
 					t.Errorf("albumRepository.FindByArtistId()[%d] = %v, want %v", i, got[i], tt.want[i])
 				}
 			}
@@ -528,6 +650,118 @@ func Test_albumRepository_FindByNameLimit(t *testing.T) {
 	}
 }
 
+func Test_albumRepository_AreLiked(t *testing.T) {
+	// the IDs are checked in the chunks of the limit
+	ids := make([]spotify.ID, 20+1)
+	for i := range ids {
+		ids[i] = spotify.ID(fmt.Sprintf("test_album_id_%d", i))
+	}
+	want := make([]bool, len(ids))
+	want[len(ids)-1] = true
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+		ids []spotify.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    want,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UserHasAlbums(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, ids ...spotify.ID) ([]bool, error) {
+						result := make([]bool, len(ids))
+						result[len(ids)-1] = len(ids) == 1
+						return result, nil
+					},
+				).Times(2)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (client.UserHasAlbums() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UserHasAlbums(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to check the albums"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			r := &albumRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.AreLiked(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("albumRepository.AreLiked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("albumRepository.AreLiked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_albumRepository_IsLiked(t *testing.T) {
 	type fields struct {
 		clientManager api.ClientManager
@@ -658,12 +892,13 @@ func Test_albumRepository_Like(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -736,6 +971,58 @@ func Test_albumRepository_Like(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.AddAlbumsToLibrary() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().AddAlbumsToLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.AddAlbumsToLibrary() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().AddAlbumsToLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -755,7 +1042,9 @@ func Test_albumRepository_Like(t *testing.T) {
 			err := r.Like(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("albumRepository.Like() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("albumRepository.Like() error = %v, want %v", err, tt.wantErrIs)
 			}
 		})
 	}
@@ -770,12 +1059,13 @@ func Test_albumRepository_Unlike(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -848,6 +1138,58 @@ func Test_albumRepository_Unlike(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.RemoveAlbumsFromLibrary() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().RemoveAlbumsFromLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.RemoveAlbumsFromLibrary() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().RemoveAlbumsFromLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -867,8 +1209,165 @@ func Test_albumRepository_Unlike(t *testing.T) {
 			err := r.Unlike(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("albumRepository.Unlike() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("albumRepository.Unlike() error = %v, want %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func Test_albumRepository_FindLiked(t *testing.T) {
+	firstPage := &spotify.SavedAlbumPage{
+		Albums: []spotify.SavedAlbum{
+			{
+				AddedAt: "2020-01-02T03:04:05Z",
+				FullAlbum: spotify.FullAlbum{
+					SimpleAlbum: spotify.SimpleAlbum{
+						ID:                   "test_album_id_1",
+						Name:                 "test_album_name_1",
+						ReleaseDate:          "2000-01-01",
+						ReleaseDatePrecision: "day",
+					},
+				},
+			},
+		},
+	}
+	firstPage.Next = "test_next"
+	firstPage.Total = 2
+	lastPage := &spotify.SavedAlbumPage{
+		Albums: []spotify.SavedAlbum{
+			{
+				AddedAt: "invalid",
+				FullAlbum: spotify.FullAlbum{
+					SimpleAlbum: spotify.SimpleAlbum{
+						ID:                   "test_album_id_2",
+						Name:                 "test_album_name_2",
+						ReleaseDate:          "2001",
+						ReleaseDatePrecision: "year",
+					},
+				},
+			},
+		},
+	}
+	lastPage.Total = 2
+	first := albumDomain.NewAlbum("test_album_id_1", "test_album_name_1", nil, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	first.LikedAt = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	last := albumDomain.NewAlbum("test_album_id_2", "test_album_name_2", nil, time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*albumDomain.Album
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    []*albumDomain.Album{first, last},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				gomock.InOrder(
+					mockApiClient.EXPECT().CurrentUsersAlbums(tt2.ctx, gomock.Any(), gomock.Any()).Return(firstPage, nil),
+					mockApiClient.EXPECT().CurrentUsersAlbums(tt2.ctx, gomock.Any(), gomock.Any()).Return(lastPage, nil),
+				)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.CurrentUsersAlbums() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUsersAlbums(tt2.ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get liked albums"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			r := &albumRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.FindLiked(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("albumRepository.FindLiked() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("albumRepository.FindLiked() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
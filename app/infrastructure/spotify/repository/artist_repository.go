@@ -2,12 +2,20 @@ package repository
 
 import (
 	"context"
+	"log/slog"
 
 	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/zmb3/spotify/v2"
 )
 
+const (
+	// followedArtistsPageLimit is the maximum number of the followed artists fetched at once.
+	followedArtistsPageLimit = 50
+	// artistsCheckLimit is the maximum number of the artists checked whether they are followed at once.
+	artistsCheckLimit = 50
+)
+
 // artistRepository is a struct that implements the ArtistRepository interface.
 type artistRepository struct {
 	clientManager api.ClientManager
@@ -22,6 +30,7 @@ func NewArtistRepository() artistDomain.ArtistRepository {
 
 // FindById returns the artist by the ID.
 func (r *artistRepository) FindById(ctx context.Context, id spotify.ID) (*artistDomain.Artist, error) {
+	api.Logger().DebugContext(ctx, "artistRepository.FindById", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -30,17 +39,21 @@ func (r *artistRepository) FindById(ctx context.Context, id spotify.ID) (*artist
 	client := c.Open()
 	artist, err := client.GetArtist(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
-	return artistDomain.NewArtist(
+	a := artistDomain.NewArtist(
 		artist.ID,
 		artist.Name,
-	), nil
+	)
+	a.Genres = artist.Genres
+
+	return a, nil
 }
 
 // FindByNameLimit returns the artist by the name with the limit.
 func (r *artistRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*artistDomain.Artist, error) {
+	api.Logger().DebugContext(ctx, "artistRepository.FindByNameLimit", slog.String("name", name), slog.Int("limit", limit))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -49,7 +62,7 @@ func (r *artistRepository) FindByNameLimit(ctx context.Context, name string, lim
 	client := c.Open()
 	result, err := client.Search(ctx, name, spotify.SearchTypeArtist, spotify.Limit(limit))
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	var artists []*artistDomain.Artist
@@ -66,8 +79,67 @@ func (r *artistRepository) FindByNameLimit(ctx context.Context, name string, lim
 	return artists, nil
 }
 
+// FindFollowed returns all the artists followed by the user fetching them page by page.
+func (r *artistRepository) FindFollowed(ctx context.Context) ([]*artistDomain.Artist, error) {
+	api.Logger().DebugContext(ctx, "artistRepository.FindFollowed")
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var artists []*artistDomain.Artist
+	opts := []spotify.RequestOption{spotify.Limit(followedArtistsPageLimit)}
+	for {
+		page, err := client.CurrentUsersFollowedArtists(ctx, opts...)
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		for _, artist := range page.Artists {
+			a := artistDomain.NewArtist(
+				artist.ID,
+				artist.Name,
+			)
+			a.Genres = artist.Genres
+			artists = append(artists, a)
+		}
+		api.ReportProgress(ctx, "followed artists fetched", len(artists), int(page.Total))
+
+		if page.Next == "" || page.Cursor.After == "" {
+			break
+		}
+		opts = []spotify.RequestOption{spotify.Limit(followedArtistsPageLimit), spotify.After(page.Cursor.After)}
+	}
+
+	return artists, nil
+}
+
+// AreLiked returns whether each of the artists is followed in the order of the IDs.
+func (r *artistRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	api.Logger().DebugContext(ctx, "artistRepository.AreLiked", slog.Int("ids", len(ids)))
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var liked []bool
+	for start := 0; start < len(ids); start += artistsCheckLimit {
+		chunk := ids[start:min(start+artistsCheckLimit, len(ids))]
+		result, err := client.CurrentUserFollows(ctx, "artist", chunk...)
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		liked = append(liked, result...)
+		api.ReportProgress(ctx, "artists checked", len(liked), len(ids))
+	}
+
+	return liked, nil
+}
+
 // IsLiked returns whether the artist is liked.
 func (r *artistRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	api.Logger().DebugContext(ctx, "artistRepository.IsLiked", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return false, err
@@ -76,7 +148,7 @@ func (r *artistRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, er
 	client := c.Open()
 	result, err := client.CurrentUserFollows(ctx, "artist", id)
 	if err != nil {
-		return false, err
+		return false, api.WrapError(err)
 	}
 
 	return result[0], nil
@@ -84,22 +156,32 @@ func (r *artistRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, er
 
 // Like likes the artist.
 func (r *artistRepository) Like(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "artistRepository.Like", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.FollowArtist(ctx, id)
+	if err := client.FollowArtist(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
 
 // Unlike unlikes the artist.
 func (r *artistRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "artistRepository.Unlike", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.UnfollowArtist(ctx, id)
+	if err := client.UnfollowArtist(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 
@@ -44,8 +46,9 @@ func TestNewArtistRepository(t *testing.T) {
 
 func Test_artistRepository_FindById(t *testing.T) {
 	ma := &artistDomain.Artist{
-		ID:   "test_artist_id",
-		Name: "test_artist_name",
+		ID:     "test_artist_id",
+		Name:   "test_artist_name",
+		Genres: []string{"test_genre"},
 	}
 
 	type fields struct {
@@ -82,6 +85,7 @@ func Test_artistRepository_FindById(t *testing.T) {
 						ID:   "test_artist_id",
 						Name: "test_artist_name",
 					},
+					Genres: []string{"test_genre"},
 				}, nil)
 				mockClient := api.NewMockClient(mockCtrl)
 				mockClient.EXPECT().Open().Return(mockApiClient)
@@ -328,6 +332,254 @@ func Test_artistRepository_FindByNameLimit(t *testing.T) {
 	}
 }
 
+func Test_artistRepository_FindFollowed(t *testing.T) {
+	firstPage := &spotify.FullArtistCursorPage{
+		Artists: []spotify.FullArtist{
+			{SimpleArtist: spotify.SimpleArtist{ID: "test_artist_id_1", Name: "test_artist_name_1"}, Genres: []string{"test_genre"}},
+		},
+	}
+	firstPage.Next = "test_next"
+	firstPage.Total = 2
+	firstPage.Cursor.After = "test_artist_id_1"
+	lastPage := &spotify.FullArtistCursorPage{
+		Artists: []spotify.FullArtist{
+			{SimpleArtist: spotify.SimpleArtist{ID: "test_artist_id_2", Name: "test_artist_name_2"}},
+		},
+	}
+	lastPage.Total = 2
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*artistDomain.Artist
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: []*artistDomain.Artist{
+				{ID: "test_artist_id_1", Name: "test_artist_name_1", Genres: []string{"test_genre"}},
+				artistDomain.NewArtist("test_artist_id_2", "test_artist_name_2"),
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				gomock.InOrder(
+					mockApiClient.EXPECT().CurrentUsersFollowedArtists(tt2.ctx, gomock.Any()).Return(firstPage, nil),
+					mockApiClient.EXPECT().CurrentUsersFollowedArtists(tt2.ctx, gomock.Any(), gomock.Any()).Return(lastPage, nil),
+				)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.CurrentUsersFollowedArtists() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUsersFollowedArtists(tt2.ctx, gomock.Any()).Return(nil, errors.New("failed to get followed artists"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			r := &artistRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.FindFollowed(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("artistRepository.FindFollowed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("artistRepository.FindFollowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_artistRepository_AreLiked(t *testing.T) {
+	// the IDs are checked in the chunks of the limit
+	ids := make([]spotify.ID, 50+1)
+	for i := range ids {
+		ids[i] = spotify.ID(fmt.Sprintf("test_artist_id_%d", i))
+	}
+	want := make([]bool, len(ids))
+	want[len(ids)-1] = true
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+		ids []spotify.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    want,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, ids ...spotify.ID) ([]bool, error) {
+						result := make([]bool, len(ids))
+						result[len(ids)-1] = len(ids) == 1
+						return result, nil
+					},
+				).Times(2)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (client.CurrentUserFollows() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", gomock.Any()).Return(nil, errors.New("failed to check the artists"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			r := &artistRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.AreLiked(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("artistRepository.AreLiked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("artistRepository.AreLiked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_artistRepository_IsLiked(t *testing.T) {
 	type fields struct {
 		clientManager api.ClientManager
@@ -456,12 +708,13 @@ func Test_artistRepository_Like(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -534,6 +787,58 @@ func Test_artistRepository_Like(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.FollowArtist() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().FollowArtist(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.FollowArtist() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().FollowArtist(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -553,7 +858,9 @@ func Test_artistRepository_Like(t *testing.T) {
 			err := r.Like(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("artistRepository.Like() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("artistRepository.Like() error = %v, want %v", err, tt.wantErrIs)
 			}
 		})
 	}
@@ -568,12 +875,13 @@ func Test_artistRepository_Unlike(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -646,6 +954,58 @@ func Test_artistRepository_Unlike(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.UnfollowArtist() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UnfollowArtist(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.UnfollowArtist() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UnfollowArtist(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -665,7 +1025,9 @@ func Test_artistRepository_Unlike(t *testing.T) {
 			err := r.Unlike(tt.args.ctx, tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("artistRepository.Unlike() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("artistRepository.Unlike() error = %v, want %v", err, tt.wantErrIs)
 			}
 		})
 	}
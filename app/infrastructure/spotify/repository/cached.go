@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+)
+
+// cached returns the value of the key from the cache, or finds the value and stores it in the cache.
+// The cache is best-effort, so the failure to read or write it falls back to finding the value.
+func cached[T any](c cache.Cache, ttl time.Duration, key string, find func() (T, error)) (T, error) {
+	var value T
+	if ok, err := c.Get(key, &value); err == nil && ok {
+		api.Logger().Debug("cache hit", slog.String("key", key))
+		return value, nil
+	}
+	api.Logger().Debug("cache miss", slog.String("key", key))
+
+	value, err := find()
+	if err != nil {
+		return value, err
+	}
+	_ = c.Set(key, value, ttl)
+
+	return value, nil
+}
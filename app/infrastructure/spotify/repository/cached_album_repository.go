@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+// cachedAlbumRepository is a struct that implements the AlbumRepository interface caching the results of the lookups.
+type cachedAlbumRepository struct {
+	repo  albumDomain.AlbumRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCachedAlbumRepository returns a new instance of the cachedAlbumRepository struct.
+// The liked-state checks, likes and unlikes are never cached and delegated to the repository as they are.
+func NewCachedAlbumRepository(repo albumDomain.AlbumRepository, cache cache.Cache, ttl time.Duration) albumDomain.AlbumRepository {
+	return &cachedAlbumRepository{
+		repo:  repo,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+// FindByArtistId returns the albums by the artist ID in the groups from the cache or the repository.
+func (r *cachedAlbumRepository) FindByArtistId(ctx context.Context, id spotify.ID, groups ...string) ([]*albumDomain.Album, error) {
+	key := "album:artist:" + id.String()
+	if len(groups) != 0 {
+		key += ":" + strings.Join(groups, ",")
+	}
+	return cached(r.cache, r.ttl, key, func() ([]*albumDomain.Album, error) {
+		return r.repo.FindByArtistId(ctx, id, groups...)
+	})
+}
+
+// FindById returns the album by the ID from the cache or the repository.
+func (r *cachedAlbumRepository) FindById(ctx context.Context, id spotify.ID) (*albumDomain.Album, error) {
+	return cached(r.cache, r.ttl, "album:id:"+id.String(), func() (*albumDomain.Album, error) {
+		return r.repo.FindById(ctx, id)
+	})
+}
+
+// FindByNameLimit returns the albums by the name with the limit from the cache or the repository.
+func (r *cachedAlbumRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*albumDomain.Album, error) {
+	return cached(r.cache, r.ttl, "album:name:"+strconv.Itoa(limit)+":"+name, func() ([]*albumDomain.Album, error) {
+		return r.repo.FindByNameLimit(ctx, name, limit)
+	})
+}
+
+// FindLiked returns the albums liked by the user without the cache.
+func (r *cachedAlbumRepository) FindLiked(ctx context.Context) ([]*albumDomain.Album, error) {
+	return r.repo.FindLiked(ctx)
+}
+
+// AreLiked checks if each of the albums is liked without the cache.
+func (r *cachedAlbumRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	return r.repo.AreLiked(ctx, ids)
+}
+
+// IsLiked checks if the album is liked without the cache.
+func (r *cachedAlbumRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	return r.repo.IsLiked(ctx, id)
+}
+
+// Like likes the album.
+func (r *cachedAlbumRepository) Like(ctx context.Context, id spotify.ID) error {
+	return r.repo.Like(ctx, id)
+}
+
+// Unlike unlikes the album.
+func (r *cachedAlbumRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	return r.repo.Unlike(ctx, id)
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedAlbumRepository(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	repo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	c := cache.NewCache("test_dir")
+
+	want := &cachedAlbumRepository{
+		repo:  repo,
+		cache: c,
+		ttl:   time.Hour,
+	}
+	if got := NewCachedAlbumRepository(repo, c, time.Hour); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewCachedAlbumRepository() = %v, want %v", got, want)
+	}
+}
+
+func Test_cachedAlbumRepository_Find(t *testing.T) {
+	releaseDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	album := albumDomain.NewAlbum(
+		spotify.ID("test_album_id"),
+		"test_album_name",
+		[]spotify.SimpleArtist{{ID: spotify.ID("test_artist_id"), Name: "test_artist_name"}},
+		releaseDate,
+	)
+
+	tests := []struct {
+		name    string
+		find    func(r albumDomain.AlbumRepository) (any, error)
+		want    any
+		wantErr bool
+		setup   func(mockRepo *albumDomain.MockAlbumRepository)
+	}{
+		{
+			name: "positive testing (FindByArtistId)",
+			find: func(r albumDomain.AlbumRepository) (any, error) {
+				return r.FindByArtistId(context.Background(), spotify.ID("test_artist_id"))
+			},
+			want:    []*albumDomain.Album{album},
+			wantErr: false,
+			setup: func(mockRepo *albumDomain.MockAlbumRepository) {
+				mockRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id")).Return([]*albumDomain.Album{album}, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindByArtistId with the groups)",
+			find: func(r albumDomain.AlbumRepository) (any, error) {
+				return r.FindByArtistId(context.Background(), spotify.ID("test_artist_id"), "album", "single")
+			},
+			want:    []*albumDomain.Album{album},
+			wantErr: false,
+			setup: func(mockRepo *albumDomain.MockAlbumRepository) {
+				mockRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id"), "album", "single").Return([]*albumDomain.Album{album}, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindById)",
+			find: func(r albumDomain.AlbumRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_album_id"))
+			},
+			want:    album,
+			wantErr: false,
+			setup: func(mockRepo *albumDomain.MockAlbumRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_album_id")).Return(album, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindByNameLimit)",
+			find: func(r albumDomain.AlbumRepository) (any, error) {
+				return r.FindByNameLimit(context.Background(), "test_album_name", 10)
+			},
+			want:    []*albumDomain.Album{album},
+			wantErr: false,
+			setup: func(mockRepo *albumDomain.MockAlbumRepository) {
+				mockRepo.EXPECT().FindByNameLimit(gomock.Any(), "test_album_name", 10).Return([]*albumDomain.Album{album}, nil).Times(1)
+			},
+		},
+		{
+			name: "negative testing (FindById failed, the error is not cached)",
+			find: func(r albumDomain.AlbumRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_album_id"))
+			},
+			want:    (*albumDomain.Album)(nil),
+			wantErr: true,
+			setup: func(mockRepo *albumDomain.MockAlbumRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_album_id")).Return(nil, errors.New("FindById() failed")).Times(2)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+			tt.setup(mockRepo)
+			r := NewCachedAlbumRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+			// the second call is served from the cache unless the first one failed
+			for i := 0; i < 2; i++ {
+				got, err := tt.find(r)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("cachedAlbumRepository.Find() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("cachedAlbumRepository.Find() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_cachedAlbumRepository_AreLiked(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_album_id"}).Return([]bool{false}, nil)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+	r := NewCachedAlbumRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the liked-state checks are never cached
+	for _, want := range []bool{false, true} {
+		if liked, err := r.AreLiked(context.Background(), []spotify.ID{"test_album_id"}); err != nil || !reflect.DeepEqual(liked, []bool{want}) {
+			t.Errorf("cachedAlbumRepository.AreLiked() = %v, %v, want [%v], nil", liked, err, want)
+		}
+	}
+}
+
+func Test_cachedAlbumRepository_IsLikedAndLike(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id")).Return(false, nil)
+	mockRepo.EXPECT().Like(gomock.Any(), spotify.ID("test_album_id")).Return(nil)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_album_id")).Return(true, nil)
+	mockRepo.EXPECT().Unlike(gomock.Any(), spotify.ID("test_album_id")).Return(nil)
+	r := NewCachedAlbumRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_album_id")); err != nil || liked {
+		t.Errorf("cachedAlbumRepository.IsLiked() = %v, %v, want false, nil", liked, err)
+	}
+	if err := r.Like(context.Background(), spotify.ID("test_album_id")); err != nil {
+		t.Errorf("cachedAlbumRepository.Like() error = %v", err)
+	}
+	// the liked-state check is never cached
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_album_id")); err != nil || !liked {
+		t.Errorf("cachedAlbumRepository.IsLiked() = %v, %v, want true, nil", liked, err)
+	}
+	if err := r.Unlike(context.Background(), spotify.ID("test_album_id")); err != nil {
+		t.Errorf("cachedAlbumRepository.Unlike() error = %v", err)
+	}
+}
+
+func Test_cachedAlbumRepository_FindLiked(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+	mockRepo.EXPECT().FindLiked(gomock.Any()).Return([]*albumDomain.Album{albumDomain.NewAlbum("test_album_id", "test_album_name", nil, time.Time{})}, nil).Times(2)
+	r := NewCachedAlbumRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the liked albums are never cached
+	for range 2 {
+		if albums, err := r.FindLiked(context.Background()); err != nil || len(albums) != 1 {
+			t.Errorf("cachedAlbumRepository.FindLiked() = %v, %v, want 1 album, nil", albums, err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+// cachedArtistRepository is a struct that implements the ArtistRepository interface caching the results of the lookups.
+type cachedArtistRepository struct {
+	repo  artistDomain.ArtistRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCachedArtistRepository returns a new instance of the cachedArtistRepository struct.
+// The liked-state checks, likes and unlikes are never cached and delegated to the repository as they are.
+func NewCachedArtistRepository(repo artistDomain.ArtistRepository, cache cache.Cache, ttl time.Duration) artistDomain.ArtistRepository {
+	return &cachedArtistRepository{
+		repo:  repo,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+// FindById returns the artist by the ID from the cache or the repository.
+func (r *cachedArtistRepository) FindById(ctx context.Context, id spotify.ID) (*artistDomain.Artist, error) {
+	return cached(r.cache, r.ttl, "artist:id:"+id.String(), func() (*artistDomain.Artist, error) {
+		return r.repo.FindById(ctx, id)
+	})
+}
+
+// FindByNameLimit returns the artists by the name with the limit from the cache or the repository.
+func (r *cachedArtistRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*artistDomain.Artist, error) {
+	return cached(r.cache, r.ttl, "artist:name:"+strconv.Itoa(limit)+":"+name, func() ([]*artistDomain.Artist, error) {
+		return r.repo.FindByNameLimit(ctx, name, limit)
+	})
+}
+
+// FindFollowed returns the artists followed by the user without the cache.
+func (r *cachedArtistRepository) FindFollowed(ctx context.Context) ([]*artistDomain.Artist, error) {
+	return r.repo.FindFollowed(ctx)
+}
+
+// AreLiked checks if each of the artists is liked without the cache.
+func (r *cachedArtistRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	return r.repo.AreLiked(ctx, ids)
+}
+
+// IsLiked checks if the artist is liked without the cache.
+func (r *cachedArtistRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	return r.repo.IsLiked(ctx, id)
+}
+
+// Like likes the artist.
+func (r *cachedArtistRepository) Like(ctx context.Context, id spotify.ID) error {
+	return r.repo.Like(ctx, id)
+}
+
+// Unlike unlikes the artist.
+func (r *cachedArtistRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	return r.repo.Unlike(ctx, id)
+}
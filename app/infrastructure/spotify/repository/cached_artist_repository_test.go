@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedArtistRepository(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	repo := artistDomain.NewMockArtistRepository(mockCtrl)
+	c := cache.NewCache("test_dir")
+
+	want := &cachedArtistRepository{
+		repo:  repo,
+		cache: c,
+		ttl:   time.Hour,
+	}
+	if got := NewCachedArtistRepository(repo, c, time.Hour); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewCachedArtistRepository() = %v, want %v", got, want)
+	}
+}
+
+func Test_cachedArtistRepository_Find(t *testing.T) {
+	artist := artistDomain.NewArtist(
+		spotify.ID("test_artist_id"),
+		"test_artist_name",
+	)
+
+	tests := []struct {
+		name    string
+		find    func(r artistDomain.ArtistRepository) (any, error)
+		want    any
+		wantErr bool
+		setup   func(mockRepo *artistDomain.MockArtistRepository)
+	}{
+		{
+			name: "positive testing (FindById)",
+			find: func(r artistDomain.ArtistRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_artist_id"))
+			},
+			want:    artist,
+			wantErr: false,
+			setup: func(mockRepo *artistDomain.MockArtistRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id")).Return(artist, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindByNameLimit)",
+			find: func(r artistDomain.ArtistRepository) (any, error) {
+				return r.FindByNameLimit(context.Background(), "test_artist_name", 10)
+			},
+			want:    []*artistDomain.Artist{artist},
+			wantErr: false,
+			setup: func(mockRepo *artistDomain.MockArtistRepository) {
+				mockRepo.EXPECT().FindByNameLimit(gomock.Any(), "test_artist_name", 10).Return([]*artistDomain.Artist{artist}, nil).Times(1)
+			},
+		},
+		{
+			name: "negative testing (FindById failed, the error is not cached)",
+			find: func(r artistDomain.ArtistRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_artist_id"))
+			},
+			want:    (*artistDomain.Artist)(nil),
+			wantErr: true,
+			setup: func(mockRepo *artistDomain.MockArtistRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_artist_id")).Return(nil, errors.New("FindById() failed")).Times(2)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+			tt.setup(mockRepo)
+			r := NewCachedArtistRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+			// the second call is served from the cache unless the first one failed
+			for i := 0; i < 2; i++ {
+				got, err := tt.find(r)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("cachedArtistRepository.Find() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("cachedArtistRepository.Find() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_cachedArtistRepository_AreLiked(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_artist_id"}).Return([]bool{false}, nil)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_artist_id"}).Return([]bool{true}, nil)
+	r := NewCachedArtistRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the liked-state checks are never cached
+	for _, want := range []bool{false, true} {
+		if liked, err := r.AreLiked(context.Background(), []spotify.ID{"test_artist_id"}); err != nil || !reflect.DeepEqual(liked, []bool{want}) {
+			t.Errorf("cachedArtistRepository.AreLiked() = %v, %v, want [%v], nil", liked, err, want)
+		}
+	}
+}
+
+func Test_cachedArtistRepository_IsLikedAndLike(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_artist_id")).Return(false, nil)
+	mockRepo.EXPECT().Like(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_artist_id")).Return(true, nil)
+	mockRepo.EXPECT().Unlike(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+	r := NewCachedArtistRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_artist_id")); err != nil || liked {
+		t.Errorf("cachedArtistRepository.IsLiked() = %v, %v, want false, nil", liked, err)
+	}
+	if err := r.Like(context.Background(), spotify.ID("test_artist_id")); err != nil {
+		t.Errorf("cachedArtistRepository.Like() error = %v", err)
+	}
+	// the liked-state check is never cached
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_artist_id")); err != nil || !liked {
+		t.Errorf("cachedArtistRepository.IsLiked() = %v, %v, want true, nil", liked, err)
+	}
+	if err := r.Unlike(context.Background(), spotify.ID("test_artist_id")); err != nil {
+		t.Errorf("cachedArtistRepository.Unlike() error = %v", err)
+	}
+}
+
+func Test_cachedArtistRepository_FindFollowed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := artistDomain.NewMockArtistRepository(mockCtrl)
+	mockRepo.EXPECT().FindFollowed(gomock.Any()).Return([]*artistDomain.Artist{artistDomain.NewArtist("test_artist_id", "test_artist_name")}, nil).Times(2)
+	r := NewCachedArtistRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the followed artists are never cached
+	for range 2 {
+		if artists, err := r.FindFollowed(context.Background()); err != nil || len(artists) != 1 {
+			t.Errorf("cachedArtistRepository.FindFollowed() = %v, %v, want 1 artist, nil", artists, err)
+		}
+	}
+}
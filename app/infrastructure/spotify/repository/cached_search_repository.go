@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+// cachedSearchRepository is a struct that implements the SearchRepository interface caching the results of the searches.
+type cachedSearchRepository struct {
+	repo  searchDomain.SearchRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCachedSearchRepository returns a new instance of the cachedSearchRepository struct.
+func NewCachedSearchRepository(repo searchDomain.SearchRepository, cache cache.Cache, ttl time.Duration) searchDomain.SearchRepository {
+	return &cachedSearchRepository{
+		repo:  repo,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+// FindByNameLimit returns the artists, albums, and tracks by the name with the limit from the cache or the repository.
+func (r *cachedSearchRepository) FindByNameLimit(ctx context.Context, name string, searchType spotify.SearchType, limit int) (*searchDomain.SearchResult, error) {
+	return cached(r.cache, r.ttl, "search:name:"+strconv.Itoa(int(searchType))+":"+strconv.Itoa(limit)+":"+name, func() (*searchDomain.SearchResult, error) {
+		return r.repo.FindByNameLimit(ctx, name, searchType, limit)
+	})
+}
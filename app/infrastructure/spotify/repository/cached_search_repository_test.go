@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedSearchRepository(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	repo := searchDomain.NewMockSearchRepository(mockCtrl)
+	c := cache.NewCache("test_dir")
+
+	want := &cachedSearchRepository{
+		repo:  repo,
+		cache: c,
+		ttl:   time.Hour,
+	}
+	if got := NewCachedSearchRepository(repo, c, time.Hour); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewCachedSearchRepository() = %v, want %v", got, want)
+	}
+}
+
+func Test_cachedSearchRepository_FindByNameLimit(t *testing.T) {
+	searchType := spotify.SearchTypeArtist | spotify.SearchTypeAlbum
+	result := searchDomain.NewSearchResult(
+		[]*artistDomain.Artist{artistDomain.NewArtist(spotify.ID("test_artist_id"), "test_artist_name")},
+		nil,
+		nil,
+	)
+
+	tests := []struct {
+		name    string
+		want    *searchDomain.SearchResult
+		wantErr bool
+		setup   func(mockRepo *searchDomain.MockSearchRepository)
+	}{
+		{
+			name:    "positive testing",
+			want:    result,
+			wantErr: false,
+			setup: func(mockRepo *searchDomain.MockSearchRepository) {
+				mockRepo.EXPECT().FindByNameLimit(gomock.Any(), "test_name", searchType, 10).Return(result, nil).Times(1)
+			},
+		},
+		{
+			name:    "negative testing (FindByNameLimit failed, the error is not cached)",
+			want:    nil,
+			wantErr: true,
+			setup: func(mockRepo *searchDomain.MockSearchRepository) {
+				mockRepo.EXPECT().FindByNameLimit(gomock.Any(), "test_name", searchType, 10).Return(nil, errors.New("FindByNameLimit() failed")).Times(2)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRepo := searchDomain.NewMockSearchRepository(mockCtrl)
+			tt.setup(mockRepo)
+			r := NewCachedSearchRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+			// the second call is served from the cache unless the first one failed
+			for i := 0; i < 2; i++ {
+				got, err := r.FindByNameLimit(context.Background(), "test_name", searchType, 10)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("cachedSearchRepository.FindByNameLimit() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("cachedSearchRepository.FindByNameLimit() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+)
+
+// cachedTrackRepository is a struct that implements the TrackRepository interface caching the results of the lookups.
+type cachedTrackRepository struct {
+	repo  trackDomain.TrackRepository
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// NewCachedTrackRepository returns a new instance of the cachedTrackRepository struct.
+// The liked-state checks, likes and unlikes are never cached and delegated to the repository as they are.
+func NewCachedTrackRepository(repo trackDomain.TrackRepository, cache cache.Cache, ttl time.Duration) trackDomain.TrackRepository {
+	return &cachedTrackRepository{
+		repo:  repo,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+// FindByAlbumId returns the tracks by the album ID from the cache or the repository.
+func (r *cachedTrackRepository) FindByAlbumId(ctx context.Context, id spotify.ID) ([]*trackDomain.Track, error) {
+	return cached(r.cache, r.ttl, "track:album:"+id.String(), func() ([]*trackDomain.Track, error) {
+		return r.repo.FindByAlbumId(ctx, id)
+	})
+}
+
+// FindByArtistId returns the tracks by the artist ID from the cache or the repository.
+func (r *cachedTrackRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]*trackDomain.Track, error) {
+	return cached(r.cache, r.ttl, "track:artist:"+id.String(), func() ([]*trackDomain.Track, error) {
+		return r.repo.FindByArtistId(ctx, id)
+	})
+}
+
+// FindById returns the track by the ID from the cache or the repository.
+func (r *cachedTrackRepository) FindById(ctx context.Context, id spotify.ID) (*trackDomain.Track, error) {
+	return cached(r.cache, r.ttl, "track:id:"+id.String(), func() (*trackDomain.Track, error) {
+		return r.repo.FindById(ctx, id)
+	})
+}
+
+// FindByNameLimit returns the tracks by the name with the limit from the cache or the repository.
+func (r *cachedTrackRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*trackDomain.Track, error) {
+	return cached(r.cache, r.ttl, "track:name:"+strconv.Itoa(limit)+":"+name, func() ([]*trackDomain.Track, error) {
+		return r.repo.FindByNameLimit(ctx, name, limit)
+	})
+}
+
+// FindLiked returns the tracks liked by the user without the cache.
+func (r *cachedTrackRepository) FindLiked(ctx context.Context) ([]*trackDomain.Track, error) {
+	return r.repo.FindLiked(ctx)
+}
+
+// AreLiked checks if each of the tracks is liked without the cache.
+func (r *cachedTrackRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	return r.repo.AreLiked(ctx, ids)
+}
+
+// IsLiked checks if the track is liked without the cache.
+func (r *cachedTrackRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	return r.repo.IsLiked(ctx, id)
+}
+
+// Like likes the track.
+func (r *cachedTrackRepository) Like(ctx context.Context, id spotify.ID) error {
+	return r.repo.Like(ctx, id)
+}
+
+// Unlike unlikes the track.
+func (r *cachedTrackRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	return r.repo.Unlike(ctx, id)
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedTrackRepository(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	repo := trackDomain.NewMockTrackRepository(mockCtrl)
+	c := cache.NewCache("test_dir")
+
+	want := &cachedTrackRepository{
+		repo:  repo,
+		cache: c,
+		ttl:   time.Hour,
+	}
+	if got := NewCachedTrackRepository(repo, c, time.Hour); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewCachedTrackRepository() = %v, want %v", got, want)
+	}
+}
+
+func Test_cachedTrackRepository_Find(t *testing.T) {
+	releaseDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	track := trackDomain.NewTrack(
+		spotify.ID("test_track_id"),
+		"test_track_name",
+		[]spotify.SimpleArtist{{ID: spotify.ID("test_artist_id"), Name: "test_artist_name"}},
+		spotify.SimpleAlbum{ID: spotify.ID("test_album_id"), Name: "test_album_name"},
+		spotify.Numeric(1),
+		releaseDate,
+	)
+
+	tests := []struct {
+		name    string
+		find    func(r trackDomain.TrackRepository) (any, error)
+		want    any
+		wantErr bool
+		setup   func(mockRepo *trackDomain.MockTrackRepository)
+	}{
+		{
+			name: "positive testing (FindByArtistId)",
+			find: func(r trackDomain.TrackRepository) (any, error) {
+				return r.FindByArtistId(context.Background(), spotify.ID("test_artist_id"))
+			},
+			want:    []*trackDomain.Track{track},
+			wantErr: false,
+			setup: func(mockRepo *trackDomain.MockTrackRepository) {
+				mockRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id")).Return([]*trackDomain.Track{track}, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindByAlbumId)",
+			find: func(r trackDomain.TrackRepository) (any, error) {
+				return r.FindByAlbumId(context.Background(), spotify.ID("test_album_id"))
+			},
+			want:    []*trackDomain.Track{track},
+			wantErr: false,
+			setup: func(mockRepo *trackDomain.MockTrackRepository) {
+				mockRepo.EXPECT().FindByAlbumId(gomock.Any(), spotify.ID("test_album_id")).Return([]*trackDomain.Track{track}, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindById)",
+			find: func(r trackDomain.TrackRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_track_id"))
+			},
+			want:    track,
+			wantErr: false,
+			setup: func(mockRepo *trackDomain.MockTrackRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_track_id")).Return(track, nil).Times(1)
+			},
+		},
+		{
+			name: "positive testing (FindByNameLimit)",
+			find: func(r trackDomain.TrackRepository) (any, error) {
+				return r.FindByNameLimit(context.Background(), "test_track_name", 10)
+			},
+			want:    []*trackDomain.Track{track},
+			wantErr: false,
+			setup: func(mockRepo *trackDomain.MockTrackRepository) {
+				mockRepo.EXPECT().FindByNameLimit(gomock.Any(), "test_track_name", 10).Return([]*trackDomain.Track{track}, nil).Times(1)
+			},
+		},
+		{
+			name: "negative testing (FindById failed, the error is not cached)",
+			find: func(r trackDomain.TrackRepository) (any, error) {
+				return r.FindById(context.Background(), spotify.ID("test_track_id"))
+			},
+			want:    (*trackDomain.Track)(nil),
+			wantErr: true,
+			setup: func(mockRepo *trackDomain.MockTrackRepository) {
+				mockRepo.EXPECT().FindById(gomock.Any(), spotify.ID("test_track_id")).Return(nil, errors.New("FindById() failed")).Times(2)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+			tt.setup(mockRepo)
+			r := NewCachedTrackRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+			// the second call is served from the cache unless the first one failed
+			for i := 0; i < 2; i++ {
+				got, err := tt.find(r)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("cachedTrackRepository.Find() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("cachedTrackRepository.Find() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_cachedTrackRepository_AreLiked(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+	mockRepo.EXPECT().AreLiked(gomock.Any(), []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+	r := NewCachedTrackRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the liked-state checks are never cached
+	for _, want := range []bool{false, true} {
+		if liked, err := r.AreLiked(context.Background(), []spotify.ID{"test_track_id"}); err != nil || !reflect.DeepEqual(liked, []bool{want}) {
+			t.Errorf("cachedTrackRepository.AreLiked() = %v, %v, want [%v], nil", liked, err, want)
+		}
+	}
+}
+
+func Test_cachedTrackRepository_IsLikedAndLike(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_track_id")).Return(false, nil)
+	mockRepo.EXPECT().Like(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+	mockRepo.EXPECT().IsLiked(gomock.Any(), spotify.ID("test_track_id")).Return(true, nil)
+	mockRepo.EXPECT().Unlike(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+	r := NewCachedTrackRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_track_id")); err != nil || liked {
+		t.Errorf("cachedTrackRepository.IsLiked() = %v, %v, want false, nil", liked, err)
+	}
+	if err := r.Like(context.Background(), spotify.ID("test_track_id")); err != nil {
+		t.Errorf("cachedTrackRepository.Like() error = %v", err)
+	}
+	// the liked-state check is never cached
+	if liked, err := r.IsLiked(context.Background(), spotify.ID("test_track_id")); err != nil || !liked {
+		t.Errorf("cachedTrackRepository.IsLiked() = %v, %v, want true, nil", liked, err)
+	}
+	if err := r.Unlike(context.Background(), spotify.ID("test_track_id")); err != nil {
+		t.Errorf("cachedTrackRepository.Unlike() error = %v", err)
+	}
+}
+
+func Test_cachedTrackRepository_FindLiked(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := trackDomain.NewMockTrackRepository(mockCtrl)
+	mockRepo.EXPECT().FindLiked(gomock.Any()).Return([]*trackDomain.Track{trackDomain.NewTrack("test_track_id", "test_track_name", nil, spotify.SimpleAlbum{}, 1, time.Time{})}, nil).Times(2)
+	r := NewCachedTrackRepository(mockRepo, cache.NewCache(t.TempDir()), time.Hour)
+
+	// the liked tracks are never cached
+	for range 2 {
+		if tracks, err := r.FindLiked(context.Background()); err != nil || len(tracks) != 1 {
+			t.Errorf("cachedTrackRepository.FindLiked() = %v, %v, want 1 track, nil", tracks, err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/zmb3/spotify/v2"
+)
+
+// searchRepository is a struct that implements the SearchRepository interface.
+type searchRepository struct {
+	clientManager api.ClientManager
+}
+
+// NewSearchRepository returns a new instance of the searchRepository struct.
+func NewSearchRepository() searchDomain.SearchRepository {
+	return &searchRepository{
+		clientManager: api.GetClientManager(),
+	}
+}
+
+// FindByNameLimit returns the artists, albums, and tracks by the name with the limit in one search.
+func (r *searchRepository) FindByNameLimit(ctx context.Context, name string, searchType spotify.SearchType, limit int) (*searchDomain.SearchResult, error) {
+	api.Logger().DebugContext(ctx, "searchRepository.FindByNameLimit", slog.String("name", name), slog.Int("searchType", int(searchType)), slog.Int("limit", limit))
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	result, err := client.Search(ctx, name, searchType, spotify.Limit(limit))
+	if err != nil {
+		return nil, api.WrapError(err)
+	}
+
+	var artists []*artistDomain.Artist
+	if result.Artists != nil {
+		for _, artist := range result.Artists.Artists {
+			artists = append(
+				artists,
+				artistDomain.NewArtist(
+					artist.ID,
+					artist.Name,
+				),
+			)
+		}
+	}
+
+	var albums []*albumDomain.Album
+	if result.Albums != nil {
+		for _, album := range result.Albums.Albums {
+			albums = append(
+				albums,
+				albumDomain.NewAlbum(
+					album.ID,
+					album.Name,
+					album.Artists,
+					album.ReleaseDateTime(),
+				),
+			)
+		}
+	}
+
+	var tracks []*trackDomain.Track
+	if result.Tracks != nil {
+		for _, track := range result.Tracks.Tracks {
+			tracks = append(
+				tracks,
+				trackDomain.NewTrack(
+					track.ID,
+					track.Name,
+					track.Artists,
+					track.Album,
+					track.TrackNumber,
+					track.Album.ReleaseDateTime(),
+				),
+			)
+		}
+	}
+
+	return searchDomain.NewSearchResult(artists, albums, tracks), nil
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewSearchRepository(t *testing.T) {
+	cm := api.NewClientManager(proxy.NewSpotify(), proxy.NewHttp(), proxy.NewRandstr(), proxy.NewUrl())
+
+	tests := []struct {
+		name string
+		want searchDomain.SearchRepository
+	}{
+		{
+			name: "positive testing",
+			want: &searchRepository{
+				clientManager: cm,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSearchRepository(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSearchRepository() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if err := api.ResetClientManager(); err != nil {
+		t.Errorf("Failed to reset client manager: %v", err)
+	}
+}
+
+func Test_searchRepository_FindByNameLimit(t *testing.T) {
+	expectedTime, err := time.Parse("2006-01-02", "2000-01-01")
+	if err != nil {
+		t.Errorf("Failed to parse time: %v", err)
+	}
+	artists := []spotify.SimpleArtist{
+		{
+			ID:   "test_artist_id",
+			Name: "test_artist_name",
+		},
+	}
+	album := spotify.SimpleAlbum{
+		ID:                   "test_album_id",
+		Name:                 "test_album_name",
+		Artists:              artists,
+		ReleaseDate:          "2000-01-01",
+		ReleaseDatePrecision: "day",
+	}
+	msr := searchDomain.NewSearchResult(
+		[]*artistDomain.Artist{
+			artistDomain.NewArtist("test_artist_id", "test_artist_name"),
+		},
+		[]*albumDomain.Album{
+			albumDomain.NewAlbum("test_album_id", "test_album_name", artists, expectedTime),
+		},
+		[]*trackDomain.Track{
+			trackDomain.NewTrack("test_track_id", "test_track_name", artists, album, 1, expectedTime),
+		},
+	)
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx        context.Context
+		name       string
+		searchType spotify.SearchType
+		limit      int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *searchDomain.SearchResult
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				name:       "test",
+				searchType: spotify.SearchTypeArtist | spotify.SearchTypeAlbum | spotify.SearchTypeTrack,
+				limit:      1,
+			},
+			want:    msr,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().Search(tt2.ctx, tt2.name, tt2.searchType, gomock.Any()).Return(&spotify.SearchResult{
+					Artists: &spotify.FullArtistPage{
+						Artists: []spotify.FullArtist{
+							{
+								SimpleArtist: artists[0],
+							},
+						},
+					},
+					Albums: &spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{album},
+					},
+					Tracks: &spotify.FullTrackPage{
+						Tracks: []spotify.FullTrack{
+							{
+								SimpleTrack: spotify.SimpleTrack{
+									ID:          "test_track_id",
+									Name:        "test_track_name",
+									Artists:     artists,
+									TrackNumber: 1,
+								},
+								Album: album,
+							},
+						},
+					},
+				}, nil)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "positive testing (only artists are searched)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				name:       "test",
+				searchType: spotify.SearchTypeArtist,
+				limit:      1,
+			},
+			want: searchDomain.NewSearchResult(
+				[]*artistDomain.Artist{
+					artistDomain.NewArtist("test_artist_id", "test_artist_name"),
+				},
+				nil,
+				nil,
+			),
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().Search(tt2.ctx, tt2.name, tt2.searchType, gomock.Any()).Return(&spotify.SearchResult{
+					Artists: &spotify.FullArtistPage{
+						Artists: []spotify.FullArtist{
+							{
+								SimpleArtist: artists[0],
+							},
+						},
+					},
+				}, nil)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				name:       "test",
+				searchType: spotify.SearchTypeArtist | spotify.SearchTypeAlbum | spotify.SearchTypeTrack,
+				limit:      1,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.Search() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx:        context.Background(),
+				name:       "test",
+				searchType: spotify.SearchTypeArtist | spotify.SearchTypeAlbum | spotify.SearchTypeTrack,
+				limit:      1,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().Search(tt2.ctx, tt2.name, tt2.searchType, gomock.Any()).Return(nil, errors.New("failed to search"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			r := &searchRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.FindByNameLimit(tt.args.ctx, tt.args.name, tt.args.searchType, tt.args.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("searchRepository.FindByNameLimit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchRepository.FindByNameLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
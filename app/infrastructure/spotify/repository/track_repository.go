@@ -2,12 +2,21 @@ package repository
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/zmb3/spotify/v2"
 )
 
+const (
+	// likedTracksPageLimit is the maximum number of the liked tracks fetched at once.
+	likedTracksPageLimit = 50
+	// tracksCheckLimit is the maximum number of the tracks checked whether they are liked at once.
+	tracksCheckLimit = 50
+)
+
 // trackRepository is a struct that implements the TrackRepository interface.
 type trackRepository struct {
 	clientManager api.ClientManager
@@ -20,25 +29,27 @@ func NewTrackRepository() trackDomain.TrackRepository {
 	}
 }
 
-// FindByArtistId returns the tracks by the artist ID.
+// FindByArtistId returns the tracks on all the albums by the artist ID.
 func (r *trackRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]*trackDomain.Track, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.FindByArtistId", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
 	}
 
 	client := c.Open()
-	albumsResult, err := client.GetArtistAlbums(ctx, id, nil)
+	albums, err := findArtistAlbums(ctx, client, id, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var tracks []*trackDomain.Track
-	for _, album := range albumsResult.Albums {
+	for i, album := range albums {
 		tracksResult, err := client.GetAlbumTracks(ctx, album.ID)
 		if err != nil {
-			return nil, err
+			return nil, api.WrapError(err)
 		}
+		api.ReportProgress(ctx, "albums fetched", i+1, len(albums))
 
 		for _, track := range tracksResult.Tracks {
 			tracks = append(
@@ -60,6 +71,7 @@ func (r *trackRepository) FindByArtistId(ctx context.Context, id spotify.ID) ([]
 
 // FindByAlbumId returns the tracks by the album ID.
 func (r *trackRepository) FindByAlbumId(ctx context.Context, id spotify.ID) ([]*trackDomain.Track, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.FindByAlbumId", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -68,13 +80,13 @@ func (r *trackRepository) FindByAlbumId(ctx context.Context, id spotify.ID) ([]*
 	client := c.Open()
 	album, err := client.GetAlbum(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	var tracks []*trackDomain.Track
 	tracksResult, err := client.GetAlbumTracks(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	for _, track := range tracksResult.Tracks {
@@ -96,6 +108,7 @@ func (r *trackRepository) FindByAlbumId(ctx context.Context, id spotify.ID) ([]*
 
 // FindById returns the track by the ID.
 func (r *trackRepository) FindById(ctx context.Context, id spotify.ID) (*trackDomain.Track, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.FindById", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -104,7 +117,7 @@ func (r *trackRepository) FindById(ctx context.Context, id spotify.ID) (*trackDo
 	client := c.Open()
 	track, err := client.GetTrack(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	return trackDomain.NewTrack(
@@ -119,6 +132,7 @@ func (r *trackRepository) FindById(ctx context.Context, id spotify.ID) (*trackDo
 
 // FindByNameLimit returns the track by the name with the limit.
 func (r *trackRepository) FindByNameLimit(ctx context.Context, name string, limit int) ([]*trackDomain.Track, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.FindByNameLimit", slog.String("name", name), slog.Int("limit", limit))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return nil, err
@@ -127,7 +141,7 @@ func (r *trackRepository) FindByNameLimit(ctx context.Context, name string, limi
 	client := c.Open()
 	result, err := client.Search(ctx, name, spotify.SearchTypeTrack, spotify.Limit(limit))
 	if err != nil {
-		return nil, err
+		return nil, api.WrapError(err)
 	}
 
 	var tracks []*trackDomain.Track
@@ -148,8 +162,70 @@ func (r *trackRepository) FindByNameLimit(ctx context.Context, name string, limi
 	return tracks, nil
 }
 
+// FindLiked returns all the tracks liked by the user fetching them page by page.
+func (r *trackRepository) FindLiked(ctx context.Context) ([]*trackDomain.Track, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.FindLiked")
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var tracks []*trackDomain.Track
+	for {
+		page, err := client.CurrentUsersTracks(ctx, spotify.Limit(likedTracksPageLimit), spotify.Offset(len(tracks)))
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		for _, saved := range page.Tracks {
+			t := trackDomain.NewTrack(
+				saved.ID,
+				saved.Name,
+				saved.Artists,
+				saved.Album,
+				saved.TrackNumber,
+				saved.Album.ReleaseDateTime(),
+			)
+			// the time is left zero if Spotify returns it in an unexpected layout
+			t.LikedAt, _ = time.Parse(spotify.TimestampLayout, saved.AddedAt)
+			tracks = append(tracks, t)
+		}
+		api.ReportProgress(ctx, "liked tracks fetched", len(tracks), int(page.Total))
+
+		if page.Next == "" || len(page.Tracks) == 0 {
+			break
+		}
+	}
+
+	return tracks, nil
+}
+
+// AreLiked returns whether each of the tracks is liked in the order of the IDs.
+func (r *trackRepository) AreLiked(ctx context.Context, ids []spotify.ID) ([]bool, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.AreLiked", slog.Int("ids", len(ids)))
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	var liked []bool
+	for start := 0; start < len(ids); start += tracksCheckLimit {
+		chunk := ids[start:min(start+tracksCheckLimit, len(ids))]
+		result, err := client.UserHasTracks(ctx, chunk...)
+		if err != nil {
+			return nil, api.WrapError(err)
+		}
+		liked = append(liked, result...)
+		api.ReportProgress(ctx, "tracks checked", len(liked), len(ids))
+	}
+
+	return liked, nil
+}
+
 // IsLiked returns whether the track is liked.
 func (r *trackRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, error) {
+	api.Logger().DebugContext(ctx, "trackRepository.IsLiked", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return false, err
@@ -158,7 +234,7 @@ func (r *trackRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, err
 	client := c.Open()
 	result, err := client.UserHasTracks(ctx, id)
 	if err != nil {
-		return false, err
+		return false, api.WrapError(err)
 	}
 
 	return result[0], nil
@@ -166,22 +242,32 @@ func (r *trackRepository) IsLiked(ctx context.Context, id spotify.ID) (bool, err
 
 // Like likes the track.
 func (r *trackRepository) Like(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "trackRepository.Like", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.AddTracksToLibrary(ctx, id)
+	if err := client.AddTracksToLibrary(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
 
 // Unlike unlikes the track.
 func (r *trackRepository) Unlike(ctx context.Context, id spotify.ID) error {
+	api.Logger().DebugContext(ctx, "trackRepository.Unlike", slog.String("id", id.String()))
 	c, err := r.clientManager.GetClient()
 	if err != nil {
 		return err
 	}
 
 	client := c.Open()
-	return client.RemoveTracksFromLibrary(ctx, id)
+	if err := client.RemoveTracksFromLibrary(ctx, id); err != nil {
+		return api.WrapError(err)
+	}
+
+	return nil
 }
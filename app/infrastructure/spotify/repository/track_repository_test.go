@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -99,7 +101,7 @@ func Test_trackRepository_FindByArtistId(t *testing.T) {
 			wantErr: false,
 			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
 				mockApiClient := proxy.NewMockClient(mockCtrl)
-				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil).Return(&spotify.SimpleAlbumPage{
+				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(&spotify.SimpleAlbumPage{
 					Albums: []spotify.SimpleAlbum{
 						{
 							ID:   "test_album_id",
@@ -177,7 +179,7 @@ func Test_trackRepository_FindByArtistId(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
 				mockApiClient := proxy.NewMockClient(mockCtrl)
-				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil).Return(nil, errors.New("failed to get artist albums"))
+				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get artist albums"))
 				mockClient := api.NewMockClient(mockCtrl)
 				mockClient.EXPECT().Open().Return(mockApiClient)
 				mockClientManager := api.NewMockClientManager(mockCtrl)
@@ -203,7 +205,7 @@ func Test_trackRepository_FindByArtistId(t *testing.T) {
 			wantErr: true,
 			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
 				mockApiClient := proxy.NewMockClient(mockCtrl)
-				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil).Return(&spotify.SimpleAlbumPage{
+				mockApiClient.EXPECT().GetArtistAlbums(tt2.ctx, tt2.id, nil, gomock.Any(), gomock.Any()).Return(&spotify.SimpleAlbumPage{
 					Albums: []spotify.SimpleAlbum{
 						{
 							ID:   "test_album_id",
@@ -862,6 +864,118 @@ func Test_trackRepository_FindByNameLimit(t *testing.T) {
 	}
 }
 
+func Test_trackRepository_AreLiked(t *testing.T) {
+	// the IDs are checked in the chunks of the limit
+	ids := make([]spotify.ID, 50+1)
+	for i := range ids {
+		ids[i] = spotify.ID(fmt.Sprintf("test_track_id_%d", i))
+	}
+	want := make([]bool, len(ids))
+	want[len(ids)-1] = true
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+		ids []spotify.ID
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []bool
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    want,
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UserHasTracks(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, ids ...spotify.ID) ([]bool, error) {
+						result := make([]bool, len(ids))
+						result[len(ids)-1] = len(ids) == 1
+						return result, nil
+					},
+				).Times(2)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (client.UserHasTracks() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				ids: ids,
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().UserHasTracks(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to check the tracks"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			r := &trackRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.AreLiked(tt.args.ctx, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("trackRepository.AreLiked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("trackRepository.AreLiked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_trackRepository_IsLiked(t *testing.T) {
 	type fields struct {
 		clientManager api.ClientManager
@@ -1016,12 +1130,13 @@ func Test_trackRepository_Like(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -1094,6 +1209,58 @@ func Test_trackRepository_Like(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.AddTracksToLibrary() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test_track_id"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().AddTracksToLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.AddTracksToLibrary() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test_track_id"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().AddTracksToLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1110,9 +1277,13 @@ func Test_trackRepository_Like(t *testing.T) {
 			r := &trackRepository{
 				clientManager: tt.fields.clientManager,
 			}
-			if err := r.Like(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
+			err := r.Like(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("trackRepository.Like() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("trackRepository.Like() error = %v, want %v", err, tt.wantErrIs)
+			}
 		})
 	}
 }
@@ -1126,12 +1297,13 @@ func Test_trackRepository_Unlike(t *testing.T) {
 		id  spotify.ID
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
-		cleanup func()
+		name      string
+		fields    fields
+		args      args
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup   func()
 	}{
 		{
 			name: "positive testing",
@@ -1204,6 +1376,58 @@ func Test_trackRepository_Unlike(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "negative testing (client.RemoveTracksFromLibrary() failed with forbidden)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test_track_id"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrForbidden,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().RemoveTracksFromLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.RemoveTracksFromLibrary() failed with rate limited)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  spotify.ID("test_track_id"),
+			},
+			wantErr:   true,
+			wantErrIs: api.ErrRateLimited,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().RemoveTracksFromLibrary(tt2.ctx, tt2.id).Return(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1220,9 +1444,174 @@ func Test_trackRepository_Unlike(t *testing.T) {
 			r := &trackRepository{
 				clientManager: tt.fields.clientManager,
 			}
-			if err := r.Unlike(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
+			err := r.Unlike(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("trackRepository.Unlike() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("trackRepository.Unlike() error = %v, want %v", err, tt.wantErrIs)
+			}
+		})
+	}
+}
+
+func Test_trackRepository_FindLiked(t *testing.T) {
+	album := spotify.SimpleAlbum{
+		ID:                   "test_album_id",
+		Name:                 "test_album_name",
+		ReleaseDate:          "2000-01-01",
+		ReleaseDatePrecision: "day",
+	}
+	firstPage := &spotify.SavedTrackPage{
+		Tracks: []spotify.SavedTrack{
+			{
+				AddedAt: "2020-01-02T03:04:05Z",
+				FullTrack: spotify.FullTrack{
+					SimpleTrack: spotify.SimpleTrack{
+						ID:          "test_track_id_1",
+						Name:        "test_track_name_1",
+						TrackNumber: 1,
+					},
+					Album: album,
+				},
+			},
+		},
+	}
+	firstPage.Next = "test_next"
+	firstPage.Total = 2
+	lastPage := &spotify.SavedTrackPage{
+		Tracks: []spotify.SavedTrack{
+			{
+				AddedAt: "invalid",
+				FullTrack: spotify.FullTrack{
+					SimpleTrack: spotify.SimpleTrack{
+						ID:          "test_track_id_2",
+						Name:        "test_track_name_2",
+						TrackNumber: 2,
+					},
+					Album: album,
+				},
+			},
+		},
+	}
+	lastPage.Total = 2
+	first := trackDomain.NewTrack("test_track_id_1", "test_track_name_1", nil, album, 1, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	first.LikedAt = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	last := trackDomain.NewTrack("test_track_id_2", "test_track_name_2", nil, album, 2, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	type fields struct {
+		clientManager api.ClientManager
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*trackDomain.Track
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    []*trackDomain.Track{first, last},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				gomock.InOrder(
+					mockApiClient.EXPECT().CurrentUsersTracks(tt2.ctx, gomock.Any(), gomock.Any()).Return(firstPage, nil),
+					mockApiClient.EXPECT().CurrentUsersTracks(tt2.ctx, gomock.Any(), gomock.Any()).Return(lastPage, nil),
+				)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+		{
+			name: "negative testing (client.CurrentUsersTracks() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUsersTracks(tt2.ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get liked tracks"))
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			r := &trackRepository{
+				clientManager: tt.fields.clientManager,
+			}
+			got, err := r.FindLiked(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("trackRepository.FindLiked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("trackRepository.FindLiked() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+)
+
+// userRepository is a struct that implements the UserRepository interface.
+type userRepository struct {
+	clientManager api.ClientManager
+	user          *userDomain.User
+}
+
+// NewUserRepository returns a new instance of the userRepository struct.
+func NewUserRepository() userDomain.UserRepository {
+	return &userRepository{
+		clientManager: api.GetClientManager(),
+	}
+}
+
+// FindCurrent returns the user authenticated the client.
+// The user is found only once, and the same user is returned after that.
+func (r *userRepository) FindCurrent(ctx context.Context) (*userDomain.User, error) {
+	api.Logger().DebugContext(ctx, "userRepository.FindCurrent")
+	if r.user != nil {
+		return r.user, nil
+	}
+
+	c, err := r.clientManager.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.Open()
+	user, err := client.CurrentUser(ctx)
+	if err != nil {
+		return nil, api.WrapError(err)
+	}
+
+	r.user = userDomain.NewUser(
+		spotify.ID(user.ID),
+		user.DisplayName,
+	)
+
+	return r.user, nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	userDomain "github.com/yanosea/spotlike/app/domain/spotify/user"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewUserRepository(t *testing.T) {
+	cm := api.NewClientManager(proxy.NewSpotify(), proxy.NewHttp(), proxy.NewRandstr(), proxy.NewUrl())
+
+	tests := []struct {
+		name string
+		want userDomain.UserRepository
+	}{
+		{
+			name: "positive testing",
+			want: &userRepository{
+				clientManager: cm,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewUserRepository(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewUserRepository() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if err := api.ResetClientManager(); err != nil {
+		t.Errorf("Failed to reset client manager: %v", err)
+	}
+}
+
+func Test_userRepository_FindCurrent(t *testing.T) {
+	type fields struct {
+		clientManager api.ClientManager
+		user          *userDomain.User
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *userDomain.User
+		wantErr   bool
+		wantErrIs error
+		setup     func(mockCtrl *gomock.Controller, tt *fields, tt2 *args)
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &userDomain.User{
+				ID:   "test_user_id",
+				Name: "test_user_name",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUser(tt2.ctx).Return(&spotify.PrivateUser{
+					User: spotify.User{
+						ID:          "test_user_id",
+						DisplayName: "test_user_name",
+					},
+				}, nil)
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "positive testing (the user has been found)",
+			fields: fields{
+				clientManager: nil,
+				user: &userDomain.User{
+					ID:   "test_user_id",
+					Name: "test_user_name",
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: &userDomain.User{
+				ID:   "test_user_id",
+				Name: "test_user_name",
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				tt.clientManager = api.NewMockClientManager(mockCtrl)
+			},
+		},
+		{
+			name: "negative testing (r.clientManager.GetClient() failed)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client"))
+				tt.clientManager = mockClientManager
+			},
+		},
+		{
+			name: "negative testing (client.CurrentUser() failed with unauthorized)",
+			fields: fields{
+				clientManager: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:      nil,
+			wantErr:   true,
+			wantErrIs: api.ErrNotAuthenticated,
+			setup: func(mockCtrl *gomock.Controller, tt *fields, tt2 *args) {
+				mockApiClient := proxy.NewMockClient(mockCtrl)
+				mockApiClient.EXPECT().CurrentUser(tt2.ctx).Return(nil, spotify.Error{Message: "unauthorized", Status: http.StatusUnauthorized})
+				mockClient := api.NewMockClient(mockCtrl)
+				mockClient.EXPECT().Open().Return(mockApiClient)
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(mockClient, nil)
+				tt.clientManager = mockClientManager
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl, &tt.fields, &tt.args)
+			}
+			r := &userRepository{
+				clientManager: tt.fields.clientManager,
+				user:          tt.fields.user,
+			}
+			got, err := r.FindCurrent(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("userRepository.FindCurrent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("userRepository.FindCurrent() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userRepository.FindCurrent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package command
 
 import (
 	"context"
+	"log/slog"
 	"os"
 
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
@@ -59,6 +61,8 @@ func (c *cli) Init(
 	version string,
 	versionUtil utility.VersionUtil,
 ) int {
+	output = ""
+
 	configurator := config.NewSpotlikeCliConfigurator(envconfig)
 	conf, err := configurator.GetConfig()
 	if err != nil {
@@ -80,10 +84,13 @@ func (c *cli) Init(
 		if err := c.ClientManager.InitializeClient(
 			c.Context,
 			&api.ClientConfig{
-				SpotifyID:           conf.SpotifyID,
-				SpotifySecret:       conf.SpotifySecret,
-				SpotifyRedirectUri:  conf.SpotifyRedirectUri,
-				SpotifyRefreshToken: conf.SpotifyRefreshToken,
+				SpotifyID:              conf.SpotifyID,
+				SpotifySecret:          conf.SpotifySecret,
+				SpotifyRedirectUri:     conf.SpotifyRedirectUri,
+				SpotifyRefreshToken:    conf.SpotifyRefreshToken,
+				SpotifyApiBaseUrl:      conf.SpotifyApiBaseUrl,
+				SpotifyAccountsBaseUrl: conf.SpotifyAccountsBaseUrl,
+				CacheDir:               conf.CacheDir,
 			},
 		); err != nil {
 			output = formatter.AppendErrorToOutput(err, output)
@@ -121,11 +128,19 @@ func (c *cli) Run() (exitCode int) {
 	}()
 
 	out := os.Stdout
-	if err := c.RootCommand.ExecuteContext(c.Context); err != nil {
+	spotlike.SetExitCode(spotlike.ExitCodeOk)
+	err := c.RootCommand.ExecuteContext(c.Context)
+	if err != nil {
+		api.Logger().Error("command failed", slog.String("error", err.Error()))
+	}
+	if cerr := spotlike.CloseLogger(); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err != nil {
 		output = formatter.AppendErrorToOutput(err, output)
 		out = os.Stderr
-		exitCode = 1
 	}
+	exitCode = spotlike.ExitCode(err)
 
 	if output != "" {
 		if err := presenter.Print(out, output); err != nil {
@@ -605,6 +605,41 @@ func Test_cli_Run(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "negative testing (c.RootCommand.ExecuteContext() failed with the client not authenticated)",
+			fields: fields{
+				os:        osProxy,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func(mockCtrl *gomock.Controller) {
+					mockCommand := proxy.NewMockCommand(mockCtrl)
+					mockCommand.EXPECT().ExecuteContext(gomock.Any()).Return(api.ErrClientNotInitialized)
+					mockClientManager := api.NewMockClientManager(mockCtrl)
+					mockClientManager.EXPECT().IsClientInitialized().Return(false)
+					c := &cli{
+						Exit:          exit,
+						Cobra:         proxy.NewCobra(),
+						RootCommand:   mockCommand,
+						Context:       ctx,
+						ClientManager: mockClientManager,
+					}
+					if got := c.Run(); got != 3 {
+						t.Errorf("cli.Run() = %v, want %v", got, 3)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: color.RedString("Error : client not initialized") + "\n",
+			wantErr:    false,
+			setup: func() {
+				output = ""
+			},
+			cleanup: func() {
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (presenter.Print(out, output) failed in Run)",
 			fields: fields{
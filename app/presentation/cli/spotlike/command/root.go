@@ -4,6 +4,8 @@ import (
 	c "github.com/spf13/cobra"
 
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike/cache"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike/check"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike/completion"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike/get"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike/like"
@@ -48,6 +50,100 @@ func NewRootCommand(
 		false,
 		"🔖 show the version of spotlike",
 	)
+	spotlike.GlobalOps.DataDir = conf.DataDir
+	spotlike.GlobalOps.CacheDir = conf.CacheDir
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.DryRun,
+		"dry-run",
+		"",
+		false,
+		"🧪 show the plan of like and unlike without executing it",
+	)
+	cmd.PersistentFlags().IntVarP(
+		&spotlike.GlobalOps.ConfirmThreshold,
+		"confirm-threshold",
+		"",
+		10,
+		"📋 confirm only once with the summary when more items than this are affected",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.KeepGoing,
+		"keep-going",
+		"",
+		false,
+		"🏃 keep liking and unliking the remaining items even if some of them failed",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&spotlike.GlobalOps.RetryFailed,
+		"retry-failed",
+		"",
+		"",
+		"🔁 retry liking and unliking only the failed items in the report saved with the json format",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.Quiet,
+		"quiet",
+		"q",
+		false,
+		"🤫 do not show the progress of long running operations",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.NoCache,
+		"no-cache",
+		"",
+		false,
+		"🧊 do not use the cache of the catalog lookups",
+	)
+	cmd.PersistentFlags().IntVarP(
+		&spotlike.GlobalOps.CacheTTL,
+		"cache-ttl",
+		"",
+		24,
+		"⏳ hours to keep the cache of the catalog lookups",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&spotlike.GlobalOps.Record,
+		"record",
+		"",
+		"",
+		"📼 record the sanitized requests and responses to the Spotify Web API in the directory",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&spotlike.GlobalOps.Replay,
+		"replay",
+		"",
+		"",
+		"📼 replay the responses recorded in the directory instead of sending the requests",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.Verbose,
+		"verbose",
+		"",
+		false,
+		"🔊 write the logs of the requests to Spotify to stderr",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&spotlike.GlobalOps.Debug,
+		"debug",
+		"",
+		false,
+		"🐛 write the debug logs including the redacted headers to stderr",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&spotlike.GlobalOps.LogFile,
+		"log-file",
+		"",
+		"",
+		"📄 write the logs to the file instead of stderr",
+	)
+	cmd.SetPersistentPreRunE(
+		func(cmd *c.Command, args []string) error {
+			if err := spotlike.SetUpLogger(); err != nil {
+				return err
+			}
+			return spotlike.SetUpClient(cmd.Context(), conf)
+		},
+	)
 	versionCmd := spotlike.NewVersionCommand(
 		cobra,
 		version,
@@ -83,11 +179,65 @@ func NewRootCommand(
 			authCmd,
 			output,
 		),
+		check.NewCheckCommand(
+			cobra,
+			authCmd,
+			output,
+		),
 		spotlike.NewSearchCommand(
 			cobra,
 			authCmd,
 			output,
 		),
+		spotlike.NewHistoryCommand(
+			cobra,
+			output,
+		),
+		spotlike.NewUndoCommand(
+			exit,
+			cobra,
+			authCmd,
+			output,
+		),
+		spotlike.NewReleasesCommand(
+			exit,
+			cobra,
+			authCmd,
+			output,
+		),
+		spotlike.NewWatchCommand(
+			exit,
+			cobra,
+			authCmd,
+			output,
+		),
+		spotlike.NewApplyCommand(
+			exit,
+			cobra,
+			authCmd,
+			output,
+		),
+		spotlike.NewDiffCommand(
+			exit,
+			cobra,
+			authCmd,
+			output,
+		),
+		spotlike.NewStatsCommand(
+			cobra,
+			authCmd,
+			output,
+		),
+		cache.NewCacheCommand(
+			cobra,
+			output,
+		),
+		spotlike.NewDoctorCommand(
+			cobra,
+			version,
+			conf,
+			output,
+		),
 		versionCmd,
 	)
 
@@ -118,7 +268,17 @@ func runRoot(
 - 📚 get,        ge,   g - Get the information of the content on Spotify by ID.
 - 🤍 like,       li,   l - Like content on Spotify by ID.
 - 💔 unlike,     un,   u - Unlike content on Spotify by ID.
+- 🔎 check,      ch,   k - Check whether content on Spotify is liked by ID.
 - 🔍 search,     se,   s - Search for the ID of content in Spotify.
+- 🕒 history,    hi,   h - Show the history of like and unlike operations.
+- ⏪ undo,       ud,   U - Undo like and unlike operations.
+- 🆕 releases,   re,   r - Browse new releases from the followed artists and like them.
+- 👀 watch,      wa,   w - Watch new releases and like them periodically with the rules.
+- 📜 apply,      ap,   A - Apply the rules file to your library.
+- 🔀 diff,       df,   D - Compare the snapshots of your library.
+- 📊 stats,      st,   S - Show the statistics of your library.
+- 🗄️ cache,      ca,   C - Manage the cache of the catalog lookups.
+- 🩺 doctor,     dr,   d - Diagnose the setup of spotlike.
 - 🔧 completion, comp, c - Generate the autocompletion script for the specified shell.
 - 🔖 version,    ver,  v - Show the version of spotlike.
 - 🤝 help                - Help for spotlike.
@@ -138,6 +298,19 @@ const (
 You can like tracks, albums, and artists in Spotify by ID.
 Also, you can search for the ID of tracks, albums, and artists in Spotify.
 
+Exit Codes:
+  0    ✅ succeeded
+  1    ❌ failed with an unexpected error
+  2    👻 the content is not found on Spotify
+  3    🔑 not authenticated or the token is invalid (run "spotlike auth")
+  4    🚫 forbidden by Spotify such as the scope is missing
+  5    ⏳ rate limited by Spotify
+  6    🌓 some of the contents are processed but the others are not found
+  7    ⏩ nothing to do such as all of the contents are already liked
+  8    🩹 some of the contents failed to be liked or unliked with "--keep-going"
+  9    🤍 some of the contents checked are not liked or not followed
+  130  🚫 canceled by the user
+
 ` + rootUsageTemplate
 	// rootUsageTemplate is the usage template of the root command.
 	rootUsageTemplate = `Usage:
@@ -149,14 +322,36 @@ Available Commands:
   get,        ge,   g  📚 Get the information of the content on Spotify by ID.
   like,       li,   l  🤍 Like content on Spotify by ID.
   unlike,     un,   u  💔 Unlike content on Spotify by ID.
+  check,      ch,   k  🔎 Check whether content on Spotify is liked by ID.
   search,     se,   s  🔍 Search for the ID of content in Spotify.
+  history,    hi,   h  🕒 Show the history of like and unlike operations.
+  undo,       ud,   U  ⏪ Undo like and unlike operations.
+  releases,   re,   r  🆕 Browse new releases from the followed artists and like them.
+  watch,      wa,   w  👀 Watch new releases and like them periodically with the rules.
+  apply,      ap,   A  📜 Apply the rules file to your library.
+  diff,       df,   D  🔀 Compare the snapshots of your library.
+  stats,      st,   S  📊 Show the statistics of your library.
+  cache,      ca,   C  🗄️ Manage the cache of the catalog lookups.
+  doctor,     dr,   d  🩺 Diagnose the setup of spotlike.
   completion, comp, c  🔧 Generate the autocompletion script for the specified shell.
   version,    ver,  v  🔖 Show the version of spotlike.
   help                 🤝 Help for spotlike.
 
 Flags:
-  -h, --help     🤝 help for spotlike
-  -v, --version  🔖 version for spotlike
+  -h, --help           🤝 help for spotlike
+  -v, --version        🔖 version for spotlike
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+  --verbose            🔊 write the logs of the requests to Spotify to stderr
+  --debug              🐛 write the debug logs including the redacted headers to stderr
+  --log-file           📄 write the logs to the file instead of stderr
+  --no-cache           🧊 do not use the cache of the catalog lookups
+  --cache-ttl          ⏳ hours to keep the cache of the catalog lookups (default 24)
+  --record             📼 record the sanitized requests and responses to the Spotify Web API in the directory
+  --replay             📼 replay the responses recorded in the directory instead of sending the requests
 
 Use "spotlike [command] --help" for more information about a command.
 `
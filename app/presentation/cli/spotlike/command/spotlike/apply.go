@@ -0,0 +1,372 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// ApplyOptions provides the options for the apply command.
+type ApplyOptions struct {
+	NoConfirm bool
+	Format    string
+}
+
+var (
+	// applyOps is a variable to store the apply options with the default values for injecting the dependencies in testing.
+	applyOps = ApplyOptions{
+		NoConfirm: false,
+		Format:    "table",
+	}
+	// applyYaml is a variable to store the yaml proxy for injecting the dependencies in testing.
+	applyYaml = proxy.NewYaml()
+)
+
+// NewApplyCommand returns a new instance of the apply command.
+func NewApplyCommand(
+	exit func(int),
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("apply")
+	cmd.SetAliases([]string{"ap", "A"})
+	cmd.SetUsageTemplate(applyUsageTemplate)
+	cmd.SetHelpTemplate(applyHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&applyOps.NoConfirm,
+		"no-confirm",
+		"",
+		false,
+		"🚫 do not confirm before applying the rules",
+	)
+	cmd.Flags().StringVarP(
+		&applyOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runApply(exit, cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runApply runs the apply command.
+func runApply(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if len(args) == 0 {
+		o := formatter.Yellow("⚡ No rules file specified...")
+		*output = o
+		return nil
+	}
+
+	ruleRepo := fileRepository.NewRuleRepository(applyYaml, args[0])
+	if _, err := ruleRepo.Find(cmd.Context()); err != nil {
+		o := formatter.Red("❌ Failed to load the rules file...")
+		*output = o
+		return err
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	w := MessageWriter(applyOps.Format)
+	progress := NewProgressReporter(applyOps.Format)
+	albumRepo := NewAlbumRepository()
+	trackRepo := NewTrackRepository()
+	pruc := spotlikeApp.NewPlanRulesUseCase(ruleRepo, NewArtistRepository(), albumRepo, trackRepo)
+	// the rules are applied to the whole library regardless of the release date
+	pruoDtos, err := pruc.Run(WithProgress(cmd.Context(), progress), time.Time{})
+	if err != nil {
+		progress.Done()
+		return err
+	}
+	pnruc := spotlikeApp.NewPlanNeverRulesUseCase(ruleRepo, albumRepo, trackRepo)
+	pnruoDtos, err := pnruc.Run(WithProgress(cmd.Context(), progress))
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	var changes []*spotlikeApp.PlanRulesUseCaseOutputDto
+	for _, pruoDto := range pruoDtos {
+		if !pruoDto.Liked {
+			changes = append(changes, pruoDto)
+		}
+	}
+	// the contents never to be liked are unliked, because they are liked
+	changes = append(changes, pnruoDtos...)
+	if len(changes) == 0 {
+		o := formatter.Yellow("⚡ The library already satisfies the rules... (" + fmt.Sprint(len(pruoDtos)) + " contents matched)")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	if err := presenter.Print(w, "\n"+applyDiff(changes)); err != nil {
+		return err
+	}
+	if err := presenter.Print(w, "📋 "+fmt.Sprint(len(changes))+" to change, "+fmt.Sprint(len(pruoDtos)+len(pnruoDtos)-len(changes))+" unchanged."); err != nil {
+		return err
+	}
+
+	noConfirm := applyOps.NoConfirm || GlobalOps.DryRun
+	if !noConfirm && len(changes) > GlobalOps.ConfirmThreshold {
+		if answer, err := presenter.RunPrompt(
+			"Proceed with applying " + fmt.Sprint(len(changes)) + " changes of the rules above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled applying...")); err != nil {
+				return err
+			}
+			exit(ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled applying the rules...")
+			*output = o
+			SetExitCode(ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(GlobalOps.DataDir), repository.NewUserRepository())
+	var results []*spotlikeApp.OperationResultDto
+	applied := 0
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, change := range changes {
+		action := applyAction(change)
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled applying the remaining changes...")); err != nil {
+				return err
+			}
+			for _, remaining := range changes[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto(remaining.Type, remaining.ID, remaining.Name, applyAction(remaining), spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("changes applied", i, len(changes))
+		}
+		if GlobalOps.DryRun {
+			applied++
+			results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, action, spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
+			if answer, err := presenter.RunPrompt(
+				"Proceed with applying " + action + " " + change.Type + " " + change.Name + " (" + change.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
+					return err
+				}
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled applying...")); err != nil {
+					return err
+				}
+				exit(ExitCodeCanceled)
+				return nil
+			} else if err != nil {
+				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled applying the remaining changes...")); err != nil {
+					return err
+				}
+				for _, remaining := range changes[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto(remaining.Type, remaining.ID, remaining.Name, applyAction(remaining), spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
+			} else if answer != "y" && answer != "Y" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled applying "+action+" "+change.Type+" "+change.Name+" ("+change.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, action, spotlikeApp.OperationResultStatusCanceled, nil))
+				continue
+			}
+		}
+
+		if err := ApplyAction(ctx, action, change.Type, change.ID); err != nil {
+			if !GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, action, spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        change.Type,
+				ID:          change.ID,
+				Name:        change.Name,
+				Action:      action,
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
+			return err
+		}
+
+		applied++
+		status := spotlikeApp.OperationResultStatusLiked
+		if action == "unlike" {
+			status = spotlikeApp.OperationResultStatusUnliked
+		}
+		results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, action, status, nil))
+	}
+	progress.Done()
+
+	f, err := formatter.NewFormatter(applyOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(results)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	if failed := FailedResultsMessage(results); failed != "" {
+		if applyOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if applied != 0 {
+		message := formatter.Green("✅📜 Successfully applied the changes of the rules below!")
+		if GlobalOps.DryRun {
+			message = formatter.Yellow("🧪📜 Changes of the rules below would be applied... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Context().Err() != nil {
+		SetExitCode(ExitCodeCanceled)
+		return nil
+	}
+	SetExitCode(ResultsExitCode(results))
+
+	return nil
+}
+
+// applyAction returns the action of the change of the plan, which unlikes the contents already liked.
+func applyAction(change *spotlikeApp.PlanRulesUseCaseOutputDto) string {
+	if change.Liked {
+		return "unlike"
+	}
+
+	return "like"
+}
+
+// applyDiff returns the changes of the plan in the diff style.
+func applyDiff(changes []*spotlikeApp.PlanRulesUseCaseOutputDto) string {
+	var lines []string
+	for _, change := range changes {
+		sign, color := "+ ", formatter.Green
+		if applyAction(change) == "unlike" {
+			sign, color = "- ", formatter.Red
+		}
+		line := sign + change.Type + " " + change.Name + " (" + change.ID + ")"
+		if change.Type != "artist" {
+			line += " released at " + change.ReleaseDate.Format("2006-01-02") + " by " + change.Artists
+		}
+		lines = append(lines, color(line+" [rule: "+change.Rule+"]"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+const (
+	// applyHelpTemplate is the help template of the apply command.
+	applyHelpTemplate = `📜 Apply the rules file to your library.
+
+You can describe the artists to follow and the albums or the tracks to like in the rules file in YAML,
+and keep your library as the version-controlled configuration.
+
+  follow:                      # IDs of the artists to follow
+    - 00DuPiLri3mNomvvM3nZvU
+  never: ["(?i)karaoke"]       # patterns of the names of the albums and tracks never to like (unliked if liked)
+  rules:
+    - name: studio albums        # name of the rule (default "rule N")
+      artists:                   # IDs of the artists
+        - 00DuPiLri3mNomvvM3nZvU
+      followed: false            # also the artists you follow
+      include_groups: [album]    # album, single, compilation, appears_on (default all)
+      released_after: 2015-01-01 # only the albums released on or after the date
+      exclude: ["(?i)\\blive\\b"] # patterns of the names of the albums and tracks not to like
+      like: albums               # albums or tracks (default albums)
+
+The plan is computed against your current library, and the changes are shown in the diff style.
+The albums and the tracks liked in your library whose names match the "never" patterns are unliked.
+Before applying, you would be asked to confirm each change,
+or to confirm once with the summary when more changes than "--confirm-threshold" are planned.
+The rules only like and follow otherwise, so the contents not matched by the rules are left as they are.
+You can only show the changes by specifying the "--dry-run" option.
+
+` + applyUsageTemplate
+	// applyUsageTemplate is the usage template of the apply command.
+	applyUsageTemplate = `Usage:
+  spotlike apply [flags] [argument]
+  spotlike ap    [flags] [argument]
+  spotlike A     [flags] [argument]
+
+Flags:
+  --no-confirm  🚫 do not confirm before applying the rules
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for apply
+
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  -q, --quiet          🤫 do not show the progress of long running operations
+
+Argument:
+  RULES  📜 path of the rules file (e.g: "rules.yaml")
+`
+)
@@ -0,0 +1,536 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewApplyCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		exit    func(int)
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				exit:  exit,
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewApplyCommand(tt.args.exit, tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewApplyCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the apply command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runApply(t *testing.T) {
+	output := ""
+	exit := func(code int) {
+		SetExitCode(code)
+	}
+	origApplyOps := applyOps
+	origGlobalOps := GlobalOps
+	origPu := presenter.Pu
+	su := utility.NewStringsUtil()
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	albums := &spotify.SimpleAlbumPage{
+		Albums: []spotify.SimpleAlbum{
+			{
+				ID:   "test_album_id_1",
+				Name: "test_album_name_1",
+				Artists: []spotify.SimpleArtist{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				AlbumGroup:           "album",
+				ReleaseDate:          "2000-01-10",
+				ReleaseDatePrecision: "day",
+			},
+			{
+				ID:   "test_album_id_2",
+				Name: "test_album_name_2",
+				Artists: []spotify.SimpleArtist{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				AlbumGroup:           "album",
+				ReleaseDate:          "1999-12-31",
+				ReleaseDatePrecision: "day",
+			},
+		},
+	}
+	writeRules := func(t *testing.T, never bool) string {
+		path := filepath.Join(t.TempDir(), "rules.yaml")
+		rules := "follow: [test_artist_id]\nrules:\n  - name: test_rule\n    artists: [test_artist_id]\n    released_after: 2000-01-01\n"
+		if never {
+			rules += "never: [\"(?i)karaoke\"]\n"
+		}
+		if err := os.WriteFile(path, []byte(rules), 0600); err != nil {
+			t.Errorf("Failed to write the rules file: %v", err)
+		}
+		return path
+	}
+	expectPlan := func(mockSpotifyClient *proxy.MockClient, followed bool, liked bool) {
+		mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(
+			&spotify.FullArtist{
+				SimpleArtist: spotify.SimpleArtist{
+					ID:   "test_artist_id",
+					Name: "test_artist_name",
+				},
+			},
+			nil,
+		)
+		mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{followed}, nil)
+		mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+		mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{liked}, nil)
+	}
+	expectNeverPlan := func(mockSpotifyClient *proxy.MockClient) {
+		mockSpotifyClient.EXPECT().CurrentUsersAlbums(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&spotify.SavedAlbumPage{
+				Albums: []spotify.SavedAlbum{
+					{FullAlbum: spotify.FullAlbum{SimpleAlbum: spotify.SimpleAlbum{ID: "test_album_id_1", Name: "test_album_name_1"}}},
+					{FullAlbum: spotify.FullAlbum{SimpleAlbum: spotify.SimpleAlbum{ID: "test_karaoke_album_id", Name: "test_album_name (Karaoke)"}}},
+				},
+			},
+			nil,
+		)
+		mockSpotifyClient.EXPECT().CurrentUsersTracks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&spotify.SavedTrackPage{
+				Tracks: []spotify.SavedTrack{
+					{FullTrack: spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: "test_karaoke_track_id", Name: "test_track_name (Karaoke Version)"}}},
+				},
+			},
+			nil,
+		)
+	}
+	setPrompt := func(mockCtrl *gomock.Controller, label string, answer string, err error) {
+		mockPrompt := proxy.NewMockPrompt(mockCtrl)
+		mockPrompt.EXPECT().SetLabel(label)
+		mockPrompt.EXPECT().Run().Return(answer, err)
+		mockPromptui := proxy.NewMockPromptui(mockCtrl)
+		mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+		presenter.Pu = utility.NewPromptUtil(mockPromptui)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		applyOps = origApplyOps
+		GlobalOps = origGlobalOps
+		presenter.Pu = origPu
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		rules        bool
+		never        bool
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (no rules file specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			rules:        false,
+			wantOutput:   formatter.Yellow("⚡Norulesfilespecified..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (apply the rules)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_artist_id]likeartist:test_artist_name=>liked[test_album_id_1]likealbum:test_album_name_1=>liked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, false, false)
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				GlobalOps.ConfirmThreshold = 1
+				setPrompt(mockCtrl, "Proceed with applying 2 changes of the rules above ? [y/N]", "y", nil)
+			},
+		},
+		{
+			name: "positive testing (apply the rules without confirming)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_album_id_1]likealbum:test_album_name_1=>liked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.NoConfirm = true
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, true, false)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (apply the rules with confirming each change)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_album_id_1]likealbum:test_album_name_1=>liked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, true, false)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with applying like album test_album_name_1 (test_album_id_1) ? [y/N/a/q]", "y", nil)
+			},
+		},
+		{
+			name: "positive testing (cancelled applying a change)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_album_id_1]likealbum:test_album_name_1=>canceled",
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, false)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with applying like album test_album_name_1 (test_album_id_1) ? [y/N/a/q]", "n", nil)
+			},
+		},
+		{
+			name: "positive testing (unlike the contents never to be liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			never:        true,
+			wantOutput:   "[test_karaoke_album_id]unlikealbum:test_album_name(Karaoke)=>unliked[test_karaoke_track_id]unliketrack:test_track_name(KaraokeVersion)=>unliked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.NoConfirm = true
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, true, true)
+				expectNeverPlan(mockSpotifyClient)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(gomock.Any(), spotify.ID("test_karaoke_album_id")).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_karaoke_track_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (dry run of unliking the contents never to be liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			never:        true,
+			wantOutput:   "[test_karaoke_album_id]unlikealbum:test_album_name(Karaoke)=>planned[test_karaoke_track_id]unliketrack:test_track_name(KaraokeVersion)=>planned",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DryRun = true
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, true)
+				expectNeverPlan(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_artist_id]likeartist:test_artist_name=>planned[test_album_id_1]likealbum:test_album_name_1=>planned",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DryRun = true
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, false, false)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (the library already satisfies the rules)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Yellow("⚡Thelibraryalreadysatisfiestherules...(2contentsmatched)"),
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, true)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (cancelled applying)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Yellow("🚫Cancelledapplyingtherules..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, false)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				GlobalOps.ConfirmThreshold = 0
+				setPrompt(mockCtrl, "Proceed with applying 1 changes of the rules above ? [y/N]", "n", nil)
+			},
+		},
+		{
+			name: "positive testing (keep going after failed to apply)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "[test_artist_id]likeartist:test_artist_name=>failed(testerror)[test_album_id_1]likealbum:test_album_name_1=>liked" + formatter.Red("❌Failedtolike1contentsbelow...") + "artisttest_artist_name(test_artist_id):testerror",
+			wantErr:      false,
+			wantExitCode: ExitCodePartialFailure,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.KeepGoing = true
+				applyOps.NoConfirm = true
+				applyOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, false, false)
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(errors.New("test error"))
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to load the rules file)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{filepath.Join(t.TempDir(), "not_exist.yaml")},
+			},
+			rules:        false,
+			wantOutput:   formatter.Red("❌Failedtoloadtherulesfile..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (failed to plan the rules)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil, errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to plan the never patterns)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			never:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, true)
+				mockSpotifyClient.EXPECT().CurrentUsersAlbums(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to apply)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				applyOps.NoConfirm = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, false, true)
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			SetExitCode(ExitCodeOk)
+			GlobalOps.DataDir = t.TempDir()
+			if tt.rules {
+				tt.args.args = []string{writeRules(t, tt.never)}
+			}
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runApply(exit, tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runApply() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runApply() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runApply() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package spotlike
 
 import (
+	"errors"
 	"os"
 
 	c "github.com/spf13/cobra"
@@ -55,7 +56,7 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 		*output = o
 		return nil
 	}
-	if client, err := clientManager.GetClient(); err != nil && err.Error() != "client not initialized" {
+	if client, err := clientManager.GetClient(); err != nil && !errors.Is(err, api.ErrNotAuthenticated) {
 		return err
 	} else if client != nil {
 		o := formatter.Yellow("⚡You've already setup your Spotify client...")
@@ -67,14 +68,14 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 		for {
 			if id, err := presenter.RunPrompt(
 				"🆔 Input your Spotify Client ID",
-			); err != nil && err.Error() == "^C" {
+			); err != nil && errors.Is(err, api.ErrCanceled) {
 				if err := presenter.Print(os.Stdout, "\n"); err != nil {
 					return err
 				}
 				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled authentication...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
@@ -91,14 +92,14 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 			if secret, err := presenter.RunPromptWithMask(
 				"🔑 Input your Spotify Client Secret",
 				'*',
-			); err != nil && err.Error() == "^C" {
+			); err != nil && errors.Is(err, api.ErrCanceled) {
 				if err := presenter.Print(os.Stdout, "\n"); err != nil {
 					return err
 				}
 				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled authentication...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
@@ -114,14 +115,14 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 		for {
 			if redirectUri, err := presenter.RunPrompt(
 				"🔗 Input your Spotify Redirect URI",
-			); err != nil && err.Error() == "^C" {
+			); err != nil && errors.Is(err, api.ErrCanceled) {
 				if err := presenter.Print(os.Stdout, "\n"); err != nil {
 					return err
 				}
 				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled authentication...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
@@ -146,12 +147,7 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 		defer close(doneChan)
 		err = clientManager.InitializeClient(
 			cmd.Context(),
-			&api.ClientConfig{
-				SpotifyID:           conf.SpotifyID,
-				SpotifySecret:       conf.SpotifySecret,
-				SpotifyRedirectUri:  conf.SpotifyRedirectUri,
-				SpotifyRefreshToken: "",
-			},
+			NewClientConfig(conf, ""),
 		)
 		if err != nil {
 			authUrlChan <- ""
@@ -171,12 +167,7 @@ func runAuth(exit func(int), cmd *c.Command, version string, conf *config.Spotli
 		conf.SpotifyRefreshToken = refreshToken
 
 		if client != nil {
-			client.UpdateConfig(&api.ClientConfig{
-				SpotifyID:           conf.SpotifyID,
-				SpotifySecret:       conf.SpotifySecret,
-				SpotifyRedirectUri:  conf.SpotifyRedirectUri,
-				SpotifyRefreshToken: refreshToken,
-			})
+			client.UpdateConfig(NewClientConfig(conf, refreshToken))
 		}
 	}()
 	authUrl := <-authUrlChan
@@ -288,7 +288,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -350,13 +350,13 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -396,13 +396,13 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -449,13 +449,13 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -503,7 +503,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -559,7 +559,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockPrompt1 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt1.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
 				mockPrompt1.EXPECT().Run().Return("", nil)
@@ -646,7 +646,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -656,7 +656,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt2.EXPECT().SetLabel("🔑 Input your Spotify Client Secret")
 				mockPrompt2.EXPECT().SetMask('*')
-				mockPrompt2.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt2.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -699,7 +699,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -709,7 +709,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt2.EXPECT().SetLabel("🔑 Input your Spotify Client Secret")
 				mockPrompt2.EXPECT().SetMask('*')
-				mockPrompt2.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt2.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -759,7 +759,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -769,7 +769,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt2.EXPECT().SetLabel("🔑 Input your Spotify Client Secret")
 				mockPrompt2.EXPECT().SetMask('*')
-				mockPrompt2.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt2.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -820,7 +820,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -883,7 +883,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockPrompt1 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt1.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
 				mockPrompt1.EXPECT().Run().Return("test_client_id", nil)
@@ -971,7 +971,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -984,7 +984,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2.EXPECT().Run().Return("test_client_secret", nil)
 				mockPrompt3 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt3.EXPECT().SetLabel("🔗 Input your Spotify Redirect URI")
-				mockPrompt3.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt3.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -1028,7 +1028,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -1041,7 +1041,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2.EXPECT().Run().Return("test_client_secret", nil)
 				mockPrompt3 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt3.EXPECT().SetLabel("🔗 Input your Spotify Redirect URI")
-				mockPrompt3.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt3.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -1092,7 +1092,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -1105,7 +1105,7 @@ func Test_runAuth(t *testing.T) {
 				mockPrompt2.EXPECT().Run().Return("test_client_secret", nil)
 				mockPrompt3 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt3.EXPECT().SetLabel("🔗 Input your Spotify Redirect URI")
-				mockPrompt3.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt3.EXPECT().Run().Return("", proxy.ErrInterrupt)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				gomock.InOrder(
 					mockPromptui.EXPECT().NewPrompt().Return(mockPrompt1),
@@ -1157,7 +1157,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -1224,7 +1224,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockPrompt1 := proxy.NewMockPrompt(mockCtrl)
 				mockPrompt1.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
 				mockPrompt1.EXPECT().Run().Return("test_client_id", nil)
@@ -1308,7 +1308,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1360,7 +1360,7 @@ func Test_runAuth(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1412,7 +1412,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1479,7 +1479,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1548,7 +1548,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1618,7 +1618,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1693,7 +1693,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1764,7 +1764,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1836,7 +1836,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1910,7 +1910,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -1985,7 +1985,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
@@ -2061,7 +2061,7 @@ func Test_runAuth(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
 				mockClient := api.NewMockClient(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized"))
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized)
 				mockClientManager.EXPECT().InitializeClient(
 					gomock.Any(),
 					gomock.Any(),
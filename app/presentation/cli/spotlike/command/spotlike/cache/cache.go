@@ -0,0 +1,89 @@
+package cache
+
+import (
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// NewCacheCommand creates a new cache command.
+func NewCacheCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("cache")
+	cmd.SetAliases([]string{"ca", "C"})
+	cmd.SetUsageTemplate(cacheUsageTemplate)
+	cmd.SetHelpTemplate(cacheHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.AddCommand(
+		NewCacheStatsCommand(
+			cobra,
+			output,
+		),
+		NewCacheClearCommand(
+			cobra,
+			output,
+		),
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCache(output)
+		},
+	)
+
+	return cmd
+}
+
+// runCache runs the cache command.
+func runCache(output *string) error {
+	o := formatter.Yellow("⚡ Use sub command below...")
+	o += `
+
+  - 📊 stats
+  - 🧹 clear
+
+Use "spotlike cache --help" for more information about spotlike cache.
+Use "spotlike cache [command] --help" for more information about a command.
+`
+	*output = o
+
+	return nil
+}
+
+const (
+	// cacheHelpTemplate is the help template of the cache command.
+	cacheHelpTemplate = `🗄️ Manage the cache of the catalog lookups.
+
+The artists, albums and tracks looked up on Spotify are cached
+in "$XDG_CACHE_HOME/spotlike" (or "~/.cache/spotlike") for the hours specified by the "--cache-ttl" option.
+The responses of Spotify are revalidated with the ETag after they are expired.
+Whether the contents are liked or not is never cached.
+
+You can disable the cache by specifying the "--no-cache" option.
+
+` + cacheUsageTemplate
+	// cacheUsageTemplate is the usage template of the cache command.
+	cacheUsageTemplate = `Usage:
+  spotlike cache [flags]
+  spotlike ca    [flags]
+  spotlike C     [flags]
+  spotlike cache [command]
+  spotlike ca    [command]
+  spotlike C     [command]
+
+Available Commands:
+  stats, st, s  📊 Show the statistics of the cache.
+  clear, cl, c  🧹 Clear the cache.
+
+Flags:
+  -h, --help  🤝 help for cache
+
+Use "spotlike cache [command] --help" for more information about a command.
+`
+)
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+func TestNewCacheCommand(t *testing.T) {
+	output := ""
+
+	got := NewCacheCommand(proxy.NewCobra(), &output)
+	if got == nil {
+		t.Errorf("NewCacheCommand() = %v, want not nil", got)
+		return
+	}
+	if err := got.RunE(nil, []string{}); err != nil {
+		t.Errorf("Failed to run cache command: %v", err)
+	}
+}
+
+func Test_runCache(t *testing.T) {
+	output := ""
+	want := formatter.Yellow("⚡ Use sub command below...") + `
+
+  - 📊 stats
+  - 🧹 clear
+
+Use "spotlike cache --help" for more information about spotlike cache.
+Use "spotlike cache [command] --help" for more information about a command.
+`
+	if err := runCache(&output); err != nil {
+		t.Errorf("runCache() error = %v", err)
+	}
+	if output != want {
+		t.Errorf("runCache() output = %v, want %v", output, want)
+	}
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// NewCacheClearCommand returns a new instance of the cache clear command.
+func NewCacheClearCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("clear")
+	cmd.SetAliases([]string{"cl", "c"})
+	cmd.SetUsageTemplate(cacheClearUsageTemplate)
+	cmd.SetHelpTemplate(cacheClearHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCacheClear(output)
+		},
+	)
+
+	return cmd
+}
+
+// runCacheClear clears the cache.
+func runCacheClear(output *string) error {
+	if spotlike.GlobalOps.CacheDir == "" {
+		o := formatter.Yellow("⚡ The cache directory is not determined... Please set XDG_CACHE_HOME or HOME...")
+		*output = o
+		return nil
+	}
+
+	if err := cache.NewCache(spotlike.GlobalOps.CacheDir).Clear(); err != nil {
+		o := formatter.Red("❌ Failed to clear the cache...")
+		*output = o
+		return err
+	}
+
+	o := formatter.Green("✅🧹 Successfully cleared the cache!")
+	*output = o
+
+	return nil
+}
+
+const (
+	// cacheClearHelpTemplate is the help template of the cache clear command.
+	cacheClearHelpTemplate = `🧹 Clear the cache.
+
+You can remove all the entries in the cache.
+The catalog would be looked up on Spotify again next time.
+
+` + cacheClearUsageTemplate
+	// cacheClearUsageTemplate is the usage template of the cache clear command.
+	cacheClearUsageTemplate = `Usage:
+  spotlike cache clear [flags]
+  spotlike cache cl    [flags]
+  spotlike cache c     [flags]
+
+Flags:
+  -h, --help  🤝 help for clear
+`
+)
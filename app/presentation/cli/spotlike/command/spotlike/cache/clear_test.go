@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+func TestNewCacheClearCommand(t *testing.T) {
+	output := ""
+
+	got := NewCacheClearCommand(proxy.NewCobra(), &output)
+	if got == nil {
+		t.Errorf("NewCacheClearCommand() = %v, want not nil", got)
+	}
+}
+
+func Test_runCacheClear(t *testing.T) {
+	origGlobalOps := spotlike.GlobalOps
+
+	tests := []struct {
+		name        string
+		setup       func(dir string) string
+		wantOutput  string
+		wantEntries int
+		wantErr     bool
+	}{
+		{
+			name: "positive testing",
+			setup: func(dir string) string {
+				if err := cache.NewCache(dir).Set("test_key", "test_value", time.Hour); err != nil {
+					t.Errorf("Failed to set the cache: %v", err)
+				}
+				return dir
+			},
+			wantOutput:  formatter.Green("✅🧹 Successfully cleared the cache!"),
+			wantEntries: 0,
+			wantErr:     false,
+		},
+		{
+			name: "positive testing (cache dir is not determined)",
+			setup: func(dir string) string {
+				return ""
+			},
+			wantOutput:  formatter.Yellow("⚡ The cache directory is not determined... Please set XDG_CACHE_HOME or HOME..."),
+			wantEntries: 0,
+			wantErr:     false,
+		},
+		{
+			name: "negative testing (failed to remove the entry)",
+			setup: func(dir string) string {
+				if err := os.MkdirAll(filepath.Join(dir, "test.json", "child"), 0755); err != nil {
+					t.Errorf("Failed to make the directory: %v", err)
+				}
+				return dir
+			},
+			wantOutput:  formatter.Red("❌ Failed to clear the cache..."),
+			wantEntries: 1,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			spotlike.GlobalOps.CacheDir = tt.setup(dir)
+			defer func() {
+				spotlike.GlobalOps = origGlobalOps
+			}()
+			output := ""
+			if err := runCacheClear(&output); (err != nil) != tt.wantErr {
+				t.Errorf("runCacheClear() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output != tt.wantOutput {
+				t.Errorf("runCacheClear() output = %v, want %v", output, tt.wantOutput)
+			}
+			files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+			if len(files) != tt.wantEntries {
+				t.Errorf("runCacheClear() entries = %v, want %v", len(files), tt.wantEntries)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package cache provides the cache sub commands for the spotlike cli.
+package cache
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"fmt"
+
+	c "github.com/spf13/cobra"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// NewCacheStatsCommand returns a new instance of the cache stats command.
+func NewCacheStatsCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("stats")
+	cmd.SetAliases([]string{"st", "s"})
+	cmd.SetUsageTemplate(cacheStatsUsageTemplate)
+	cmd.SetHelpTemplate(cacheStatsHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCacheStats(output)
+		},
+	)
+
+	return cmd
+}
+
+// runCacheStats shows the statistics of the cache.
+func runCacheStats(output *string) error {
+	if spotlike.GlobalOps.CacheDir == "" {
+		o := formatter.Yellow("⚡ The cache directory is not determined... Please set XDG_CACHE_HOME or HOME...")
+		*output = o
+		return nil
+	}
+
+	stats, err := cache.NewCache(spotlike.GlobalOps.CacheDir).Stats()
+	if err != nil {
+		return err
+	}
+
+	*output = fmt.Sprintf(
+		"🗄️ Directory : %s\n📦 Entries   : %d (%d expired)\n💾 Size      : %s",
+		stats.Dir,
+		stats.Entries,
+		stats.Expired,
+		formatSize(stats.Size),
+	)
+
+	return nil
+}
+
+// formatSize returns the size in bytes in the human readable format.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+const (
+	// cacheStatsHelpTemplate is the help template of the cache stats command.
+	cacheStatsHelpTemplate = `📊 Show the statistics of the cache.
+
+You can see the directory, the number of the entries and the size of the cache.
+
+` + cacheStatsUsageTemplate
+	// cacheStatsUsageTemplate is the usage template of the cache stats command.
+	cacheStatsUsageTemplate = `Usage:
+  spotlike cache stats [flags]
+  spotlike cache st    [flags]
+  spotlike cache s     [flags]
+
+Flags:
+  -h, --help  🤝 help for stats
+`
+)
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+func TestNewCacheStatsCommand(t *testing.T) {
+	output := ""
+
+	got := NewCacheStatsCommand(proxy.NewCobra(), &output)
+	if got == nil {
+		t.Errorf("NewCacheStatsCommand() = %v, want not nil", got)
+	}
+}
+
+func Test_runCacheStats(t *testing.T) {
+	origGlobalOps := spotlike.GlobalOps
+
+	tests := []struct {
+		name       string
+		setup      func(dir string) string
+		wantOutput func(dir string) string
+		wantErr    bool
+	}{
+		{
+			name: "positive testing (cache is empty)",
+			setup: func(dir string) string {
+				return dir
+			},
+			wantOutput: func(dir string) string {
+				return "🗄️ Directory : " + dir + "\n📦 Entries   : 0 (0 expired)\n💾 Size      : 0 B"
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (cache has entries)",
+			setup: func(dir string) string {
+				c := cache.NewCache(dir)
+				if err := c.Set("test_key_1", "test_value_1", time.Hour); err != nil {
+					t.Errorf("Failed to set the cache: %v", err)
+				}
+				if err := c.Set("test_key_2", "test_value_2", time.Nanosecond); err != nil {
+					t.Errorf("Failed to set the cache: %v", err)
+				}
+				time.Sleep(time.Millisecond)
+				return dir
+			},
+			wantOutput: func(dir string) string {
+				var size int64
+				files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+				for _, file := range files {
+					info, _ := os.Stat(file)
+					size += info.Size()
+				}
+				return "🗄️ Directory : " + dir + "\n📦 Entries   : 2 (1 expired)\n💾 Size      : " + formatSize(size)
+			},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (cache dir is not determined)",
+			setup: func(dir string) string {
+				return ""
+			},
+			wantOutput: func(dir string) string {
+				return formatter.Yellow("⚡ The cache directory is not determined... Please set XDG_CACHE_HOME or HOME...")
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			spotlike.GlobalOps.CacheDir = tt.setup(dir)
+			defer func() {
+				spotlike.GlobalOps = origGlobalOps
+			}()
+			output := ""
+			if err := runCacheStats(&output); (err != nil) != tt.wantErr {
+				t.Errorf("runCacheStats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if want := tt.wantOutput(dir); output != want {
+				t.Errorf("runCacheStats() output = %v, want %v", output, want)
+			}
+		})
+	}
+}
+
+func Test_formatSize(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{size: 0, want: "0 B"},
+		{size: 1023, want: "1023 B"},
+		{size: 1536, want: "1.5 KiB"},
+		{size: 5 * 1024 * 1024, want: "5.0 MiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatSize(tt.size); got != tt.want {
+				t.Errorf("formatSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package check
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// CheckAlbumOptions represents the options for the check album command.
+type CheckAlbumOptions struct {
+	Artist string
+	Format string
+}
+
+var (
+	// checkAlbumOps is a variable to store the check album options with the default values for injecting the dependencies in testing.
+	checkAlbumOps = CheckAlbumOptions{
+		Artist: "",
+		Format: "table",
+	}
+)
+
+// NewCheckAlbumCommand creates a new check album command.
+func NewCheckAlbumCommand(
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("album")
+	cmd.SetAliases([]string{"al", "a"})
+	cmd.SetUsageTemplate(checkAlbumUsageTemplate)
+	cmd.SetHelpTemplate(checkAlbumHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&checkAlbumOps.Artist,
+		"artist",
+		"A",
+		"",
+		"🆔 an ID of the artist to check all albums released by the artist",
+	)
+	cmd.Flags().StringVarP(
+		&checkAlbumOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCheckAlbum(cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runCheckAlbum executes the check album command.
+func runCheckAlbum(cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	ids, err := readIds(args)
+	if err != nil {
+		o := formatter.Red("❌ Failed to read the IDs from the standard input...")
+		*output = o
+		return err
+	}
+	if checkAlbumOps.Artist == "" && len(ids) == 0 {
+		o := formatter.Yellow("⚡ No ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	progress := spotlike.NewProgressReporter(checkAlbumOps.Format)
+	// the albums not found are nil, so that the results are in the order of the IDs
+	var gaucoDtos []*spotlikeApp.GetAlbumUseCaseOutputDto
+	albumRepo := spotlike.NewAlbumRepository()
+	if checkAlbumOps.Artist != "" {
+		gAuc := spotlikeApp.NewGetArtistUseCase(spotlike.NewArtistRepository())
+		gAucoDto, err := gAuc.Run(cmd.Context(), checkAlbumOps.Artist)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return err
+		}
+		if gAucoDto == nil {
+			o := formatter.Yellow("⚡ The id " + checkAlbumOps.Artist + " is not found or it is not an artist...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
+			return nil
+		}
+
+		gaaAuc := spotlikeApp.NewGetAllAlbumsByArtistIdUseCase(albumRepo)
+		gaaAucoDtos, err := gaaAuc.Run(cmd.Context(), checkAlbumOps.Artist)
+		if err != nil {
+			return err
+		}
+		for _, gaaAucoDto := range gaaAucoDtos {
+			gaucoDtos = append(gaucoDtos, &spotlikeApp.GetAlbumUseCaseOutputDto{ID: gaaAucoDto.ID, Name: gaaAucoDto.Name})
+		}
+	} else {
+		gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
+		for _, id := range ids {
+			gaucoDto, err := gauc.Run(cmd.Context(), id)
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				return err
+			}
+			gaucoDtos = append(gaucoDtos, gaucoDto)
+		}
+	}
+
+	var checkIds []string
+	for _, gaucoDto := range gaucoDtos {
+		if gaucoDto != nil {
+			checkIds = append(checkIds, gaucoDto.ID)
+		}
+	}
+	clauc := spotlikeApp.NewCheckLikeAlbumsUseCase(albumRepo)
+	liked, err := clauc.Run(spotlike.WithProgress(cmd.Context(), progress), checkIds)
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	var states []*spotlikeApp.LikeStateDto
+	checked := 0
+	for i, gaucoDto := range gaucoDtos {
+		if gaucoDto == nil {
+			states = append(states, spotlikeApp.NewLikeStateDto("album", ids[i], "", spotlikeApp.LikeStateNotFound))
+			continue
+		}
+		states = append(states, spotlikeApp.NewLikeStateDto("album", gaucoDto.ID, gaucoDto.Name, likeState("album", liked[checked])))
+		checked++
+	}
+
+	if len(states) == 0 {
+		o := formatter.Yellow("⚡ No albums found to check...")
+		*output = o
+		spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+		return nil
+	}
+
+	return formatStates(checkAlbumOps.Format, output, states)
+}
+
+const (
+	// checkAlbumHelpTemplate is a template for the help message of the check album command.
+	checkAlbumHelpTemplate = `🔎💿 Check whether albums on Spotify are liked by ID.
+
+You can check whether albums on Spotify are liked by ID without liking or unliking them.
+If you specify "-" as the argument, the IDs separated by whitespaces are read from the standard input.
+
+Also, you can check all albums released by the artist with specifying the ID of the artist with artist flag.
+If you specify artist flag, the arguments would be ignored.
+
+If some of the albums are not liked, spotlike exits with the code 9.
+
+` + checkAlbumUsageTemplate
+	// checkAlbumUsageTemplate is a template for the usage message of the check album command.
+	checkAlbumUsageTemplate = `Usage:
+  spotlike check album [flags] [arguments]
+  spotlike check al    [flags] [arguments]
+  spotlike check a     [flags] [arguments]
+
+Flags:
+  -A, --artist  🆔 an ID of the artist to check all albums released by the artist
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for album
+
+Global Flags:
+  -q, --quiet   🤫 do not show the progress of long running operations
+
+Arguments:
+  ID  🆔 ID of the albums, or "-" to read them from the standard input (e.g: "1dGzXXa8MeTCdi0oBbvB1J 6Xy481vVb9vPK4qbCuT9u1")
+`
+)
@@ -0,0 +1,296 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCheckAlbumCommand(t *testing.T) {
+	output := ""
+
+	type args struct {
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:   proxy.NewCobra(),
+				authCmd: newAuthCommand(&output),
+				output:  &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCheckAlbumCommand(tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewCheckAlbumCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the check album command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runCheckAlbum(t *testing.T) {
+	output := ""
+	origCheckAlbumOps := checkAlbumOps
+	origGlobalOps := spotlike.GlobalOps
+	origStdin := stdin
+	su := utility.NewStringsUtil()
+	authCmd := newAuthCommand(&output)
+	artist := spotify.SimpleArtist{
+		ID:   "test_artist_id",
+		Name: "test_artist_name",
+	}
+	expectAlbum := func(mockSpotifyClient *proxy.MockClient, id string) {
+		mockSpotifyClient.EXPECT().GetAlbum(gomock.Any(), spotify.ID(id)).Return(
+			&spotify.FullAlbum{
+				SimpleAlbum: spotify.SimpleAlbum{
+					ID:                   spotify.ID(id),
+					Name:                 id + "_name",
+					Artists:              []spotify.SimpleArtist{artist},
+					ReleaseDate:          "2000-01-01",
+					ReleaseDatePrecision: "day",
+				},
+			},
+			nil,
+		)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		checkAlbumOps = origCheckAlbumOps
+		spotlike.GlobalOps = origGlobalOps
+		stdin = origStdin
+		output = ""
+		spotlike.SetExitCode(spotlike.ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (all albums are liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_album_id_1"},
+			},
+			wantOutput:   "🆔ID📁TYPE📛NAME🤍STATEtest_album_id_1albumtest_album_id_1_namelikedTOTAL:1contents!",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectAlbum(mockSpotifyClient, "test_album_id_1")
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{true}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (some albums are not liked with stdin)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"-"},
+			},
+			wantOutput:   "[test_album_id_1]album:test_album_id_1_name=>liked[test_album_id_2]album:test_album_id_2_name=>not_liked",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeNotLiked,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkAlbumOps.Format = "plain"
+				stdin = strings.NewReader("test_album_id_1 test_album_id_2")
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectAlbum(mockSpotifyClient, "test_album_id_1")
+				expectAlbum(mockSpotifyClient, "test_album_id_2")
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1"), spotify.ID("test_album_id_2")).Return([]bool{true, false}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (some albums are not found)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_album_id_1", "test_album_id_2", "test_album_id_3"},
+			},
+			wantOutput:   "[test_album_id_1]album:test_album_id_1_name=>liked[test_album_id_2]album:=>not_found[test_album_id_3]album:test_album_id_3_name=>not_liked",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeNotFound,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkAlbumOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectAlbum(mockSpotifyClient, "test_album_id_1")
+				mockSpotifyClient.EXPECT().GetAlbum(gomock.Any(), spotify.ID("test_album_id_2")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				expectAlbum(mockSpotifyClient, "test_album_id_3")
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1"), spotify.ID("test_album_id_3")).Return([]bool{true, false}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (artist option is set)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_1]album:test_album_id_1_name=>not_liked",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeNotLiked,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkAlbumOps.Artist = "test_artist_id"
+				checkAlbumOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(&spotify.FullArtist{SimpleArtist: artist}, nil)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:                   "test_album_id_1",
+								Name:                 "test_album_id_1_name",
+								Artists:              []spotify.SimpleArtist{artist},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (no ID arguments specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡NoIDargumentsspecified..."),
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (artist is not found)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Theidtest_artist_idisnotfoundoritisnotanartist..."),
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeNotFound,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkAlbumOps.Artist = "test_artist_id"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to read the standard input)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"-"},
+			},
+			wantOutput:   formatter.Red("❌FailedtoreadtheIDsfromthestandardinput..."),
+			wantErr:      true,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				stdin = errReader{}
+			},
+		},
+		{
+			name: "negative testing (client manager is not initialized)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_album_id_1"},
+			},
+			wantOutput:   formatter.Red("❌Clientmanagerisnotinitialized..."),
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (failed to check the album)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_album_id_1"},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectAlbum(mockSpotifyClient, "test_album_id_1")
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil, errors.New("failed to check the album"))
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			spotlike.SetExitCode(spotlike.ExitCodeOk)
+			spotlike.GlobalOps.NoCache = true
+			spotlike.GlobalOps.Quiet = true
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runCheckAlbum(tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runCheckAlbum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runCheckAlbum() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if spotlike.GetExitCode() != tt.wantExitCode {
+				t.Errorf("runCheckAlbum() exit code = %v, want %v", spotlike.GetExitCode(), tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package check
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// CheckArtistOptions represents the options for the check artist command.
+type CheckArtistOptions struct {
+	Format string
+}
+
+var (
+	// checkArtistOps is a variable to store the check artist options with the default values for injecting the dependencies in testing.
+	checkArtistOps = CheckArtistOptions{
+		Format: "table",
+	}
+)
+
+// NewCheckArtistCommand creates a new check artist command.
+func NewCheckArtistCommand(
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("artist")
+	cmd.SetAliases([]string{"ar", "A"})
+	cmd.SetUsageTemplate(checkArtistUsageTemplate)
+	cmd.SetHelpTemplate(checkArtistHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&checkArtistOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCheckArtist(cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runCheckArtist executes the check artist command.
+func runCheckArtist(cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	ids, err := readIds(args)
+	if err != nil {
+		o := formatter.Red("❌ Failed to read the IDs from the standard input...")
+		*output = o
+		return err
+	}
+	if len(ids) == 0 {
+		o := formatter.Yellow("⚡ No ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	progress := spotlike.NewProgressReporter(checkArtistOps.Format)
+	// the artists not found are nil, so that the results are in the order of the IDs
+	var gAucoDtos []*spotlikeApp.GetArtistUseCaseOutputDto
+	var checkIds []string
+	artistRepo := spotlike.NewArtistRepository()
+	gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
+	for i, id := range ids {
+		progress.Report("artists found", i, len(ids))
+		gAucoDto, err := gAuc.Run(cmd.Context(), id)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			progress.Done()
+			return err
+		}
+		gAucoDtos = append(gAucoDtos, gAucoDto)
+		if gAucoDto != nil {
+			checkIds = append(checkIds, gAucoDto.ID)
+		}
+	}
+	progress.Done()
+
+	clAuc := spotlikeApp.NewCheckLikeArtistsUseCase(artistRepo)
+	followed, err := clAuc.Run(spotlike.WithProgress(cmd.Context(), progress), checkIds)
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	var states []*spotlikeApp.LikeStateDto
+	checked := 0
+	for i, gAucoDto := range gAucoDtos {
+		if gAucoDto == nil {
+			states = append(states, spotlikeApp.NewLikeStateDto("artist", ids[i], "", spotlikeApp.LikeStateNotFound))
+			continue
+		}
+		states = append(states, spotlikeApp.NewLikeStateDto("artist", gAucoDto.ID, gAucoDto.Name, likeState("artist", followed[checked])))
+		checked++
+	}
+
+	return formatStates(checkArtistOps.Format, output, states)
+}
+
+const (
+	// checkArtistHelpTemplate is a template for the help message of the check artist command.
+	checkArtistHelpTemplate = `🔎🎤 Check whether artists on Spotify are followed by ID.
+
+You can check whether artists on Spotify are followed by ID without following or unfollowing them.
+If you specify "-" as the argument, the IDs separated by whitespaces are read from the standard input.
+
+If some of the artists are not followed, spotlike exits with the code 9.
+
+` + checkArtistUsageTemplate
+	// checkArtistUsageTemplate is a template for the usage message of the check artist command.
+	checkArtistUsageTemplate = `Usage:
+  spotlike check artist [flags] [arguments]
+  spotlike check ar     [flags] [arguments]
+  spotlike check A      [flags] [arguments]
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for artist
+
+Global Flags:
+  -q, --quiet   🤫 do not show the progress of long running operations
+
+Arguments:
+  ID  🆔 ID of the artists, or "-" to read them from the standard input (e.g. : "00DuPiLri3mNomvvM3nZvU 3B9O5mYYw89fFXkwKh7jCS")
+`
+)
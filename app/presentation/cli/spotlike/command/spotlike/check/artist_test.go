@@ -0,0 +1,237 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCheckArtistCommand(t *testing.T) {
+	output := ""
+
+	type args struct {
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:   proxy.NewCobra(),
+				authCmd: newAuthCommand(&output),
+				output:  &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCheckArtistCommand(tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewCheckArtistCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the check artist command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runCheckArtist(t *testing.T) {
+	output := ""
+	origCheckArtistOps := checkArtistOps
+	origGlobalOps := spotlike.GlobalOps
+	origStdin := stdin
+	su := utility.NewStringsUtil()
+	authCmd := newAuthCommand(&output)
+	expectArtist := func(mockSpotifyClient *proxy.MockClient, id string) {
+		mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID(id)).Return(
+			&spotify.FullArtist{
+				SimpleArtist: spotify.SimpleArtist{
+					ID:   spotify.ID(id),
+					Name: id + "_name",
+				},
+			},
+			nil,
+		)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		checkArtistOps = origCheckArtistOps
+		spotlike.GlobalOps = origGlobalOps
+		stdin = origStdin
+		output = ""
+		spotlike.SetExitCode(spotlike.ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (all artists are followed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_artist_id_1"},
+			},
+			wantOutput:   "[test_artist_id_1]artist:test_artist_id_1_name=>followed",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkArtistOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectArtist(mockSpotifyClient, "test_artist_id_1")
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id_1")).Return([]bool{true}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (some artists are not followed with stdin)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_artist_id_1", "-"},
+			},
+			wantOutput:   "[test_artist_id_1]artist:test_artist_id_1_name=>followed[test_artist_id_2]artist:test_artist_id_2_name=>not_followed[test_artist_id_3]artist:=>not_found",
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeNotFound,
+			setup: func(mockCtrl *gomock.Controller) {
+				checkArtistOps.Format = "plain"
+				stdin = strings.NewReader("test_artist_id_2\ntest_artist_id_3\n")
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectArtist(mockSpotifyClient, "test_artist_id_1")
+				expectArtist(mockSpotifyClient, "test_artist_id_2")
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id_3")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id_1"), spotify.ID("test_artist_id_2")).Return([]bool{true, false}, nil)
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (no ID arguments specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡NoIDargumentsspecified..."),
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (failed to read the standard input)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"-"},
+			},
+			wantOutput:   formatter.Red("❌FailedtoreadtheIDsfromthestandardinput..."),
+			wantErr:      true,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				stdin = errReader{}
+			},
+		},
+		{
+			name: "negative testing (client manager is not initialized)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_artist_id_1"},
+			},
+			wantOutput:   formatter.Red("❌Clientmanagerisnotinitialized..."),
+			wantErr:      false,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (failed to get the artist)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_artist_id_1"},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id_1")).Return(nil, errors.New("failed to get the artist"))
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to check the artist)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"test_artist_id_1"},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectArtist(mockSpotifyClient, "test_artist_id_1")
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id_1")).Return(nil, errors.New("failed to check the artist"))
+				initializeClient(t, mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			spotlike.SetExitCode(spotlike.ExitCodeOk)
+			spotlike.GlobalOps.NoCache = true
+			spotlike.GlobalOps.Quiet = true
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runCheckArtist(tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runCheckArtist() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runCheckArtist() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if spotlike.GetExitCode() != tt.wantExitCode {
+				t.Errorf("runCheckArtist() exit code = %v, want %v", spotlike.GetExitCode(), tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package check
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+var (
+	// stdin is a variable to store the reader of the IDs given with "-" for injecting the dependencies in testing.
+	stdin io.Reader = os.Stdin
+)
+
+// NewCheckCommand creates a new check command.
+func NewCheckCommand(
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("check")
+	cmd.SetAliases([]string{"ch", "k"})
+	cmd.SetUsageTemplate(checkUsageTemplate)
+	cmd.SetHelpTemplate(checkHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.AddCommand(
+		NewCheckArtistCommand(
+			cobra,
+			authCmd,
+			output,
+		),
+		NewCheckAlbumCommand(
+			cobra,
+			authCmd,
+			output,
+		),
+		NewCheckTrackCommand(
+			cobra,
+			authCmd,
+			output,
+		),
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCheck(output)
+		},
+	)
+
+	return cmd
+}
+
+// runCheck runs the check command.
+func runCheck(output *string) error {
+	o := formatter.Yellow("⚡ Use sub command below...")
+	o += `
+
+  - 🎤 artist
+  - 💿 album
+  - 🎵 track
+
+Use "spotlike check --help" for more information about spotlike check.
+Use "spotlike check [command] --help" for more information about a command.
+`
+	*output = o
+
+	return nil
+}
+
+// readIds returns the IDs in the arguments, replacing "-" with the IDs separated by whitespaces read from the standard input.
+func readIds(args []string) ([]string, error) {
+	var ids []string
+	read := false
+	for _, arg := range args {
+		if arg != "-" {
+			ids = append(ids, arg)
+			continue
+		}
+		if read {
+			continue
+		}
+		read = true
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			ids = append(ids, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// likeState returns the state of the content from whether it is liked or not.
+func likeState(contentType string, liked bool) string {
+	switch {
+	case contentType == "artist" && liked:
+		return spotlikeApp.LikeStateFollowed
+	case contentType == "artist":
+		return spotlikeApp.LikeStateNotFollowed
+	case liked:
+		return spotlikeApp.LikeStateLiked
+	default:
+		return spotlikeApp.LikeStateNotLiked
+	}
+}
+
+// formatStates formats the states of the contents checked and sets the exit code from them.
+func formatStates(format string, output *string, states []*spotlikeApp.LikeStateDto) error {
+	f, err := formatter.NewFormatter(format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(states)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	spotlike.SetExitCode(spotlike.LikeStatesExitCode(states))
+
+	return nil
+}
+
+const (
+	// checkHelpTemplate is the help template of the check command.
+	checkHelpTemplate = `🔎 Check whether content on Spotify is liked by ID.
+
+You can check whether the artists are followed and the albums and the tracks are liked without changing your library.
+
+If some of the contents are not liked or not followed, spotlike exits with the code 9,
+so that you can assert the state of your library in your scripts.
+
+` + checkUsageTemplate
+	// checkUsageTemplate is the usage template of the check command.
+	checkUsageTemplate = `Usage:
+  spotlike check [flags]
+  spotlike ch    [flags]
+  spotlike k     [flags]
+  spotlike check [command]
+  spotlike ch    [command]
+  spotlike k     [command]
+
+Available Commands:
+  artist, ar, A  🎤 Check whether artists on Spotify are followed by ID.
+  album,  al, a  💿 Check whether albums on Spotify are liked by ID.
+  track,  tr, t  🎵 Check whether tracks on Spotify are liked by ID.
+
+Flags:
+  -h, --help  🤝 help for check
+
+Use "spotlike check [command] --help" for more information about a command.
+`
+)
@@ -0,0 +1,249 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newAuthCommand returns a new instance of the auth command for testing.
+func newAuthCommand(output *string) proxy.Command {
+	return spotlike.NewAuthCommand(
+		os.Exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		output,
+	)
+}
+
+// initializeClient initializes the client manager with the mock client for testing.
+func initializeClient(t *testing.T, mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+	mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+	mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+	mockSpotify := proxy.NewMockSpotify(mockCtrl)
+	mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+	mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+	cm := api.NewClientManager(
+		mockSpotify,
+		proxy.NewMockHttp(mockCtrl),
+		proxy.NewMockRandstr(mockCtrl),
+		proxy.NewMockUrl(mockCtrl),
+	)
+	if err := cm.InitializeClient(
+		context.Background(),
+		&api.ClientConfig{
+			SpotifyID:           "test_client_id",
+			SpotifySecret:       "test_client_secret",
+			SpotifyRedirectUri:  "test_redirect_uri",
+			SpotifyRefreshToken: "test_refresh_token",
+		},
+	); err != nil {
+		t.Errorf("Failed to initialize client: %v", err)
+	}
+}
+
+// errReader is a reader which always fails to read for testing.
+type errReader struct{}
+
+// Read always returns an error.
+func (errReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("failed to read")
+}
+
+func TestNewCheckCommand(t *testing.T) {
+	output := ""
+
+	type args struct {
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:   proxy.NewCobra(),
+				authCmd: newAuthCommand(&output),
+				output:  &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCheckCommand(tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewCheckCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run check command: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runCheck(t *testing.T) {
+	output := ""
+
+	type args struct {
+		output *string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				output: &output,
+			},
+			wantOutput: formatter.Yellow("⚡ Use sub command below...") + `
+
+  - 🎤 artist
+  - 💿 album
+  - 🎵 track
+
+Use "spotlike check --help" for more information about spotlike check.
+Use "spotlike check [command] --help" for more information about a command.
+`,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runCheck(tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runCheck() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runCheck() output = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func Test_readIds(t *testing.T) {
+	origStdin := stdin
+
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   func()
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "positive testing (without stdin)",
+			args:    []string{"test_id_1", "test_id_2"},
+			stdin:   nil,
+			want:    []string{"test_id_1", "test_id_2"},
+			wantErr: false,
+		},
+		{
+			name: "positive testing (with stdin)",
+			args: []string{"test_id_1", "-", "-"},
+			stdin: func() {
+				stdin = strings.NewReader("test_id_2\ntest_id_3 test_id_4\n")
+			},
+			want:    []string{"test_id_1", "test_id_2", "test_id_3", "test_id_4"},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (failed to read stdin)",
+			args: []string{"-"},
+			stdin: func() {
+				stdin = errReader{}
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.stdin != nil {
+				tt.stdin()
+			}
+			defer func() {
+				stdin = origStdin
+			}()
+			got, err := readIds(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("readIds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readIds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_likeState(t *testing.T) {
+	type args struct {
+		contentType string
+		liked       bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (track is liked)",
+			args: args{contentType: "track", liked: true},
+			want: spotlikeApp.LikeStateLiked,
+		},
+		{
+			name: "positive testing (album is not liked)",
+			args: args{contentType: "album", liked: false},
+			want: spotlikeApp.LikeStateNotLiked,
+		},
+		{
+			name: "positive testing (artist is followed)",
+			args: args{contentType: "artist", liked: true},
+			want: spotlikeApp.LikeStateFollowed,
+		},
+		{
+			name: "positive testing (artist is not followed)",
+			args: args{contentType: "artist", liked: false},
+			want: spotlikeApp.LikeStateNotFollowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := likeState(tt.args.contentType, tt.args.liked); got != tt.want {
+				t.Errorf("likeState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
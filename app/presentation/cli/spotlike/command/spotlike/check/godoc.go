@@ -0,0 +1,2 @@
+// Package check provides the check sub commands for the spotlike cli.
+package check
@@ -0,0 +1,237 @@
+package check
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// CheckTrackOptions represents the options for the check track command.
+type CheckTrackOptions struct {
+	Artist string
+	Album  string
+	Format string
+}
+
+var (
+	// checkTrackOps is a variable to store the check track options with the default values for injecting the dependencies in testing.
+	checkTrackOps = CheckTrackOptions{
+		Artist: "",
+		Album:  "",
+		Format: "table",
+	}
+)
+
+// NewCheckTrackCommand creates a new check track command.
+func NewCheckTrackCommand(
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("track")
+	cmd.SetAliases([]string{"tr", "t"})
+	cmd.SetUsageTemplate(checkTrackUsageTemplate)
+	cmd.SetHelpTemplate(checkTrackHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&checkTrackOps.Artist,
+		"artist",
+		"A",
+		"",
+		"🆔 an ID of the artist to check all tracks released by the artist",
+	)
+	cmd.Flags().StringVarP(
+		&checkTrackOps.Album,
+		"album",
+		"a",
+		"",
+		"🆔 an ID of the album to check all tracks in the album",
+	)
+	cmd.Flags().StringVarP(
+		&checkTrackOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runCheckTrack(cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runCheckTrack executes the check track command.
+func runCheckTrack(cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if checkTrackOps.Artist != "" && checkTrackOps.Album != "" {
+		o := formatter.Yellow("⚡ Both artist and album flags can not be specified at the same time...")
+		*output = o
+		return nil
+	}
+
+	ids, err := readIds(args)
+	if err != nil {
+		o := formatter.Red("❌ Failed to read the IDs from the standard input...")
+		*output = o
+		return err
+	}
+	if checkTrackOps.Artist == "" && checkTrackOps.Album == "" && len(ids) == 0 {
+		o := formatter.Yellow("⚡ No ID arguments specified...")
+		*output = o
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	progress := spotlike.NewProgressReporter(checkTrackOps.Format)
+	// the tracks not found are nil, so that the results are in the order of the IDs
+	var gtucoDtos []*spotlikeApp.GetTrackUseCaseOutputDto
+	trackRepo := spotlike.NewTrackRepository()
+	if checkTrackOps.Artist != "" {
+		gAuc := spotlikeApp.NewGetArtistUseCase(spotlike.NewArtistRepository())
+		gAucoDto, err := gAuc.Run(cmd.Context(), checkTrackOps.Artist)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return err
+		}
+		if gAucoDto == nil {
+			o := formatter.Yellow("⚡ The id " + checkTrackOps.Artist + " is not found or it is not an artist...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
+			return nil
+		}
+
+		gatAuc := spotlikeApp.NewGetAllTracksByArtistIdUseCase(trackRepo)
+		gatAucoDtos, err := gatAuc.Run(spotlike.WithProgress(cmd.Context(), progress), checkTrackOps.Artist)
+		progress.Done()
+		if err != nil {
+			return err
+		}
+		for _, gatAucoDto := range gatAucoDtos {
+			gtucoDtos = append(gtucoDtos, &spotlikeApp.GetTrackUseCaseOutputDto{ID: gatAucoDto.ID, Name: gatAucoDto.Name})
+		}
+	} else if checkTrackOps.Album != "" {
+		gauc := spotlikeApp.NewGetAlbumUseCase(spotlike.NewAlbumRepository())
+		gaucoDto, err := gauc.Run(cmd.Context(), checkTrackOps.Album)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return err
+		}
+		if gaucoDto == nil {
+			o := formatter.Yellow("⚡ The id " + checkTrackOps.Album + " is not found or it is not an album...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
+			return nil
+		}
+
+		gatauc := spotlikeApp.NewGetAllTracksByAlbumIdUseCase(trackRepo)
+		gataucoDtos, err := gatauc.Run(cmd.Context(), checkTrackOps.Album)
+		if err != nil {
+			return err
+		}
+		for _, gataucoDto := range gataucoDtos {
+			gtucoDtos = append(gtucoDtos, &spotlikeApp.GetTrackUseCaseOutputDto{ID: gataucoDto.ID, Name: gataucoDto.Name})
+		}
+	} else {
+		gtuc := spotlikeApp.NewGetTrackUseCase(trackRepo)
+		for _, id := range ids {
+			gtucoDto, err := gtuc.Run(cmd.Context(), id)
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				return err
+			}
+			gtucoDtos = append(gtucoDtos, gtucoDto)
+		}
+	}
+
+	var checkIds []string
+	for _, gtucoDto := range gtucoDtos {
+		if gtucoDto != nil {
+			checkIds = append(checkIds, gtucoDto.ID)
+		}
+	}
+	clTuc := spotlikeApp.NewCheckLikeTracksUseCase(trackRepo)
+	liked, err := clTuc.Run(spotlike.WithProgress(cmd.Context(), progress), checkIds)
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	var states []*spotlikeApp.LikeStateDto
+	checked := 0
+	for i, gtucoDto := range gtucoDtos {
+		if gtucoDto == nil {
+			states = append(states, spotlikeApp.NewLikeStateDto("track", ids[i], "", spotlikeApp.LikeStateNotFound))
+			continue
+		}
+		states = append(states, spotlikeApp.NewLikeStateDto("track", gtucoDto.ID, gtucoDto.Name, likeState("track", liked[checked])))
+		checked++
+	}
+
+	if len(states) == 0 {
+		o := formatter.Yellow("⚡ No tracks found to check...")
+		*output = o
+		spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+		return nil
+	}
+
+	return formatStates(checkTrackOps.Format, output, states)
+}
+
+const (
+	// checkTrackHelpTemplate is a template for the help message of the check track command.
+	checkTrackHelpTemplate = `🔎🎵 Check whether tracks on Spotify are liked by ID.
+
+You can check whether tracks on Spotify are liked by ID without liking or unliking them.
+If you specify "-" as the argument, the IDs separated by whitespaces are read from the standard input.
+
+Also, you can check all tracks released by the artist with specifying the ID of the artist with artist flag.
+If you specify artist flag, the arguments would be ignored.
+Also, you can check all tracks in the album with specifying the ID of the album with album flag.
+If you specify album flag, the arguments would be ignored.
+Both artist and album flags can not be specified at the same time.
+
+If some of the tracks are not liked, spotlike exits with the code 9.
+
+` + checkTrackUsageTemplate
+	// checkTrackUsageTemplate is a template for the usage message of the check track command.
+	checkTrackUsageTemplate = `Usage:
+  spotlike check track [flags] [arguments]
+  spotlike check tr    [flags] [arguments]
+  spotlike check t     [flags] [arguments]
+
+Flags:
+  -A, --artist  🆔 an ID of the artist to check all tracks released by the artist
+  -a, --album   🆔 an ID of the album to check all tracks in the album
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for track
+
+Global Flags:
+  -q, --quiet   🤫 do not show the progress of long running operations
+
+Arguments:
+  ID  🆔 ID of the tracks, or "-" to read them from the standard input (e.g: "20q73dOrP7ceLGAJQVtuTq 5A7nqzXUt5IZIOA7oNBv6M")
+`
+)
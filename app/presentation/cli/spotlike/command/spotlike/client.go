@@ -0,0 +1,81 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+)
+
+const (
+	// replayClientID is the client ID used in replaying, which needs no credentials.
+	replayClientID = "replay_client_id"
+	// replayClientSecret is the client secret used in replaying, which needs no credentials.
+	replayClientSecret = "replay_client_secret"
+	// replayRefreshToken is the refresh token used in replaying, which needs no credentials.
+	replayRefreshToken = "replay_refresh_token"
+)
+
+// SetUpClient sets up the client with the cache and the cassette specified by the global options.
+func SetUpClient(ctx context.Context, conf *config.SpotlikeCliConfig) error {
+	if GlobalOps.Record != "" && GlobalOps.Replay != "" {
+		return errors.New("both record and replay flags can not be specified at the same time")
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil && GlobalOps.Record == "" && GlobalOps.Replay == "" {
+		// nothing is recorded nor replayed, and the client would be set up by the auth command
+		return nil
+	} else if clientManager == nil {
+		return errors.New("client manager is not initialized")
+	}
+
+	if GlobalOps.Replay != "" {
+		// replaying sends no requests, so the credentials are replaced not to be required
+		if err := clientManager.CloseClient(); err != nil {
+			return err
+		}
+		clientConfig := NewClientConfig(conf, replayRefreshToken)
+		clientConfig.SpotifyID = replayClientID
+		clientConfig.SpotifySecret = replayClientSecret
+		return clientManager.InitializeClient(ctx, clientConfig)
+	}
+
+	client, err := clientManager.GetClient()
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		// the auth command initializes the client with the global options
+		return nil
+	} else if err != nil {
+		return err
+	}
+	client.UpdateConfig(NewClientConfig(conf, conf.SpotifyRefreshToken))
+
+	return nil
+}
+
+// NewClientConfig returns a new client config built from the config and the global options.
+func NewClientConfig(conf *config.SpotlikeCliConfig, refreshToken string) *api.ClientConfig {
+	return &api.ClientConfig{
+		SpotifyID:              conf.SpotifyID,
+		SpotifySecret:          conf.SpotifySecret,
+		SpotifyRedirectUri:     conf.SpotifyRedirectUri,
+		SpotifyRefreshToken:    refreshToken,
+		SpotifyApiBaseUrl:      conf.SpotifyApiBaseUrl,
+		SpotifyAccountsBaseUrl: conf.SpotifyAccountsBaseUrl,
+		CacheDir:               cacheDir(),
+		CacheTTL:               cacheTTL(),
+		RecordDir:              GlobalOps.Record,
+		ReplayDir:              GlobalOps.Replay,
+	}
+}
+
+// cacheDir returns the directory of the cache, which is empty if the cache is disabled.
+// The cache is disabled in recording and replaying not to hide the requests from the cassette.
+func cacheDir() string {
+	if GlobalOps.NoCache || GlobalOps.Record != "" || GlobalOps.Replay != "" {
+		return ""
+	}
+
+	return GlobalOps.CacheDir
+}
@@ -0,0 +1,247 @@
+package spotlike
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	baseConfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+func TestSetUpClient(t *testing.T) {
+	origGlobalOps := GlobalOps
+	conf := &config.SpotlikeCliConfig{
+		SpotlikeConfig: baseConfig.SpotlikeConfig{
+			SpotifyID:           "test_client_id",
+			SpotifySecret:       "test_client_secret",
+			SpotifyRedirectUri:  "test_redirect_uri",
+			SpotifyRefreshToken: "test_refresh_token",
+		},
+	}
+	newClientManager := func(initialize bool) {
+		cm := api.NewClientManager(proxy.NewSpotify(), proxy.NewHttp(), proxy.NewRandstr(), proxy.NewUrl())
+		if !initialize {
+			return
+		}
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name            string
+		noCache         bool
+		record          string
+		replay          string
+		wantInitialized bool
+		wantErr         bool
+		setup           func()
+	}{
+		{
+			name:            "positive testing (neither record nor replay is specified)",
+			record:          "",
+			replay:          "",
+			wantInitialized: true,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(true)
+			},
+		},
+		{
+			name:            "positive testing (no cache is specified)",
+			noCache:         true,
+			record:          "",
+			replay:          "",
+			wantInitialized: true,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(true)
+			},
+		},
+		{
+			name:            "positive testing (record is specified, client is initialized)",
+			record:          "test_record_dir",
+			replay:          "",
+			wantInitialized: true,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(true)
+			},
+		},
+		{
+			name:            "positive testing (record is specified, client is not initialized)",
+			record:          "test_record_dir",
+			replay:          "",
+			wantInitialized: false,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(false)
+			},
+		},
+		{
+			name:            "positive testing (replay is specified, client is initialized)",
+			record:          "",
+			replay:          "test_replay_dir",
+			wantInitialized: true,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(true)
+			},
+		},
+		{
+			name:            "positive testing (replay is specified, client is not initialized)",
+			record:          "",
+			replay:          "test_replay_dir",
+			wantInitialized: true,
+			wantErr:         false,
+			setup: func() {
+				newClientManager(false)
+			},
+		},
+		{
+			name:            "negative testing (both record and replay are specified)",
+			record:          "test_record_dir",
+			replay:          "test_replay_dir",
+			wantInitialized: true,
+			wantErr:         true,
+			setup: func() {
+				newClientManager(true)
+			},
+		},
+		{
+			name:            "positive testing (neither record nor replay is specified, client manager is not initialized)",
+			record:          "",
+			replay:          "",
+			wantInitialized: false,
+			wantErr:         false,
+			setup:           nil,
+		},
+		{
+			name:            "negative testing (client manager is not initialized)",
+			record:          "test_record_dir",
+			replay:          "",
+			wantInitialized: false,
+			wantErr:         true,
+			setup:           nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			GlobalOps.NoCache = tt.noCache
+			GlobalOps.Record = tt.record
+			GlobalOps.Replay = tt.replay
+			defer func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				GlobalOps = origGlobalOps
+			}()
+			if err := SetUpClient(context.Background(), conf); (err != nil) != tt.wantErr {
+				t.Errorf("SetUpClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			initialized := false
+			if cm := api.GetClientManager(); cm != nil {
+				initialized = cm.IsClientInitialized()
+			}
+			if initialized != tt.wantInitialized {
+				t.Errorf("SetUpClient() initialized = %v, want %v", initialized, tt.wantInitialized)
+			}
+		})
+	}
+}
+
+func TestNewClientConfig(t *testing.T) {
+	origGlobalOps := GlobalOps
+	conf := &config.SpotlikeCliConfig{
+		SpotlikeConfig: baseConfig.SpotlikeConfig{
+			SpotifyID:              "test_client_id",
+			SpotifySecret:          "test_client_secret",
+			SpotifyRedirectUri:     "test_redirect_uri",
+			SpotifyRefreshToken:    "test_refresh_token",
+			SpotifyApiBaseUrl:      "test_api_base_url",
+			SpotifyAccountsBaseUrl: "test_accounts_base_url",
+			CacheDir:               "test_cache_dir",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		noCache bool
+		record  string
+		want    *api.ClientConfig
+	}{
+		{
+			name:    "positive testing (cache is enabled)",
+			noCache: false,
+			record:  "",
+			want: &api.ClientConfig{
+				SpotifyID:              "test_client_id",
+				SpotifySecret:          "test_client_secret",
+				SpotifyRedirectUri:     "test_redirect_uri",
+				SpotifyRefreshToken:    "test_new_refresh_token",
+				SpotifyApiBaseUrl:      "test_api_base_url",
+				SpotifyAccountsBaseUrl: "test_accounts_base_url",
+				CacheTTL:               24 * time.Hour,
+				CacheDir:               "test_cache_dir",
+			},
+		},
+		{
+			name:    "positive testing (no cache is specified)",
+			noCache: true,
+			record:  "",
+			want: &api.ClientConfig{
+				SpotifyID:              "test_client_id",
+				SpotifySecret:          "test_client_secret",
+				SpotifyRedirectUri:     "test_redirect_uri",
+				SpotifyRefreshToken:    "test_new_refresh_token",
+				SpotifyApiBaseUrl:      "test_api_base_url",
+				SpotifyAccountsBaseUrl: "test_accounts_base_url",
+				CacheTTL:               24 * time.Hour,
+			},
+		},
+		{
+			name:    "positive testing (record is specified)",
+			noCache: false,
+			record:  "test_record_dir",
+			want: &api.ClientConfig{
+				SpotifyID:              "test_client_id",
+				SpotifySecret:          "test_client_secret",
+				SpotifyRedirectUri:     "test_redirect_uri",
+				SpotifyRefreshToken:    "test_new_refresh_token",
+				SpotifyApiBaseUrl:      "test_api_base_url",
+				SpotifyAccountsBaseUrl: "test_accounts_base_url",
+				CacheTTL:               24 * time.Hour,
+				RecordDir:              "test_record_dir",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			GlobalOps.CacheDir = conf.CacheDir
+			GlobalOps.NoCache = tt.noCache
+			GlobalOps.Record = tt.record
+			defer func() {
+				GlobalOps = origGlobalOps
+			}()
+			if got := NewClientConfig(conf, "test_new_refresh_token"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewClientConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+)
+
+// checkLike checks if the content of the specified type is liked.
+func checkLike(ctx context.Context, contentType string, id string) (bool, error) {
+	switch contentType {
+	case "track":
+		return spotlikeApp.NewCheckLikeTrackUseCase(repository.NewTrackRepository()).Run(ctx, id)
+	case "album":
+		return spotlikeApp.NewCheckLikeAlbumUseCase(repository.NewAlbumRepository()).Run(ctx, id)
+	case "artist":
+		return spotlikeApp.NewCheckLikeArtistUseCase(repository.NewArtistRepository()).Run(ctx, id)
+	default:
+		return false, errors.New("unknown content type : " + contentType)
+	}
+}
+
+// CheckLikes checks if each of the contents of the specified type is liked at once in the order of the IDs.
+func CheckLikes(ctx context.Context, contentType string, ids []string) ([]bool, error) {
+	switch contentType {
+	case "track":
+		return spotlikeApp.NewCheckLikeTracksUseCase(repository.NewTrackRepository()).Run(ctx, ids)
+	case "album":
+		return spotlikeApp.NewCheckLikeAlbumsUseCase(repository.NewAlbumRepository()).Run(ctx, ids)
+	case "artist":
+		return spotlikeApp.NewCheckLikeArtistsUseCase(repository.NewArtistRepository()).Run(ctx, ids)
+	default:
+		return nil, errors.New("unknown content type : " + contentType)
+	}
+}
+
+// ApplyAction likes or unlikes the content of the specified type.
+func ApplyAction(ctx context.Context, action string, contentType string, id string) error {
+	switch contentType + " " + action {
+	case "track like":
+		return spotlikeApp.NewLikeTrackUseCase(repository.NewTrackRepository()).Run(ctx, id)
+	case "track unlike":
+		return spotlikeApp.NewUnlikeTrackUseCase(repository.NewTrackRepository()).Run(ctx, id)
+	case "album like":
+		return spotlikeApp.NewLikeAlbumUseCase(repository.NewAlbumRepository()).Run(ctx, id)
+	case "album unlike":
+		return spotlikeApp.NewUnlikeAlbumUseCase(repository.NewAlbumRepository()).Run(ctx, id)
+	case "artist like":
+		return spotlikeApp.NewLikeArtistUseCase(repository.NewArtistRepository()).Run(ctx, id)
+	case "artist unlike":
+		return spotlikeApp.NewUnlikeArtistUseCase(repository.NewArtistRepository()).Run(ctx, id)
+	default:
+		return errors.New("unknown content type : " + contentType)
+	}
+}
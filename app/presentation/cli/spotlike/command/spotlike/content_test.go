@@ -0,0 +1,225 @@
+package spotlike
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+
+	"go.uber.org/mock/gomock"
+)
+
+func Test_checkLike(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		contentType string
+		id          string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "negative testing (unknown content type)",
+			args: args{
+				ctx:         context.Background(),
+				contentType: "playlist",
+				id:          "test_playlist_id",
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkLike(tt.args.ctx, tt.args.contentType, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkLike() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("checkLike() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLikes(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		contentType string
+		ids         []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []bool
+		wantErr bool
+	}{
+		{
+			name: "negative testing (unknown content type)",
+			args: args{
+				ctx:         context.Background(),
+				contentType: "playlist",
+				ids:         []string{"test_playlist_id"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckLikes(tt.args.ctx, tt.args.contentType, tt.args.ids)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckLikes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckLikes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyAction(t *testing.T) {
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	forbidden := spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden}
+	rateLimited := spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests}
+
+	type args struct {
+		ctx         context.Context
+		action      string
+		contentType string
+		id          string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockSpotifyClient *proxy.MockClient)
+	}{
+		{
+			name: "negative testing (unknown content type)",
+			args: args{
+				ctx:         context.Background(),
+				action:      "like",
+				contentType: "playlist",
+				id:          "test_playlist_id",
+			},
+			wantErr:      true,
+			wantExitCode: ExitCodeError,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (liking the track is forbidden)",
+			args: args{
+				ctx:         context.Background(),
+				action:      "like",
+				contentType: "track",
+				id:          "test_track_id",
+			},
+			wantErr:      true,
+			wantExitCode: ExitCodeForbidden,
+			setup: func(mockSpotifyClient *proxy.MockClient) {
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(forbidden)
+			},
+		},
+		{
+			name: "negative testing (liking the album is rate limited)",
+			args: args{
+				ctx:         context.Background(),
+				action:      "like",
+				contentType: "album",
+				id:          "test_album_id",
+			},
+			wantErr:      true,
+			wantExitCode: ExitCodeRateLimited,
+			setup: func(mockSpotifyClient *proxy.MockClient) {
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id")).Return(rateLimited)
+			},
+		},
+		{
+			name: "negative testing (unliking the artist is forbidden)",
+			args: args{
+				ctx:         context.Background(),
+				action:      "unlike",
+				contentType: "artist",
+				id:          "test_artist_id",
+			},
+			wantErr:      true,
+			wantExitCode: ExitCodeForbidden,
+			setup: func(mockSpotifyClient *proxy.MockClient) {
+				mockSpotifyClient.EXPECT().UnfollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(forbidden)
+			},
+		},
+		{
+			name: "negative testing (unliking the track is rate limited)",
+			args: args{
+				ctx:         context.Background(),
+				action:      "unlike",
+				contentType: "track",
+				id:          "test_track_id",
+			},
+			wantErr:      true,
+			wantExitCode: ExitCodeRateLimited,
+			setup: func(mockSpotifyClient *proxy.MockClient) {
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(rateLimited)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				tt.setup(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				defer func() {
+					if err := api.ResetClientManager(); err != nil {
+						t.Errorf("Failed to reset client manager: %v", err)
+					}
+				}()
+			}
+			err := ApplyAction(tt.args.ctx, tt.args.action, tt.args.contentType, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyAction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := ExitCode(err); got != tt.wantExitCode {
+				t.Errorf("ExitCode(ApplyAction()) = %v, want %v", got, tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package spotlike
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// DiffOptions provides the options for the diff command.
+type DiffOptions struct {
+	Live   bool
+	Save   string
+	Format string
+}
+
+var (
+	// diffOps is a variable to store the diff options with the default values for injecting the dependencies in testing.
+	diffOps = DiffOptions{
+		Live:   false,
+		Save:   "",
+		Format: "table",
+	}
+	// diffNow is a function to get the current time for injecting the dependencies in testing.
+	diffNow = time.Now
+)
+
+// NewDiffCommand returns a new instance of the diff command.
+func NewDiffCommand(
+	exit func(int),
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("diff")
+	cmd.SetAliases([]string{"df", "D"})
+	cmd.SetUsageTemplate(diffUsageTemplate)
+	cmd.SetHelpTemplate(diffHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().BoolVarP(
+		&diffOps.Live,
+		"live",
+		"l",
+		false,
+		"📡 compare with your current library instead of the second snapshot",
+	)
+	cmd.Flags().StringVarP(
+		&diffOps.Save,
+		"save",
+		"s",
+		"",
+		"💾 save the snapshot of your current library to the path (e.g: \"library.json\")",
+	)
+	cmd.Flags().StringVarP(
+		&diffOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runDiff(exit, cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runDiff runs the diff command.
+func runDiff(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if diffOps.Save != "" && !diffOps.Live {
+		o := formatter.Yellow("⚡ The save option is only available with the live option...")
+		*output = o
+		return nil
+	}
+	if len(args) > 2 {
+		o := formatter.Yellow("⚡ Too many snapshots specified...")
+		*output = o
+		return nil
+	}
+	if len(args) == 2 && diffOps.Live {
+		o := formatter.Yellow("⚡ Specify either the second snapshot or the live option...")
+		*output = o
+		return nil
+	}
+	if len(args) == 0 && !diffOps.Live {
+		o := formatter.Yellow("⚡ No snapshots specified...")
+		*output = o
+		return nil
+	}
+	if len(args) == 1 && !diffOps.Live {
+		o := formatter.Yellow("⚡ Specify the second snapshot or the live option...")
+		*output = o
+		return nil
+	}
+
+	var from *spotlikeApp.GetLibraryUseCaseOutputDto
+	if len(args) > 0 {
+		library, err := LoadLibrarySnapshot(args[0])
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the snapshot " + args[0] + "...")
+			*output = o
+			return err
+		}
+		from = library
+	}
+
+	w := MessageWriter(diffOps.Format)
+	var to *spotlikeApp.GetLibraryUseCaseOutputDto
+	toName := "your current library"
+	if diffOps.Live {
+		clientManager := api.GetClientManager()
+		if clientManager == nil {
+			o := formatter.Red("❌ Client manager is not initialized...")
+			*output = o
+			return nil
+		}
+		if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+			if err := authCmd.RunE(cmd, args); err != nil {
+				return err
+			}
+		} else if err != nil {
+			o := formatter.Red("❌ Failed to get client...")
+			*output = o
+			return err
+		}
+
+		progress := NewProgressReporter(diffOps.Format)
+		gluc := spotlikeApp.NewGetLibraryUseCase(NewArtistRepository(), NewAlbumRepository(), NewTrackRepository())
+		library, err := gluc.Run(WithProgress(cmd.Context(), progress))
+		progress.Done()
+		if err != nil {
+			return err
+		}
+		library.ExportedAt = diffNow()
+		to = library
+
+		if diffOps.Save != "" {
+			if err := SaveLibrarySnapshot(diffOps.Save, library); err != nil {
+				o := formatter.Red("❌ Failed to save the snapshot " + diffOps.Save + "...")
+				*output = o
+				return err
+			}
+			if err := presenter.Print(w, formatter.Green("✅💾 Successfully saved the snapshot of your library to "+diffOps.Save+"!")); err != nil {
+				return err
+			}
+		}
+		// only the snapshot is saved if there is no snapshot to compare with
+		if from == nil {
+			return nil
+		}
+	} else {
+		library, err := LoadLibrarySnapshot(args[1])
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the snapshot " + args[1] + "...")
+			*output = o
+			return err
+		}
+		to = library
+		toName = args[1]
+	}
+
+	dlucoDtos := spotlikeApp.NewDiffLibrariesUseCase().Run(from, to)
+	if len(dlucoDtos) == 0 {
+		o := formatter.Yellow("⚡ No changes found between " + args[0] + " and " + toName + "...")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(diffOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(dlucoDtos)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+
+	added := 0
+	for _, dlucoDto := range dlucoDtos {
+		if dlucoDto.Change == spotlikeApp.LibraryChangeAdded {
+			added++
+		}
+	}
+	if err := presenter.Print(w, "🔀 "+fmt.Sprint(added)+" added, "+fmt.Sprint(len(dlucoDtos)-added)+" removed since "+args[0]+" ("+from.ExportedAt.Format(time.DateTime)+")."); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const (
+	// diffHelpTemplate is the help template of the diff command.
+	diffHelpTemplate = `🔀 Compare the snapshots of your library.
+
+You can save the snapshot of your current library in JSON by specifying the "--live" and the "--save" options,
+and review the artists followed and the albums and the tracks liked or unliked since then.
+
+  spotlike diff --live --save library.json    # save the snapshot of your current library
+  spotlike diff library.json --live           # compare the snapshot with your current library
+  spotlike diff last-week.json library.json   # compare the two snapshots
+
+The added and the removed contents are shown with their names, release dates and dates when they were liked.
+
+` + diffUsageTemplate
+	// diffUsageTemplate is the usage template of the diff command.
+	diffUsageTemplate = `Usage:
+  spotlike diff [flags] [arguments]
+  spotlike df   [flags] [arguments]
+  spotlike D    [flags] [arguments]
+
+Flags:
+  -l, --live    📡 compare with your current library instead of the second snapshot
+  -s, --save    💾 save the snapshot of your current library to the path (e.g: "library.json")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for diff
+
+Global Flags:
+  -q, --quiet   🤫 do not show the progress of long running operations
+
+Arguments:
+  FROM  📸 path of the snapshot to compare from (e.g: "last-week.json")
+  TO    📸 path of the snapshot to compare to (e.g: "library.json")
+`
+)
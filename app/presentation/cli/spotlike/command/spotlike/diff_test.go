@@ -0,0 +1,450 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewDiffCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		exit    func(int)
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				exit:  exit,
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewDiffCommand(tt.args.exit, tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewDiffCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the diff command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runDiff(t *testing.T) {
+	output := ""
+	exit := func(code int) {
+		SetExitCode(code)
+	}
+	origDiffOps := diffOps
+	origDiffNow := diffNow
+	origGlobalOps := GlobalOps
+	su := utility.NewStringsUtil()
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	expectLibrary := func(mockSpotifyClient *proxy.MockClient) {
+		mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(
+			&spotify.FullArtistCursorPage{
+				Artists: []spotify.FullArtist{
+					{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+				},
+			},
+			nil,
+		)
+		mockSpotifyClient.EXPECT().CurrentUsersAlbums(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&spotify.SavedAlbumPage{},
+			nil,
+		)
+		mockSpotifyClient.EXPECT().CurrentUsersTracks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&spotify.SavedTrackPage{
+				Tracks: []spotify.SavedTrack{
+					{
+						AddedAt: "2024-01-02T00:00:00Z",
+						FullTrack: spotify.FullTrack{
+							SimpleTrack: spotify.SimpleTrack{
+								ID:   "test_track_id_2",
+								Name: "test_track_name_2",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+							},
+							Album: spotify.SimpleAlbum{
+								ReleaseDate:          "2001-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+				},
+			},
+			nil,
+		)
+	}
+	writeSnapshots := func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		snapshots := map[string]string{
+			"a.json": `{
+  "exported_at": "2024-01-01T00:00:00Z",
+  "artists": [{"id": "test_artist_id", "name": "test_artist_name", "release_date": "0001-01-01T00:00:00Z", "liked_at": "0001-01-01T00:00:00Z"}],
+  "albums": [],
+  "tracks": [{"id": "test_track_id_1", "name": "test_track_name_1", "artists": "test_artist_name", "release_date": "2000-01-01T00:00:00Z", "liked_at": "2020-01-01T00:00:00Z"}]
+}`,
+			"b.json": `{
+  "exported_at": "2024-01-08T00:00:00Z",
+  "artists": [{"id": "test_artist_id", "name": "test_artist_name", "release_date": "0001-01-01T00:00:00Z", "liked_at": "0001-01-01T00:00:00Z"}],
+  "albums": [],
+  "tracks": [{"id": "test_track_id_2", "name": "test_track_name_2", "artists": "test_artist_name", "release_date": "2001-01-01T00:00:00Z", "liked_at": "2024-01-02T00:00:00Z"}]
+}`,
+			"broken.json": "test_track_id_1 test_track_name_1",
+		}
+		for name, snapshot := range snapshots {
+			if err := os.WriteFile(name, []byte(snapshot), 0600); err != nil {
+				t.Errorf("Failed to write the snapshot: %v", err)
+			}
+		}
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		diffOps = origDiffOps
+		diffNow = origDiffNow
+		GlobalOps = origGlobalOps
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantSaved    bool
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (no snapshots specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Nosnapshotsspecified..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (second snapshot is not specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json"},
+			},
+			wantOutput:   formatter.Yellow("⚡Specifythesecondsnapshotortheliveoption..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (both second snapshot and live option are specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "b.json"},
+			},
+			wantOutput:   formatter.Yellow("⚡Specifyeitherthesecondsnapshotortheliveoption..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				diffOps.Live = true
+			},
+		},
+		{
+			name: "positive testing (too many snapshots specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "b.json", "a.json"},
+			},
+			wantOutput:   formatter.Yellow("⚡Toomanysnapshotsspecified..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (save option is specified without live option)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "b.json"},
+			},
+			wantOutput:   formatter.Yellow("⚡Thesaveoptionisonlyavailablewiththeliveoption..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				diffOps.Save = "c.json"
+			},
+		},
+		{
+			name: "positive testing (compare two snapshots)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "b.json"},
+			},
+			wantOutput:   "-[test_track_id_1]track:test_track_name_1releasedat2000-01-01bytest_artist_name(likedat2020-01-01)+[test_track_id_2]track:test_track_name_2releasedat2001-01-01bytest_artist_name(likedat2024-01-02)",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				diffOps.Format = "plain"
+			},
+		},
+		{
+			name: "positive testing (no changes between two snapshots)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "a.json"},
+			},
+			wantOutput:   formatter.Yellow("⚡Nochangesfoundbetweena.jsonanda.json..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (compare the snapshot with the live library and save it)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json"},
+			},
+			wantOutput:   "-[test_track_id_1]track:test_track_name_1releasedat2000-01-01bytest_artist_name(likedat2020-01-01)+[test_track_id_2]track:test_track_name_2releasedat2001-01-01bytest_artist_name(likedat2024-01-02)",
+			wantSaved:    true,
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				diffOps.Live = true
+				diffOps.Save = "c.json"
+				diffOps.Format = "plain"
+				diffNow = func() time.Time {
+					return time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+				}
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (only save the live library)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantSaved:    true,
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				diffOps.Live = true
+				diffOps.Save = "c.json"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (snapshot does not exist)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"not_exist.json", "b.json"},
+			},
+			wantOutput:   formatter.Red("❌Failedtoloadthesnapshotnot_exist.json..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (second snapshot is not in the json format)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json", "broken.json"},
+			},
+			wantOutput:   formatter.Red("❌Failedtoloadthesnapshotbroken.json..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (client manager is not initialized)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json"},
+			},
+			wantOutput:   formatter.Red("❌Clientmanagerisnotinitialized..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				diffOps.Live = true
+			},
+		},
+		{
+			name: "negative testing (failed to get the live library)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json"},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				diffOps.Live = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get the followed artists"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to save the snapshot)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{"a.json"},
+			},
+			wantOutput:   formatter.Red("❌Failedtosavethesnapshota.json/c.json..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				diffOps.Live = true
+				diffOps.Save = "a.json/c.json"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			SetExitCode(ExitCodeOk)
+			writeSnapshots(t)
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runDiff(exit, tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runDiff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runDiff() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runDiff() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+			if _, err := os.Stat("c.json"); (err == nil) != tt.wantSaved {
+				t.Errorf("runDiff() saved the snapshot = %v, want %v", err == nil, tt.wantSaved)
+			}
+		})
+	}
+}
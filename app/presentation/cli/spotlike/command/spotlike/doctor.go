@@ -0,0 +1,348 @@
+package spotlike
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2/auth"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// DoctorOptions provides the options for the doctor command.
+type DoctorOptions struct {
+	Format string
+}
+
+var (
+	// doctorOps is a variable to store the doctor options with the default values for injecting the dependencies in testing.
+	doctorOps = DoctorOptions{
+		Format: "table",
+	}
+	// commandScopes is the scopes required by each command.
+	commandScopes = []struct {
+		commands string
+		scopes   []string
+	}{
+		{
+			commands: "like, unlike track and album",
+			scopes:   []string{spotifyauth.ScopeUserLibraryRead, spotifyauth.ScopeUserLibraryModify},
+		},
+		{
+			commands: "like, unlike artist",
+			scopes:   []string{spotifyauth.ScopeUserFollowRead, spotifyauth.ScopeUserFollowModify},
+		},
+		{
+			commands: "releases",
+			scopes:   []string{spotifyauth.ScopeUserFollowRead, spotifyauth.ScopeUserLibraryRead, spotifyauth.ScopeUserLibraryModify},
+		},
+		{
+			commands: "diff, stats, check",
+			scopes:   []string{spotifyauth.ScopeUserFollowRead, spotifyauth.ScopeUserLibraryRead},
+		},
+		{
+			commands: "watch, apply, undo",
+			scopes:   api.Scopes,
+		},
+	}
+)
+
+const (
+	// doctorTimeout is the timeout of the requests to check the token.
+	doctorTimeout = 30 * time.Second
+	// clockSkewThreshold is the clock skew from Spotify to be warned.
+	clockSkewThreshold = time.Minute
+)
+
+// NewDoctorCommand returns a new instance of the doctor command.
+func NewDoctorCommand(
+	cobra proxy.Cobra,
+	version string,
+	conf *config.SpotlikeCliConfig,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("doctor")
+	cmd.SetAliases([]string{"dr", "d"})
+	cmd.SetUsageTemplate(doctorUsageTemplate)
+	cmd.SetHelpTemplate(doctorHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&doctorOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runDoctor(cmd, version, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+// runDoctor runs the doctor command.
+func runDoctor(cmd *c.Command, version string, conf *config.SpotlikeCliConfig, output *string) error {
+	diagnoses := []*spotlikeApp.DiagnosisDto{diagnoseVersion(version)}
+	diagnoses = append(diagnoses, diagnoseConfig(conf)...)
+	redirectUri, port := diagnoseRedirectUri(conf.SpotifyRedirectUri)
+	diagnoses = append(diagnoses, redirectUri, diagnosePort(port))
+	ctx, cancel := context.WithTimeout(cmd.Context(), doctorTimeout)
+	defer cancel()
+	token, status := diagnoseToken(ctx, conf)
+	diagnoses = append(diagnoses, token)
+	diagnoses = append(diagnoses, diagnoseScopes(status)...)
+	diagnoses = append(diagnoses, diagnoseClock(status, time.Now()))
+
+	for _, diagnosis := range diagnoses {
+		if diagnosis.Status == spotlikeApp.DiagnosisStatusFail {
+			SetExitCode(ExitCodeError)
+			break
+		}
+	}
+
+	f, err := formatter.NewFormatter(doctorOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(diagnoses)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+
+	return nil
+}
+
+// diagnoseVersion returns the diagnosis of the version of spotlike.
+func diagnoseVersion(version string) *spotlikeApp.DiagnosisDto {
+	detail := version + " (" + runtime.Version() + " " + runtime.GOOS + "/" + runtime.GOARCH + ")"
+	if version == "" || version == "unknown" || version == "dev" {
+		return spotlikeApp.NewDiagnosisDto("version", spotlikeApp.DiagnosisStatusWarn, detail+" is not a released version")
+	}
+
+	return spotlikeApp.NewDiagnosisDto("version", spotlikeApp.DiagnosisStatusPass, detail)
+}
+
+// diagnoseConfig returns the diagnoses of the configurations and where they come from.
+// spotlike reads the configurations only from the environment variables, and no configuration file is read.
+func diagnoseConfig(conf *config.SpotlikeCliConfig) []*spotlikeApp.DiagnosisDto {
+	var diagnoses []*spotlikeApp.DiagnosisDto
+	for _, required := range []struct {
+		name  string
+		value string
+	}{
+		{name: "SPOTIFY_ID", value: conf.SpotifyID},
+		{name: "SPOTIFY_SECRET", value: conf.SpotifySecret},
+		{name: "SPOTIFY_REDIRECT_URI", value: conf.SpotifyRedirectUri},
+		{name: "SPOTIFY_REFRESH_TOKEN", value: conf.SpotifyRefreshToken},
+	} {
+		if required.value == "" {
+			diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+				required.name,
+				spotlikeApp.DiagnosisStatusFail,
+				"not set in the environment variable (run \"spotlike auth\" and export it)",
+			))
+			continue
+		}
+		diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+			required.name,
+			spotlikeApp.DiagnosisStatusPass,
+			"set in the environment variable",
+		))
+	}
+
+	for _, override := range []struct {
+		name  string
+		value string
+	}{
+		{name: "SPOTIFY_API_BASE_URL", value: conf.SpotifyApiBaseUrl},
+		{name: "SPOTIFY_ACCOUNTS_BASE_URL", value: conf.SpotifyAccountsBaseUrl},
+	} {
+		if override.value != "" {
+			diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+				override.name,
+				spotlikeApp.DiagnosisStatusWarn,
+				"set in the environment variable to send the requests to "+override.value+" instead of Spotify",
+			))
+		}
+	}
+
+	switch {
+	case conf.DataDir == "":
+		diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+			"data directory",
+			spotlikeApp.DiagnosisStatusWarn,
+			"neither XDG_DATA_HOME nor HOME is set, so the history can not be saved",
+		))
+	case os.Getenv("XDG_DATA_HOME") != "":
+		diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+			"data directory",
+			spotlikeApp.DiagnosisStatusPass,
+			conf.DataDir+" (from XDG_DATA_HOME)",
+		))
+	default:
+		diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(
+			"data directory",
+			spotlikeApp.DiagnosisStatusPass,
+			conf.DataDir+" (from HOME)",
+		))
+	}
+
+	return diagnoses
+}
+
+// diagnoseRedirectUri returns the diagnosis of the redirect URI and the port to receive the callback of the authentication.
+// The redirect URI is checked in the same way as the auth command, which needs the port to listen on.
+func diagnoseRedirectUri(redirectUri string) (*spotlikeApp.DiagnosisDto, string) {
+	if redirectUri == "" {
+		return spotlikeApp.NewDiagnosisDto("redirect uri", spotlikeApp.DiagnosisStatusFail, "SPOTIFY_REDIRECT_URI is not set"), ""
+	}
+
+	uri, err := url.Parse(redirectUri)
+	if err != nil {
+		return spotlikeApp.NewDiagnosisDto("redirect uri", spotlikeApp.DiagnosisStatusFail, "failed to parse "+redirectUri+" : "+err.Error()), ""
+	}
+	port := uri.Port()
+	if port == "" {
+		return spotlikeApp.NewDiagnosisDto("redirect uri", spotlikeApp.DiagnosisStatusFail, redirectUri+" has no port (e.g: \"http://127.0.0.1:8080/callback\")"), ""
+	}
+
+	return spotlikeApp.NewDiagnosisDto("redirect uri", spotlikeApp.DiagnosisStatusPass, redirectUri), port
+}
+
+// diagnosePort returns the diagnosis of whether the port to receive the callback of the authentication is free.
+func diagnosePort(port string) *spotlikeApp.DiagnosisDto {
+	if port == "" {
+		return spotlikeApp.NewDiagnosisDto("callback port", spotlikeApp.DiagnosisStatusWarn, "skipped because the redirect uri has no port")
+	}
+
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return spotlikeApp.NewDiagnosisDto("callback port", spotlikeApp.DiagnosisStatusWarn, "port "+port+" is in use, so \"spotlike auth\" can not receive the callback")
+	}
+	if err := listener.Close(); err != nil {
+		return spotlikeApp.NewDiagnosisDto("callback port", spotlikeApp.DiagnosisStatusWarn, "failed to release port "+port+" : "+err.Error())
+	}
+
+	return spotlikeApp.NewDiagnosisDto("callback port", spotlikeApp.DiagnosisStatusPass, "port "+port+" is free")
+}
+
+// diagnoseToken returns the diagnosis of refreshing the access token and the status of the refreshed one.
+func diagnoseToken(ctx context.Context, conf *config.SpotlikeCliConfig) (*spotlikeApp.DiagnosisDto, *api.TokenStatus) {
+	if conf.SpotifyID == "" || conf.SpotifySecret == "" || conf.SpotifyRefreshToken == "" {
+		return spotlikeApp.NewDiagnosisDto("token refresh", spotlikeApp.DiagnosisStatusWarn, "skipped because the credentials are not set"), nil
+	}
+
+	status, err := api.CheckToken(
+		ctx,
+		&api.ClientConfig{
+			SpotifyID:              conf.SpotifyID,
+			SpotifySecret:          conf.SpotifySecret,
+			SpotifyRedirectUri:     conf.SpotifyRedirectUri,
+			SpotifyRefreshToken:    conf.SpotifyRefreshToken,
+			SpotifyApiBaseUrl:      conf.SpotifyApiBaseUrl,
+			SpotifyAccountsBaseUrl: conf.SpotifyAccountsBaseUrl,
+		},
+	)
+	if err != nil {
+		return spotlikeApp.NewDiagnosisDto("token refresh", spotlikeApp.DiagnosisStatusFail, "failed to refresh the access token (run \"spotlike auth\" again) : "+err.Error()), nil
+	}
+
+	return spotlikeApp.NewDiagnosisDto("token refresh", spotlikeApp.DiagnosisStatusPass, "refreshed the access token"), status
+}
+
+// diagnoseScopes returns the diagnoses of whether the scopes required by each command are granted.
+func diagnoseScopes(status *api.TokenStatus) []*spotlikeApp.DiagnosisDto {
+	var diagnoses []*spotlikeApp.DiagnosisDto
+	for _, command := range commandScopes {
+		check := "scopes (" + command.commands + ")"
+		if status == nil {
+			diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(check, spotlikeApp.DiagnosisStatusWarn, "skipped because the access token is not refreshed"))
+			continue
+		}
+		if status.Scopes == nil {
+			diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(check, spotlikeApp.DiagnosisStatusWarn, "the granted scopes are not told by Spotify"))
+			continue
+		}
+
+		var missing []string
+		for _, scope := range command.scopes {
+			if !slices.Contains(status.Scopes, scope) {
+				missing = append(missing, scope)
+			}
+		}
+		if len(missing) > 0 {
+			diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(check, spotlikeApp.DiagnosisStatusFail, "missing "+strings.Join(missing, ", ")+" (run \"spotlike auth\" again to grant them)"))
+			continue
+		}
+		diagnoses = append(diagnoses, spotlikeApp.NewDiagnosisDto(check, spotlikeApp.DiagnosisStatusPass, strings.Join(command.scopes, ", ")))
+	}
+
+	return diagnoses
+}
+
+// diagnoseClock returns the diagnosis of the skew of the local clock from the time of Spotify.
+func diagnoseClock(status *api.TokenStatus, now time.Time) *spotlikeApp.DiagnosisDto {
+	if status == nil || status.ServerTime.IsZero() {
+		return spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusWarn, "skipped because the time of Spotify is unknown")
+	}
+
+	skew := now.Sub(status.ServerTime).Truncate(time.Second)
+	detail := "the local clock is " + skew.String() + " ahead of Spotify"
+	if skew < 0 {
+		detail = "the local clock is " + (-skew).String() + " behind Spotify"
+	}
+	if skew.Abs() > clockSkewThreshold {
+		return spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusWarn, detail+fmt.Sprintf(" (more than %s)", clockSkewThreshold))
+	}
+
+	return spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusPass, detail)
+}
+
+const (
+	// doctorHelpTemplate is the help template of the doctor command.
+	doctorHelpTemplate = `🩺 Diagnose the setup of spotlike.
+
+You can check the setup of spotlike below and find what to fix when the commands fail.
+
+  - the version of spotlike
+  - the configurations and where they come from (spotlike reads only the environment variables)
+  - whether the redirect uri has the port to receive the callback of the authentication
+  - whether the port is free
+  - whether the access token can be refreshed with the refresh token
+  - whether the scopes required by each command are granted
+  - the skew of the local clock from the time of Spotify
+
+Each check passes, warns, or fails, and the exit code is 1 if any check fails.
+
+` + doctorUsageTemplate
+	// doctorUsageTemplate is the usage template of the doctor command.
+	doctorUsageTemplate = `Usage:
+  spotlike doctor [flags]
+  spotlike dr     [flags]
+  spotlike d      [flags]
+
+Flags:
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for doctor
+`
+)
@@ -0,0 +1,393 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	baseConfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newTestAccountsServer returns a new server of the accounts service which grants the scopes without the date header.
+func newTestAccountsServer(t *testing.T, scope string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header()["Date"] = nil
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("refresh_token") != "test_refresh_token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Invalid refresh token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token": "test_access_token", "token_type": "Bearer", "expires_in": 3600, "scope": "` + scope + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewDoctorCommand(t *testing.T) {
+	output := ""
+
+	type args struct {
+		cobra   proxy.Cobra
+		version string
+		conf    *config.SpotlikeCliConfig
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:   proxy.NewCobra(),
+				version: "0.0.0",
+				conf:    &config.SpotlikeCliConfig{},
+				output:  &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewDoctorCommand(tt.args.cobra, tt.args.version, tt.args.conf, tt.args.output)
+			if got == nil {
+				t.Errorf("NewDoctorCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the doctor command : %v", err)
+				}
+				SetExitCode(ExitCodeOk)
+			}
+		})
+	}
+}
+
+func Test_runDoctor(t *testing.T) {
+	output := ""
+	origDoctorOps := doctorOps
+	origNewFormatter := formatter.NewFormatter
+	su := utility.NewStringsUtil()
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Failed to listen on a port: %v", err)
+	}
+	defer listener.Close()
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	server := newTestAccountsServer(t, "user-follow-read user-follow-modify user-library-read")
+	t.Setenv("XDG_DATA_HOME", "/test/data")
+	runtimeDetail := "(" + runtime.Version() + runtime.GOOS + "/" + runtime.GOARCH + ")"
+
+	type args struct {
+		cmd     *c.Command
+		version string
+		conf    *config.SpotlikeCliConfig
+		output  *string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantExitCode int
+		wantErr      bool
+		setup        func(mockCtrl *gomock.Controller)
+		cleanup      func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:     &c.Command{},
+				version: "1.0.0",
+				conf: &config.SpotlikeCliConfig{
+					SpotlikeConfig: baseConfig.SpotlikeConfig{
+						SpotifyID:              "test_id",
+						SpotifySecret:          "test_secret",
+						SpotifyRedirectUri:     "http://127.0.0.1:" + port + "/callback",
+						SpotifyRefreshToken:    "test_refresh_token",
+						SpotifyAccountsBaseUrl: server.URL + "/accounts",
+						DataDir:                "/test/data/spotlike",
+					},
+				},
+				output: &output,
+			},
+			wantOutput: "[pass]version:1.0.0" + runtimeDetail +
+				"[pass]SPOTIFY_ID:setintheenvironmentvariable" +
+				"[pass]SPOTIFY_SECRET:setintheenvironmentvariable" +
+				"[pass]SPOTIFY_REDIRECT_URI:setintheenvironmentvariable" +
+				"[pass]SPOTIFY_REFRESH_TOKEN:setintheenvironmentvariable" +
+				"[warn]SPOTIFY_ACCOUNTS_BASE_URL:setintheenvironmentvariabletosendtherequeststo" + server.URL + "/accountsinsteadofSpotify" +
+				"[pass]datadirectory:/test/data/spotlike(fromXDG_DATA_HOME)" +
+				"[pass]redirecturi:http://127.0.0.1:" + port + "/callback" +
+				"[warn]callbackport:port" + port + "isinuse,so\"spotlikeauth\"cannotreceivethecallback" +
+				"[pass]tokenrefresh:refreshedtheaccesstoken" +
+				"[fail]scopes(like,unliketrackandalbum):missinguser-library-modify(run\"spotlikeauth\"againtograntthem)" +
+				"[pass]scopes(like,unlikeartist):user-follow-read,user-follow-modify" +
+				"[fail]scopes(releases):missinguser-library-modify(run\"spotlikeauth\"againtograntthem)" +
+				"[pass]scopes(diff,stats,check):user-follow-read,user-library-read" +
+				"[fail]scopes(watch,apply,undo):missinguser-library-modify(run\"spotlikeauth\"againtograntthem)" +
+				"[warn]clockskew:skippedbecausethetimeofSpotifyisunknown",
+			wantExitCode: ExitCodeError,
+			wantErr:      false,
+			setup: func(_ *gomock.Controller) {
+				doctorOps.Format = "plain"
+			},
+			cleanup: func() {
+				doctorOps = origDoctorOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (credentials are not set)",
+			args: args{
+				cmd:     &c.Command{},
+				version: "dev",
+				conf: &config.SpotlikeCliConfig{
+					SpotlikeConfig: baseConfig.SpotlikeConfig{
+						SpotifyID:     "test_id",
+						SpotifySecret: "test_secret",
+						DataDir:       "/test/data/spotlike",
+					},
+				},
+				output: &output,
+			},
+			wantOutput: "[warn]version:dev" + runtimeDetail + "isnotareleasedversion" +
+				"[pass]SPOTIFY_ID:setintheenvironmentvariable" +
+				"[pass]SPOTIFY_SECRET:setintheenvironmentvariable" +
+				"[fail]SPOTIFY_REDIRECT_URI:notsetintheenvironmentvariable(run\"spotlikeauth\"andexportit)" +
+				"[fail]SPOTIFY_REFRESH_TOKEN:notsetintheenvironmentvariable(run\"spotlikeauth\"andexportit)" +
+				"[pass]datadirectory:/test/data/spotlike(fromXDG_DATA_HOME)" +
+				"[fail]redirecturi:SPOTIFY_REDIRECT_URIisnotset" +
+				"[warn]callbackport:skippedbecausetheredirecturihasnoport" +
+				"[warn]tokenrefresh:skippedbecausethecredentialsarenotset" +
+				"[warn]scopes(like,unliketrackandalbum):skippedbecausetheaccesstokenisnotrefreshed" +
+				"[warn]scopes(like,unlikeartist):skippedbecausetheaccesstokenisnotrefreshed" +
+				"[warn]scopes(releases):skippedbecausetheaccesstokenisnotrefreshed" +
+				"[warn]scopes(diff,stats,check):skippedbecausetheaccesstokenisnotrefreshed" +
+				"[warn]scopes(watch,apply,undo):skippedbecausetheaccesstokenisnotrefreshed" +
+				"[warn]clockskew:skippedbecausethetimeofSpotifyisunknown",
+			wantExitCode: ExitCodeError,
+			wantErr:      false,
+			setup: func(_ *gomock.Controller) {
+				doctorOps.Format = "plain"
+			},
+			cleanup: func() {
+				doctorOps = origDoctorOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter() failed)",
+			args: args{
+				cmd:     &c.Command{},
+				version: "1.0.0",
+				conf:    &config.SpotlikeCliConfig{},
+				output:  &output,
+			},
+			wantOutput:   formatter.Red("❌Failedtocreateaformatter..."),
+			wantExitCode: ExitCodeError,
+			wantErr:      true,
+			setup: func(_ *gomock.Controller) {
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("invalid format")
+				}
+			},
+			cleanup: func() {
+				formatter.NewFormatter = origNewFormatter
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (f.Format() failed)",
+			args: args{
+				cmd:     &c.Command{},
+				version: "1.0.0",
+				conf:    &config.SpotlikeCliConfig{},
+				output:  &output,
+			},
+			wantOutput:   "",
+			wantExitCode: ExitCodeError,
+			wantErr:      true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockFormatter := formatter.NewMockFormatter(mockCtrl)
+				mockFormatter.EXPECT().Format(gomock.Any()).Return("", errors.New("format error"))
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return mockFormatter, nil
+				}
+			},
+			cleanup: func() {
+				formatter.NewFormatter = origNewFormatter
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+				SetExitCode(ExitCodeOk)
+			}()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runDoctor(tt.args.cmd, tt.args.version, tt.args.conf, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runDoctor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runDoctor() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runDoctor() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+		})
+	}
+}
+
+func Test_diagnoseRedirectUri(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectUri string
+		wantStatus  string
+		wantPort    string
+	}{
+		{
+			name:        "positive testing",
+			redirectUri: "http://127.0.0.1:8080/callback",
+			wantStatus:  spotlikeApp.DiagnosisStatusPass,
+			wantPort:    "8080",
+		},
+		{
+			name:        "negative testing (redirect uri is not set)",
+			redirectUri: "",
+			wantStatus:  spotlikeApp.DiagnosisStatusFail,
+			wantPort:    "",
+		},
+		{
+			name:        "negative testing (redirect uri is invalid)",
+			redirectUri: "http://[::1",
+			wantStatus:  spotlikeApp.DiagnosisStatusFail,
+			wantPort:    "",
+		},
+		{
+			name:        "negative testing (redirect uri has no port)",
+			redirectUri: "http://127.0.0.1/callback",
+			wantStatus:  spotlikeApp.DiagnosisStatusFail,
+			wantPort:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, port := diagnoseRedirectUri(tt.redirectUri)
+			if got.Status != tt.wantStatus {
+				t.Errorf("diagnoseRedirectUri() status = %v, want %v", got.Status, tt.wantStatus)
+			}
+			if port != tt.wantPort {
+				t.Errorf("diagnoseRedirectUri() port = %v, want %v", port, tt.wantPort)
+			}
+		})
+	}
+}
+
+func Test_diagnoseScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *api.TokenStatus
+		want   []string
+	}{
+		{
+			name:   "positive testing (all scopes are granted)",
+			status: &api.TokenStatus{Scopes: api.Scopes},
+			want:   []string{spotlikeApp.DiagnosisStatusPass, spotlikeApp.DiagnosisStatusPass, spotlikeApp.DiagnosisStatusPass, spotlikeApp.DiagnosisStatusPass, spotlikeApp.DiagnosisStatusPass},
+		},
+		{
+			name:   "positive testing (scopes are not told)",
+			status: &api.TokenStatus{Scopes: nil},
+			want:   []string{spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn},
+		},
+		{
+			name:   "positive testing (token is not refreshed)",
+			status: nil,
+			want:   []string{spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn, spotlikeApp.DiagnosisStatusWarn},
+		},
+		{
+			name:   "negative testing (scopes to follow are missing)",
+			status: &api.TokenStatus{Scopes: []string{"user-library-read", "user-library-modify"}},
+			want:   []string{spotlikeApp.DiagnosisStatusPass, spotlikeApp.DiagnosisStatusFail, spotlikeApp.DiagnosisStatusFail, spotlikeApp.DiagnosisStatusFail, spotlikeApp.DiagnosisStatusFail},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, diagnosis := range diagnoseScopes(tt.status) {
+				got = append(got, diagnosis.Status)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diagnoseScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_diagnoseClock(t *testing.T) {
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		status *api.TokenStatus
+		want   *spotlikeApp.DiagnosisDto
+	}{
+		{
+			name:   "positive testing (local clock is ahead)",
+			status: &api.TokenStatus{ServerTime: now.Add(-3 * time.Second)},
+			want:   spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusPass, "the local clock is 3s ahead of Spotify"),
+		},
+		{
+			name:   "positive testing (local clock is behind)",
+			status: &api.TokenStatus{ServerTime: now.Add(2 * time.Second)},
+			want:   spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusPass, "the local clock is 2s behind Spotify"),
+		},
+		{
+			name:   "positive testing (time of Spotify is unknown)",
+			status: &api.TokenStatus{},
+			want:   spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusWarn, "skipped because the time of Spotify is unknown"),
+		},
+		{
+			name:   "negative testing (local clock is skewed too much)",
+			status: &api.TokenStatus{ServerTime: now.Add(-5 * time.Minute)},
+			want:   spotlikeApp.NewDiagnosisDto("clock skew", spotlikeApp.DiagnosisStatusWarn, "the local clock is 5m0s ahead of Spotify (more than 1m0s)"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diagnoseClock(tt.status, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diagnoseClock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package spotlike
+
+import (
+	"errors"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+)
+
+const (
+	// ExitCodeOk is the exit code when the command succeeded.
+	ExitCodeOk = 0
+	// ExitCodeError is the exit code when the command failed with the error not classified.
+	ExitCodeError = 1
+	// ExitCodeNotFound is the exit code when the content is not found on Spotify.
+	ExitCodeNotFound = 2
+	// ExitCodeNotAuthenticated is the exit code when the client is not authenticated or the token is invalid.
+	ExitCodeNotAuthenticated = 3
+	// ExitCodeForbidden is the exit code when the request is forbidden such as the scope is missing.
+	ExitCodeForbidden = 4
+	// ExitCodeRateLimited is the exit code when the requests are rate limited by Spotify.
+	ExitCodeRateLimited = 5
+	// ExitCodePartialSuccess is the exit code when some of the contents are processed but the others are not found.
+	ExitCodePartialSuccess = 6
+	// ExitCodeNothingToDo is the exit code when all of the contents are skipped such as they are already liked.
+	ExitCodeNothingToDo = 7
+	// ExitCodePartialFailure is the exit code when some of the contents failed to be liked or unliked with the keep going flag.
+	ExitCodePartialFailure = 8
+	// ExitCodeNotLiked is the exit code when some of the contents checked are not liked or not followed.
+	ExitCodeNotLiked = 9
+	// ExitCodeCanceled is the exit code when the command is canceled by the user.
+	ExitCodeCanceled = 130
+)
+
+var (
+	// exitCode is the exit code decided by the command which finished without any error.
+	exitCode = ExitCodeOk
+)
+
+// SetExitCode sets the exit code decided by the command which finished without any error.
+func SetExitCode(code int) {
+	exitCode = code
+}
+
+// GetExitCode returns the exit code decided by the command which finished without any error.
+func GetExitCode() int {
+	return exitCode
+}
+
+// ResultExitCode returns the exit code from the numbers of the processed, skipped, not found and failed contents.
+func ResultExitCode(processed int, skipped int, notFound int, failed int) int {
+	switch {
+	case failed > 0:
+		return ExitCodePartialFailure
+	case notFound > 0 && processed+skipped > 0:
+		return ExitCodePartialSuccess
+	case notFound > 0:
+		return ExitCodeNotFound
+	case processed == 0 && skipped > 0:
+		return ExitCodeNothingToDo
+	default:
+		return ExitCodeOk
+	}
+}
+
+// ResultsExitCode returns the exit code from the results of liking or unliking the contents.
+func ResultsExitCode(results []*spotlikeApp.OperationResultDto) int {
+	var processed, skipped, notFound, failed int
+	for _, result := range results {
+		switch result.Status {
+		case spotlikeApp.OperationResultStatusLiked,
+			spotlikeApp.OperationResultStatusUnliked,
+			spotlikeApp.OperationResultStatusPlanned:
+			processed++
+		case spotlikeApp.OperationResultStatusSkipped,
+			spotlikeApp.OperationResultStatusCanceled:
+			skipped++
+		case spotlikeApp.OperationResultStatusNotFound:
+			notFound++
+		case spotlikeApp.OperationResultStatusFailed:
+			failed++
+		}
+	}
+
+	return ResultExitCode(processed, skipped, notFound, failed)
+}
+
+// LikeStatesExitCode returns the exit code from the states of the contents checked whether they are liked or not.
+func LikeStatesExitCode(states []*spotlikeApp.LikeStateDto) int {
+	var notLiked, notFound int
+	for _, state := range states {
+		switch {
+		case state.State == spotlikeApp.LikeStateNotFound:
+			notFound++
+		case !state.IsLiked():
+			notLiked++
+		}
+	}
+
+	switch {
+	case notFound > 0:
+		return ExitCodeNotFound
+	case notLiked > 0:
+		return ExitCodeNotLiked
+	default:
+		return ExitCodeOk
+	}
+}
+
+// ExitCode returns the exit code corresponding to the error, or the one decided by the command if there is no error.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitCode
+	case errors.Is(err, api.ErrCanceled):
+		return ExitCodeCanceled
+	case errors.Is(err, api.ErrNotFound):
+		return ExitCodeNotFound
+	case errors.Is(err, api.ErrNotAuthenticated):
+		return ExitCodeNotAuthenticated
+	case errors.Is(err, api.ErrForbidden):
+		return ExitCodeForbidden
+	case errors.Is(err, api.ErrRateLimited):
+		return ExitCodeRateLimited
+	default:
+		return ExitCodeError
+	}
+}
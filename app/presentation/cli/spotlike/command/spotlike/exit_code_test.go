@@ -0,0 +1,181 @@
+package spotlike
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+func TestResultExitCode(t *testing.T) {
+	type args struct {
+		processed int
+		skipped   int
+		notFound  int
+		failed    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "positive testing (all contents are processed)",
+			args: args{processed: 2, skipped: 0, notFound: 0},
+			want: ExitCodeOk,
+		},
+		{
+			name: "positive testing (some contents are skipped)",
+			args: args{processed: 1, skipped: 1, notFound: 0},
+			want: ExitCodeOk,
+		},
+		{
+			name: "positive testing (all contents are skipped)",
+			args: args{processed: 0, skipped: 2, notFound: 0},
+			want: ExitCodeNothingToDo,
+		},
+		{
+			name: "positive testing (some contents are not found)",
+			args: args{processed: 1, skipped: 0, notFound: 1},
+			want: ExitCodePartialSuccess,
+		},
+		{
+			name: "positive testing (all contents are not found)",
+			args: args{processed: 0, skipped: 0, notFound: 2},
+			want: ExitCodeNotFound,
+		},
+		{
+			name: "positive testing (some contents are failed)",
+			args: args{processed: 1, skipped: 1, notFound: 1, failed: 1},
+			want: ExitCodePartialFailure,
+		},
+		{
+			name: "positive testing (all contents are failed)",
+			args: args{processed: 0, skipped: 0, notFound: 0, failed: 2},
+			want: ExitCodePartialFailure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResultExitCode(tt.args.processed, tt.args.skipped, tt.args.notFound, tt.args.failed); got != tt.want {
+				t.Errorf("ResultExitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLikeStatesExitCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []*spotlikeApp.LikeStateDto
+		want   int
+	}{
+		{
+			name: "positive testing (all contents are liked or followed)",
+			states: []*spotlikeApp.LikeStateDto{
+				{State: spotlikeApp.LikeStateLiked},
+				{State: spotlikeApp.LikeStateFollowed},
+			},
+			want: ExitCodeOk,
+		},
+		{
+			name: "positive testing (some contents are not liked)",
+			states: []*spotlikeApp.LikeStateDto{
+				{State: spotlikeApp.LikeStateLiked},
+				{State: spotlikeApp.LikeStateNotLiked},
+			},
+			want: ExitCodeNotLiked,
+		},
+		{
+			name: "positive testing (some contents are not followed)",
+			states: []*spotlikeApp.LikeStateDto{
+				{State: spotlikeApp.LikeStateFollowed},
+				{State: spotlikeApp.LikeStateNotFollowed},
+			},
+			want: ExitCodeNotLiked,
+		},
+		{
+			name: "positive testing (some contents are not found)",
+			states: []*spotlikeApp.LikeStateDto{
+				{State: spotlikeApp.LikeStateNotLiked},
+				{State: spotlikeApp.LikeStateNotFound},
+			},
+			want: ExitCodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LikeStatesExitCode(tt.states); got != tt.want {
+				t.Errorf("LikeStatesExitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		exitCode int
+		err      error
+		want     int
+	}{
+		{
+			name:     "positive testing (no error)",
+			exitCode: ExitCodeOk,
+			err:      nil,
+			want:     ExitCodeOk,
+		},
+		{
+			name:     "positive testing (no error with the exit code decided by the command)",
+			exitCode: ExitCodeNothingToDo,
+			err:      nil,
+			want:     ExitCodeNothingToDo,
+		},
+		{
+			name: "positive testing (error not classified)",
+			err:  errors.New("test error"),
+			want: ExitCodeError,
+		},
+		{
+			name: "positive testing (not found)",
+			err:  api.WrapError(spotify.Error{Message: "Resource not found", Status: http.StatusNotFound}),
+			want: ExitCodeNotFound,
+		},
+		{
+			name: "positive testing (not authenticated)",
+			err:  fmt.Errorf("failed to get client: %w", api.ErrClientNotInitialized),
+			want: ExitCodeNotAuthenticated,
+		},
+		{
+			name: "positive testing (forbidden)",
+			err:  api.WrapError(spotify.Error{Message: "Insufficient client scope", Status: http.StatusForbidden}),
+			want: ExitCodeForbidden,
+		},
+		{
+			name: "positive testing (rate limited)",
+			err:  api.WrapError(spotify.Error{Message: "API rate limit exceeded", Status: http.StatusTooManyRequests}),
+			want: ExitCodeRateLimited,
+		},
+		{
+			name: "positive testing (canceled)",
+			err:  api.NewError(api.ErrCanceled, proxy.ErrInterrupt),
+			want: ExitCodeCanceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetExitCode(tt.exitCode)
+			defer SetExitCode(ExitCodeOk)
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package get
 
 import (
+	"errors"
+
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
-	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
@@ -13,13 +15,15 @@ import (
 
 // GetAlbumsOptions represents the options for the get albums command.
 type GetAlbumsOptions struct {
-	Format string
+	Format    string
+	ShowLiked bool
 }
 
 var (
 	// getAlbumsOps is a variable to store the get albums options with the default values for injecting the dependencies in testing.
 	getAlbumsOps = GetAlbumsOptions{
-		Format: "table",
+		Format:    "table",
+		ShowLiked: false,
 	}
 )
 
@@ -41,7 +45,14 @@ func NewGetAlbumsCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.Flags().BoolVarP(
+		&getAlbumsOps.ShowLiked,
+		"show-liked",
+		"",
+		false,
+		"🤍 show whether each of the albums is liked",
 	)
 
 	cmd.SetRunE(
@@ -68,26 +79,27 @@ func runGetAlbums(cmd *c.Command, authCmd proxy.Command, output *string, args []
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
 	}
 
-	artistRepo := repository.NewArtistRepository()
+	artistRepo := spotlike.NewArtistRepository()
 	gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 	gAucoDto, err := gAuc.Run(cmd.Context(), args[0])
-	if err != nil && err.Error() != "Resource not found" {
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
 		return err
 	}
 
 	if gAucoDto == nil {
 		o := formatter.Yellow("⚡ The id " + args[0] + " is not found... or it is not an artist...")
 		*output = o
+		spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 		return nil
 	}
 
-	albumRepo := repository.NewAlbumRepository()
+	albumRepo := spotlike.NewAlbumRepository()
 	gaauc := spotlikeApp.NewGetAllAlbumsByArtistIdUseCase(albumRepo)
 	gaaucoDtos, err := gaauc.Run(cmd.Context(), args[0])
 	if err != nil {
@@ -105,6 +117,20 @@ func runGetAlbums(cmd *c.Command, authCmd proxy.Command, output *string, args []
 		albums = append(albums, album)
 	}
 
+	if getAlbumsOps.ShowLiked {
+		var ids []string
+		for _, album := range albums {
+			ids = append(ids, album.ID)
+		}
+		likes, err := spotlike.CheckLikes(cmd.Context(), "album", ids)
+		if err != nil {
+			return err
+		}
+		for i, liked := range likes {
+			albums[i].Liked = &liked
+		}
+	}
+
 	f, err := formatter.NewFormatter(getAlbumsOps.Format)
 	if err != nil {
 		o := formatter.Red("❌ Failed to create a formatter...")
@@ -129,6 +155,8 @@ You can get the information of the albums on Spotify by artist ID.
 Before using this command,
 you need to get the ID of the artist you want to get by using the search command.
 
+If you specify the "--show-liked" option, whether each of the albums is liked is shown in the output.
+
 ` + getAlbumsUsageTemplate
 	// getAlbumsUsageTemplate is a usage template for the get albums command.
 	getAlbumsUsageTemplate = `Usage:
@@ -137,8 +165,9 @@ you need to get the ID of the artist you want to get by using the search command
   spotlike get a      [flags] [arguments]
 
 Flags:
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
-  -h, --help    🤝 help for albums
+  -f, --format      📝 format of the output (default "table", e.g: "plain", "json")
+  --show-liked      🤍 show whether each of the albums is liked
+  -h, --help        🤝 help for albums
 
 Argument:
   ID  🆔 ID of the artist (e.g. : "00DuPiLri3mNomvvM3nZvU")
@@ -157,7 +157,7 @@ func Test_runGetAlbums(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -210,6 +210,112 @@ func Test_runGetAlbums(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (show liked)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					getAlbumsCmd := NewGetAlbumsCommand(
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					getAlbumsOps.ShowLiked = true
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := getAlbumsCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the getAlbums command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "\n🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATE🤍LIKEDtest_album_idtest_album_nametest_artist_name2000-01-01💚TOTAL:1albums!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, spotify.ID("test_album_id")).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				getAlbumsOps = origGetAlbumsOps
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (args is empty)",
 			fields: fields{
@@ -346,7 +452,7 @@ func Test_runGetAlbums(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -481,7 +587,7 @@ func Test_runGetAlbums(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -568,7 +674,7 @@ func Test_runGetAlbums(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(nil, errors.New("failed to get all albums by artist id"))
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get all albums by artist id"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -656,7 +762,7 @@ func Test_runGetAlbums(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -762,7 +868,7 @@ func Test_runGetAlbums(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -1,11 +1,13 @@
 package get
 
 import (
+	"errors"
+
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
-	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
@@ -13,13 +15,15 @@ import (
 
 // GetTracksOptions represents the options for the get tracks command.
 type GetTracksOptions struct {
-	Format string
+	Format    string
+	ShowLiked bool
 }
 
 var (
 	// getTracksOps is a variable to store the get tracks options with the default values for injecting the dependencies in testing.
 	getTracksOps = GetTracksOptions{
-		Format: "table",
+		Format:    "table",
+		ShowLiked: false,
 	}
 )
 
@@ -41,7 +45,14 @@ func NewGetTracksCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.Flags().BoolVarP(
+		&getTracksOps.ShowLiked,
+		"show-liked",
+		"",
+		false,
+		"🤍 show whether each of the tracks is liked",
 	)
 
 	cmd.SetRunE(
@@ -68,37 +79,40 @@ func runGetTracks(cmd *c.Command, authCmd proxy.Command, output *string, args []
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
 	}
 
-	artistRepo := repository.NewArtistRepository()
+	artistRepo := spotlike.NewArtistRepository()
 	gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 	gAucoDto, err := gAuc.Run(cmd.Context(), args[0])
-	if err != nil && err.Error() != "Resource not found" {
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
 		return err
 	}
 
-	albumRepo := repository.NewAlbumRepository()
+	albumRepo := spotlike.NewAlbumRepository()
 	gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
 	gaucoDto, err := gauc.Run(cmd.Context(), args[0])
-	if err != nil && err.Error() != "Resource not found" {
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
 		return err
 	}
 
 	if gAucoDto == nil && gaucoDto == nil {
 		o := formatter.Yellow("⚡ The id " + args[0] + " is not found... or it is not an artist or album...")
 		*output = o
+		spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 		return nil
 	}
 
 	var tracks []*spotlikeApp.GetTrackUseCaseOutputDto
-	trackRepo := repository.NewTrackRepository()
+	trackRepo := spotlike.NewTrackRepository()
 	if gAucoDto != nil {
+		progress := spotlike.NewProgressReporter(getTracksOps.Format)
 		gatAuc := spotlikeApp.NewGetAllTracksByArtistIdUseCase(trackRepo)
-		gatAucoDtos, err := gatAuc.Run(cmd.Context(), args[0])
+		gatAucoDtos, err := gatAuc.Run(spotlike.WithProgress(cmd.Context(), progress), args[0])
+		progress.Done()
 		if err != nil {
 			return err
 		}
@@ -135,6 +149,20 @@ func runGetTracks(cmd *c.Command, authCmd proxy.Command, output *string, args []
 		}
 	}
 
+	if getTracksOps.ShowLiked {
+		var ids []string
+		for _, track := range tracks {
+			ids = append(ids, track.ID)
+		}
+		likes, err := spotlike.CheckLikes(cmd.Context(), "track", ids)
+		if err != nil {
+			return err
+		}
+		for i, liked := range likes {
+			tracks[i].Liked = &liked
+		}
+	}
+
 	f, err := formatter.NewFormatter(getTracksOps.Format)
 	if err != nil {
 		o := formatter.Red("❌ Failed to create a formatter...")
@@ -159,6 +187,8 @@ You can get the information of the tracks on Spotify by artist ID or album ID.
 Before using this command,
 you need to get the ID of the artist or album you want to get by using the search command.
 
+If you specify the "--show-liked" option, whether each of the tracks is liked is shown in the output.
+
 ` + getTracksUsageTemplate
 	// getTracksUsageTemplate is a usage template for the get tracks command.
 	getTracksUsageTemplate = `Usage:
@@ -167,8 +197,9 @@ you need to get the ID of the artist or album you want to get by using the searc
   spotlike get t      [flags] [arguments]
 
 Flags:
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
-  -h, --help    🤝 help for tracks
+  -f, --format      📝 format of the output (default "table", e.g: "plain", "json")
+  --show-liked      🤍 show whether each of the tracks is liked
+  -h, --help        🤝 help for tracks
 
 Argument:
   ID  🆔 ID of the artist or album (e.g: "00DuPiLri3mNomvvM3nZvU")
@@ -157,8 +157,8 @@ func Test_runGetTracks(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -271,7 +271,7 @@ func Test_runGetTracks(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -341,6 +341,120 @@ func Test_runGetTracks(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (show liked)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					getTracksCmd := NewGetTracksCommand(
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					getTracksOps.ShowLiked = true
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := getTracksCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the getTracks command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+					wantOutput: "\n🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATE🤍LIKEDtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				).AnyTimes()
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				getTracksOps = origGetTracksOps
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (args is empty)",
 			fields: fields{
@@ -477,7 +591,7 @@ func Test_runGetTracks(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -614,7 +728,7 @@ func Test_runGetTracks(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_id")).Return(nil, errors.New("failed to get album"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
@@ -690,8 +804,8 @@ func Test_runGetTracks(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_id")).Return(nil, errors.New("Resource not found"))
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -778,8 +892,8 @@ func Test_runGetTracks(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(nil, errors.New("failed to get tracks by artist id"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get tracks by artist id"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -857,7 +971,7 @@ func Test_runGetTracks(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -963,8 +1077,8 @@ func Test_runGetTracks(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -1088,8 +1202,8 @@ func Test_runGetTracks(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
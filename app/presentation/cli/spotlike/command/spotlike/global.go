@@ -0,0 +1,53 @@
+package spotlike
+
+// GlobalOptions provides the options shared by all commands of spotlike.
+type GlobalOptions struct {
+	// DryRun is a flag to show the plan of like and unlike commands without executing it.
+	DryRun bool
+	// ConfirmThreshold is the number of the items over which like and unlike commands confirm only once with the summary.
+	ConfirmThreshold int
+	// DataDir is the directory to store the data of spotlike such as the operation journal.
+	DataDir string
+	// CacheDir is the directory to store the cache of the catalog lookups.
+	CacheDir string
+	// NoCache is a flag not to use the cache of the catalog lookups.
+	NoCache bool
+	// CacheTTL is the time to live of the cache of the catalog lookups in hours.
+	CacheTTL int
+	// Record is the directory of the cassette to record the requests and the responses to the Spotify Web API.
+	Record string
+	// Replay is the directory of the cassette to replay the responses instead of sending the requests.
+	Replay string
+	// KeepGoing is a flag to keep liking or unliking the remaining contents even if some of them failed.
+	KeepGoing bool
+	// RetryFailed is the path of the report in the json format to retry liking or unliking only the failed contents.
+	RetryFailed string
+	// Quiet is a flag not to show the progress of long running operations.
+	Quiet bool
+	// Verbose is a flag to write the logs of the requests to the Spotify Web API.
+	Verbose bool
+	// Debug is a flag to write the debug logs including the redacted headers of the requests.
+	Debug bool
+	// LogFile is the path of the file to write the logs instead of stderr.
+	LogFile string
+}
+
+var (
+	// GlobalOps is a variable to store the global options with the default values for injecting the dependencies in testing.
+	GlobalOps = GlobalOptions{
+		DryRun:           false,
+		ConfirmThreshold: 10,
+		DataDir:          "",
+		CacheDir:         "",
+		NoCache:          false,
+		CacheTTL:         24,
+		Record:           "",
+		Replay:           "",
+		KeepGoing:        false,
+		RetryFailed:      "",
+		Quiet:            false,
+		Verbose:          false,
+		Debug:            false,
+		LogFile:          "",
+	}
+)
@@ -0,0 +1,131 @@
+package spotlike
+
+import (
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// HistoryOptions provides the options for the history command.
+type HistoryOptions struct {
+	Max    int
+	Batch  string
+	Format string
+}
+
+var (
+	// historyOps is a variable to store the history options with the default values for injecting the dependencies in testing.
+	historyOps = HistoryOptions{
+		Max:    20,
+		Batch:  "",
+		Format: "table",
+	}
+)
+
+// NewHistoryCommand returns a new instance of the history command.
+func NewHistoryCommand(
+	cobra proxy.Cobra,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("history")
+	cmd.SetAliases([]string{"hi", "h"})
+	cmd.SetUsageTemplate(historyUsageTemplate)
+	cmd.SetHelpTemplate(historyHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().IntVarP(
+		&historyOps.Max,
+		"max",
+		"m",
+		20,
+		"🔢 maximum number of operations to show",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.Batch,
+		"batch",
+		"b",
+		"",
+		"📦 an ID of the batch to show the operations in the batch",
+	)
+	cmd.Flags().StringVarP(
+		&historyOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, _ []string) error {
+			return runHistory(cmd, output)
+		},
+	)
+
+	return cmd
+}
+
+// runHistory runs the history command.
+func runHistory(cmd *c.Command, output *string) error {
+	if historyOps.Max < 1 {
+		o := formatter.Yellow("⚡ Invalid history number...")
+		*output = o
+		return nil
+	}
+
+	operationRepo := fileRepository.NewOperationRepository(GlobalOps.DataDir)
+	gouc := spotlikeApp.NewGetOperationsUseCase(operationRepo)
+	goucoDtos, err := gouc.Run(cmd.Context(), historyOps.Batch, historyOps.Max)
+	if err != nil {
+		return err
+	}
+
+	if len(goucoDtos) == 0 {
+		o := formatter.Yellow("⚡ No operations found...")
+		*output = o
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(historyOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(goucoDtos)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+
+	return nil
+}
+
+const (
+	// historyHelpTemplate is the help template of the history command.
+	historyHelpTemplate = `🕒 Show the history of like and unlike operations.
+
+You can show the operations recorded by the like and unlike commands from the newest one.
+The operations executed by one command belong to the same batch.
+Each operation is shown with the Spotify ID of the account whose library was changed.
+
+Also, you can specify the maximum number of operations by specifying the "-m" or "--max" option.
+Also, you can show only the operations in the batch by specifying the "-b" or "--batch" option.
+
+` + historyUsageTemplate
+	// historyUsageTemplate is the usage template of the history command.
+	historyUsageTemplate = `Usage:
+  spotlike history [flags]
+  spotlike hi      [flags]
+  spotlike h       [flags]
+
+Flags:
+  -m, --max     🔢 maximum number of operations to show (default 20)
+  -b, --batch   📦 an ID of the batch to show the operations in the batch
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for history
+`
+)
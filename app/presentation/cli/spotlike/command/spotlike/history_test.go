@@ -0,0 +1,262 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+// saveTestOperations saves the operations for testing to the journal in the specified directory.
+func saveTestOperations(t *testing.T, dir string, operations ...*operationDomain.Operation) {
+	t.Helper()
+	repo := fileRepository.NewOperationRepository(dir)
+	for _, operation := range operations {
+		if err := repo.Save(context.Background(), operation); err != nil {
+			t.Errorf("Failed to save the operation: %v", err)
+		}
+	}
+}
+
+func TestNewHistoryCommand(t *testing.T) {
+	output := ""
+
+	type args struct {
+		cobra  proxy.Cobra
+		output *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra:  proxy.NewCobra(),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewHistoryCommand(tt.args.cobra, tt.args.output)
+			if got == nil {
+				t.Errorf("NewHistoryCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the history command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runHistory(t *testing.T) {
+	output := ""
+	origHistoryOps := historyOps
+	origGlobalOps := GlobalOps
+	origNewFormatter := formatter.NewFormatter
+	su := utility.NewStringsUtil()
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantOutput string
+		wantErr    bool
+		setup      func(mockCtrl *gomock.Controller)
+		cleanup    func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: "🕒TIME📦BATCH🔧ACTION📁TYPE🆔ID📛NAME👤ACCOUNT2000-01-0100:00:00test_batch_id_2unlikealbumtest_album_idtest_album_nametest_user_id2000-01-0100:00:00test_batch_id_1liketracktest_track_idtest_track_nametest_user_idTOTAL:2operations!",
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				saveTestOperations(
+					t,
+					GlobalOps.DataDir,
+					operationDomain.NewOperation("test_batch_id_1", timestamp, "track", "test_track_id", "test_track_name", "like", "spotlike like track test_track_id", "test_user_id"),
+					operationDomain.NewOperation("test_batch_id_2", timestamp, "album", "test_album_id", "test_album_name", "unlike", "spotlike unlike album test_album_id", "test_user_id"),
+				)
+			},
+			cleanup: func() {
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (batch is specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: "[test_batch_id_1]2000-01-0100:00:00liketrack:test_track_name(test_track_id)bytest_user_id",
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				historyOps.Batch = "test_batch_id_1"
+				historyOps.Format = "plain"
+				GlobalOps.DataDir = t.TempDir()
+				saveTestOperations(
+					t,
+					GlobalOps.DataDir,
+					operationDomain.NewOperation("test_batch_id_1", timestamp, "track", "test_track_id", "test_track_name", "like", "spotlike like track test_track_id", "test_user_id"),
+					operationDomain.NewOperation("test_batch_id_2", timestamp, "album", "test_album_id", "test_album_name", "unlike", "spotlike unlike album test_album_id", "test_user_id"),
+				)
+			},
+			cleanup: func() {
+				historyOps = origHistoryOps
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (max is less than 1)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: formatter.Yellow("⚡Invalidhistorynumber..."),
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				historyOps.Max = 0
+			},
+			cleanup: func() {
+				historyOps = origHistoryOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (no operations found)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: formatter.Yellow("⚡Nooperationsfound..."),
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+			},
+			cleanup: func() {
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gouc.Run() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(_ *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				if err := os.Mkdir(filepath.Join(GlobalOps.DataDir, "journal.jsonl"), 0700); err != nil {
+					t.Errorf("Failed to create a directory: %v", err)
+				}
+			},
+			cleanup: func() {
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: formatter.Red("❌Failedtocreateaformatter..."),
+			wantErr:    true,
+			setup: func(_ *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				saveTestOperations(
+					t,
+					GlobalOps.DataDir,
+					operationDomain.NewOperation("test_batch_id_1", timestamp, "track", "test_track_id", "test_track_name", "like", "spotlike like track test_track_id", "test_user_id"),
+				)
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("invalid format")
+				}
+			},
+			cleanup: func() {
+				formatter.NewFormatter = origNewFormatter
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (f.Format() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				saveTestOperations(
+					t,
+					GlobalOps.DataDir,
+					operationDomain.NewOperation("test_batch_id_1", timestamp, "track", "test_track_id", "test_track_name", "like", "spotlike like track test_track_id", "test_user_id"),
+				)
+				mockFormatter := formatter.NewMockFormatter(mockCtrl)
+				mockFormatter.EXPECT().Format(gomock.Any()).Return("", errors.New("format error"))
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return mockFormatter, nil
+				}
+			},
+			cleanup: func() {
+				formatter.NewFormatter = origNewFormatter
+				GlobalOps = origGlobalOps
+				output = ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runHistory(tt.args.cmd, tt.args.output); (err != nil) != tt.wantErr {
+				t.Errorf("runHistory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runHistory() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package spotlike
+
+import (
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+)
+
+const (
+	// defaultLookBackDays is the number of the days to look back when the command has never been run.
+	defaultLookBackDays = 30
+)
+
+// sinceLastRun returns the date of the last run of the command stored with the key, or the date 30 days ago if it has never been run.
+// The release dates have no time, so the releases on the day of the last run are included again.
+func sinceLastRun(st state.State, key string, now time.Time) (time.Time, error) {
+	lastRun, err := st.LastRun(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if lastRun.IsZero() {
+		lastRun = now.AddDate(0, 0, -defaultLookBackDays)
+	}
+
+	return time.Date(lastRun.Year(), lastRun.Month(), lastRun.Day(), 0, 0, 0, 0, time.UTC), nil
+}
@@ -0,0 +1,64 @@
+package spotlike
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+)
+
+func Test_sinceLastRun(t *testing.T) {
+	now := time.Date(2000, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		want    time.Time
+		wantErr bool
+		setup   func(dir string)
+	}{
+		{
+			name:    "positive testing (the command has been run)",
+			want:    time.Date(2000, 1, 15, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+			setup: func(dir string) {
+				if err := state.NewState(dir).SaveLastRun("test_key", time.Date(2000, 1, 15, 12, 0, 0, 0, time.UTC)); err != nil {
+					t.Errorf("Failed to save the last run: %v", err)
+				}
+			},
+		},
+		{
+			name:    "positive testing (the command has never been run)",
+			want:    time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+			wantErr: false,
+			setup:   nil,
+		},
+		{
+			name:    "negative testing (state is broken)",
+			want:    time.Time{},
+			wantErr: true,
+			setup: func(dir string) {
+				if err := os.WriteFile(filepath.Join(dir, "state.json"), []byte("{"), 0600); err != nil {
+					t.Errorf("Failed to write the state: %v", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.setup != nil {
+				tt.setup(dir)
+			}
+			got, err := sinceLastRun(state.NewState(dir), "test_key", now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("sinceLastRun() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("sinceLastRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,13 +1,19 @@
 package like
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -62,7 +68,7 @@ func NewLikeAlbumCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -76,6 +82,24 @@ func NewLikeAlbumCommand(
 
 // runLikeAlbum runs the like album command.
 func runLikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "album", "like")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed albums found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		// retry only the failed albums ignoring the flags to specify the parent
+		likeAlbumOps.Artist = ""
+		args = ids
+	}
+
 	if likeAlbumOps.Artist == "" && len(args) == 0 {
 		o := formatter.Yellow("⚡ No ID arguments specified...")
 		*output = o
@@ -89,7 +113,7 @@ func runLikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -99,19 +123,23 @@ func runLikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return err
 	}
 
+	w := spotlike.MessageWriter(likeAlbumOps.Format)
+	progress := spotlike.NewProgressReporter(likeAlbumOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gaucoDtos []*spotlikeApp.GetAlbumUseCaseOutputDto
-	albumRepo := repository.NewAlbumRepository()
+	albumRepo := spotlike.NewAlbumRepository()
 	if likeAlbumOps.Artist != "" {
-		artistRepo := repository.NewArtistRepository()
+		artistRepo := spotlike.NewArtistRepository()
 		gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 		gAucoDto, err := gAuc.Run(cmd.Context(), likeAlbumOps.Artist)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gAucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + likeAlbumOps.Artist + " is not found or it is not an artist...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
@@ -136,13 +164,19 @@ func runLikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		for _, id := range args {
 			gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
 			gaucoDto, err := gauc.Run(cmd.Context(), id)
-			if err != nil && err.Error() != "Resource not found" {
-				return err
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", id, "", "like", spotlikeApp.OperationResultStatusFailed, err))
+				continue
 			}
 
 			if gaucoDto == nil {
-				o := formatter.Yellow("⚡ The id " + id + " is not found or it is not an album...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not an album...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", id, "", "like", spotlikeApp.OperationResultStatusNotFound, nil))
 				continue
 			}
 
@@ -159,65 +193,185 @@ func runLikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 	}
 
 	clauc := spotlikeApp.NewCheckLikeAlbumUseCase(albumRepo)
-	lauc := spotlikeApp.NewLikeAlbumUseCase(albumRepo)
-	var likeExecutedAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
+	var likeTargetAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
 	for _, gaucoDto := range gaucoDtos {
 		alreadyLiked, err := clauc.Run(cmd.Context(), gaucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Album "+gaucoDto.Name+" ("+gaucoDto.ID+")"+" released by "+gaucoDto.Artists+" is already liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Album "+gaucoDto.Name+" ("+gaucoDto.ID+")"+" released by "+gaucoDto.Artists+" is already liked. skipping...")); err != nil {
 				return err
 			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusSkipped, nil))
 			continue
 		}
 
-		if !likeAlbumOps.NoConfirm {
+		likeTargetAlbums = append(likeTargetAlbums, gaucoDto)
+	}
+
+	noConfirm := likeAlbumOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(likeTargetAlbums) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(likeAlbumOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(likeTargetAlbums)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with liking " + fmt.Sprint(len(likeTargetAlbums)) + " albums above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled liking albums...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	lauc := spotlikeApp.NewLikeAlbumUseCase(albumRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var likeExecutedAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gaucoDto := range likeTargetAlbums {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled liking the remaining albums...")); err != nil {
+				return err
+			}
+			for _, remaining := range likeTargetAlbums[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("album", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("albums liked", i, len(likeTargetAlbums))
+		}
+		if spotlike.GlobalOps.DryRun {
+			likeExecutedAlbums = append(likeExecutedAlbums, gaucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with liking " + gaucoDto.Name + " (" + gaucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with liking " + gaucoDto.Name + " (" + gaucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking the remaining albums...")); err != nil {
+					return err
+				}
+				for _, remaining := range likeTargetAlbums[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("album", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled liking album " + gaucoDto.Name + " (" + gaucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking album "+gaucoDto.Name+" ("+gaucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := lauc.Run(cmd.Context(), gaucoDto.ID); err != nil {
+		if err := lauc.Run(ctx, gaucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "album",
+				ID:          gaucoDto.ID,
+				Name:        gaucoDto.Name,
+				Action:      "like",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "like", spotlikeApp.OperationResultStatusLiked, nil))
 		likeExecutedAlbums = append(likeExecutedAlbums, gaucoDto)
 	}
+	progress.Done()
 
-	if len(likeExecutedAlbums) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(likeAlbumOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(likeExecutedAlbums)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅🤍💿 Successfully liked albums below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if likeAlbumOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(likeExecutedAlbums) != 0 {
+		message := formatter.Green("✅🤍💿 Successfully liked albums below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪🤍💿 Albums below would be liked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
@@ -233,6 +387,10 @@ you need to get the ID of the track you want to like by using the search command
 Also, you can like all albums released by the artist with specifying the ID of the artist with artist flag.
 If you specify artist flag, the arguments would be ignored.
 
+Before liking each album, you would be asked to confirm.
+You can answer "a" to like all the remaining albums without confirming, or "q" to quit.
+If more albums than the confirm threshold are affected, you would be asked only once with the summary.
+
 ` + likeAlbumUsageTemplate
 	// likeAlbumUsageTemplate is the usage template of the like album command.
 	likeAlbumUsageTemplate = `Usage:
@@ -243,9 +401,16 @@ If you specify artist flag, the arguments would be ignored.
 Flags:
   -A, --artist  🆔 an ID of the artist to like all albums released by the artist
   --no-confirm  🚫 do not confirm before liking the album
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for album
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the albums (e.g: "1dGzXXa8MeTCdi0oBbvB1J 6Xy481vVb9vPK4qbCuT9u1")
 `
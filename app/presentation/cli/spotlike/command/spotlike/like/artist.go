@@ -1,13 +1,19 @@
 package like
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -53,7 +59,7 @@ func NewLikeArtistCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -67,6 +73,22 @@ func NewLikeArtistCommand(
 
 // runLikeArtist runs the like artist command.
 func runLikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "artist", "like")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed artists found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		args = ids
+	}
+
 	if len(args) == 0 {
 		o := formatter.Yellow("⚡ No ID arguments specified...")
 		*output = o
@@ -80,7 +102,7 @@ func runLikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -90,18 +112,27 @@ func runLikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return err
 	}
 
+	w := spotlike.MessageWriter(likeArtistOps.Format)
+	progress := spotlike.NewProgressReporter(likeArtistOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gAucoDtos []*spotlikeApp.GetArtistUseCaseOutputDto
-	artistRepo := repository.NewArtistRepository()
+	artistRepo := spotlike.NewArtistRepository()
 	gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 	for _, id := range args {
 		gAucoDto, err := gAuc.Run(cmd.Context(), id)
-		if err != nil && err.Error() != "Resource not found" {
-			return err
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", id, "", "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 
 		if gAucoDto == nil {
-			o := formatter.Yellow("⚡ The id " + id + " is not found or it is not an artist...")
-			*output = o
+			if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not an artist...")); err != nil {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", id, "", "like", spotlikeApp.OperationResultStatusNotFound, nil))
 			continue
 		}
 
@@ -109,65 +140,185 @@ func runLikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 	}
 
 	clAuc := spotlikeApp.NewCheckLikeArtistUseCase(artistRepo)
-	lAuc := spotlikeApp.NewLikeArtistUseCase(artistRepo)
-	var likeExecutedArtists []*spotlikeApp.GetArtistUseCaseOutputDto
+	var likeTargetArtists []*spotlikeApp.GetArtistUseCaseOutputDto
 	for _, gAucoDto := range gAucoDtos {
 		alreadyLiked, err := clAuc.Run(cmd.Context(), gAucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Artist "+gAucoDto.Name+" ("+gAucoDto.ID+") "+"is already liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Artist "+gAucoDto.Name+" ("+gAucoDto.ID+") "+"is already liked. skipping...")); err != nil {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusSkipped, nil))
+			continue
+		}
+
+		likeTargetArtists = append(likeTargetArtists, gAucoDto)
+	}
+
+	noConfirm := likeArtistOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(likeTargetArtists) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(likeArtistOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(likeTargetArtists)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with liking " + fmt.Sprint(len(likeTargetArtists)) + " artists above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
 				return err
 			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled liking artists...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	lAuc := spotlikeApp.NewLikeArtistUseCase(artistRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var likeExecutedArtists []*spotlikeApp.GetArtistUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gAucoDto := range likeTargetArtists {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled liking the remaining artists...")); err != nil {
+				return err
+			}
+			for _, remaining := range likeTargetArtists[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("artist", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("artists liked", i, len(likeTargetArtists))
+		}
+		if spotlike.GlobalOps.DryRun {
+			likeExecutedArtists = append(likeExecutedArtists, gAucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusPlanned, nil))
 			continue
 		}
 
-		if !likeArtistOps.NoConfirm {
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with liking " + gAucoDto.Name + " (" + gAucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with liking " + gAucoDto.Name + " (" + gAucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking the remaining artists...")); err != nil {
+					return err
+				}
+				for _, remaining := range likeTargetArtists[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("artist", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled liking artist " + gAucoDto.Name + " (" + gAucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking artist "+gAucoDto.Name+" ("+gAucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := lAuc.Run(cmd.Context(), gAucoDto.ID); err != nil {
+		if err := lAuc.Run(ctx, gAucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "artist",
+				ID:          gAucoDto.ID,
+				Name:        gAucoDto.Name,
+				Action:      "like",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "like", spotlikeApp.OperationResultStatusLiked, nil))
 		likeExecutedArtists = append(likeExecutedArtists, gAucoDto)
 	}
+	progress.Done()
 
-	if len(likeExecutedArtists) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(likeArtistOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(likeExecutedArtists)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅🤍🎤 Successfully liked artists below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if likeArtistOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(likeExecutedArtists) != 0 {
+		message := formatter.Green("✅🤍🎤 Successfully liked artists below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪🤍🎤 Artists below would be liked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
@@ -180,6 +331,10 @@ You can like artists on Spotify by ID.
 Before using this command,
 you need to get the ID of the artist you want to like by using the search command.
 
+Before liking each artist, you would be asked to confirm.
+You can answer "a" to like all the remaining artists without confirming, or "q" to quit.
+If more artists than the confirm threshold are affected, you would be asked only once with the summary.
+
 ` + likeArtistUsageTemplate
 	// likeArtistUsageTemplate is the usage template of the like artist command.
 	likeArtistUsageTemplate = `Usage:
@@ -189,9 +344,16 @@ you need to get the ID of the artist you want to like by using the search comman
 
 Flags:
   --no-confirm  🚫 do not confirm before liking the artist
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for artist
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the artists (e.g. : "00DuPiLri3mNomvvM3nZvU 3B9O5mYYw89fFXkwKh7jCS")
 `
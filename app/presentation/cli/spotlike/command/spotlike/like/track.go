@@ -1,14 +1,19 @@
 package like
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -72,7 +77,7 @@ func NewLikeTrackCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -86,6 +91,25 @@ func NewLikeTrackCommand(
 
 // runLikeTrack executes the like track command.
 func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "track", "like")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed tracks found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		// retry only the failed tracks ignoring the flags to specify the parent
+		likeTrackOps.Artist = ""
+		likeTrackOps.Album = ""
+		args = ids
+	}
+
 	if likeTrackOps.Artist != "" && likeTrackOps.Album != "" {
 		o := formatter.Yellow("⚡ Both artist and album flags can not be specified at the same time...")
 		*output = o
@@ -105,7 +129,7 @@ func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -115,24 +139,29 @@ func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		return err
 	}
 
+	w := spotlike.MessageWriter(likeTrackOps.Format)
+	progress := spotlike.NewProgressReporter(likeTrackOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gtucoDtos []*spotlikeApp.GetTrackUseCaseOutputDto
-	trackRepo := repository.NewTrackRepository()
+	trackRepo := spotlike.NewTrackRepository()
 	if likeTrackOps.Artist != "" {
-		artistRepo := repository.NewArtistRepository()
+		artistRepo := spotlike.NewArtistRepository()
 		gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 		gAucoDto, err := gAuc.Run(cmd.Context(), likeTrackOps.Artist)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gAucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + likeTrackOps.Artist + " is not found or it is not an artist...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
 		gatAuc := spotlikeApp.NewGetAllTracksByArtistIdUseCase(trackRepo)
-		gatAucoDtos, err := gatAuc.Run(cmd.Context(), likeTrackOps.Artist)
+		gatAucoDtos, err := gatAuc.Run(spotlike.WithProgress(cmd.Context(), progress), likeTrackOps.Artist)
+		progress.Done()
 		if err != nil {
 			return err
 		}
@@ -151,16 +180,17 @@ func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 			)
 		}
 	} else if likeTrackOps.Album != "" {
-		albumRepo := repository.NewAlbumRepository()
+		albumRepo := spotlike.NewAlbumRepository()
 		gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
 		gaucoDto, err := gauc.Run(cmd.Context(), likeTrackOps.Album)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gaucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + likeTrackOps.Album + " is not found or it is not an album...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
@@ -187,13 +217,19 @@ func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 		for _, id := range args {
 			gtuc := spotlikeApp.NewGetTrackUseCase(trackRepo)
 			gtucoDto, err := gtuc.Run(cmd.Context(), id)
-			if err != nil && err.Error() != "Resource not found" {
-				return err
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", id, "", "like", spotlikeApp.OperationResultStatusFailed, err))
+				continue
 			}
 
 			if gtucoDto == nil {
-				o := formatter.Yellow("⚡ The id " + id + " is not found or it is not a track...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not a track...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", id, "", "like", spotlikeApp.OperationResultStatusNotFound, nil))
 				continue
 			}
 
@@ -202,65 +238,185 @@ func runLikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output
 	}
 
 	cltuc := spotlikeApp.NewCheckLikeTrackUseCase(trackRepo)
-	ltuc := spotlikeApp.NewLikeTrackUseCase(trackRepo)
-	var likeExecutedTracks []*spotlikeApp.GetTrackUseCaseOutputDto
+	var likeTargetTracks []*spotlikeApp.GetTrackUseCaseOutputDto
 	for _, gtucoDto := range gtucoDtos {
 		alreadyLiked, err := cltuc.Run(cmd.Context(), gtucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Track #"+fmt.Sprint(gtucoDto.TrackNumber)+" "+gtucoDto.Name+" ("+gtucoDto.ID+")"+" on "+gtucoDto.Album+" rereased by "+gtucoDto.Artists+" is already liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Track #"+fmt.Sprint(gtucoDto.TrackNumber)+" "+gtucoDto.Name+" ("+gtucoDto.ID+")"+" on "+gtucoDto.Album+" rereased by "+gtucoDto.Artists+" is already liked. skipping...")); err != nil {
 				return err
 			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusSkipped, nil))
 			continue
 		}
 
-		if !likeTrackOps.NoConfirm {
+		likeTargetTracks = append(likeTargetTracks, gtucoDto)
+	}
+
+	noConfirm := likeTrackOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(likeTargetTracks) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(likeTrackOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(likeTargetTracks)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with liking " + fmt.Sprint(len(likeTargetTracks)) + " tracks above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled liking tracks...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	ltuc := spotlikeApp.NewLikeTrackUseCase(trackRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var likeExecutedTracks []*spotlikeApp.GetTrackUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gtucoDto := range likeTargetTracks {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled liking the remaining tracks...")); err != nil {
+				return err
+			}
+			for _, remaining := range likeTargetTracks[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("track", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("tracks liked", i, len(likeTargetTracks))
+		}
+		if spotlike.GlobalOps.DryRun {
+			likeExecutedTracks = append(likeExecutedTracks, gtucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with liking " + gtucoDto.Name + " (" + gtucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with liking " + gtucoDto.Name + " (" + gtucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking the remaining tracks...")); err != nil {
+					return err
+				}
+				for _, remaining := range likeTargetTracks[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("track", remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled liking track " + gtucoDto.Name + " (" + gtucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking track "+gtucoDto.Name+" ("+gtucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := ltuc.Run(cmd.Context(), gtucoDto.ID); err != nil {
+		if err := ltuc.Run(ctx, gtucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "track",
+				ID:          gtucoDto.ID,
+				Name:        gtucoDto.Name,
+				Action:      "like",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "like", spotlikeApp.OperationResultStatusLiked, nil))
 		likeExecutedTracks = append(likeExecutedTracks, gtucoDto)
 	}
+	progress.Done()
 
-	if len(likeExecutedTracks) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(likeTrackOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(likeExecutedTracks)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅🤍🎵 Successfully liked tracks below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if likeTrackOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(likeExecutedTracks) != 0 {
+		message := formatter.Green("✅🤍🎵 Successfully liked tracks below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪🤍🎵 Tracks below would be liked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
@@ -279,6 +435,10 @@ Also, you can like all tracks in the album with specifying the ID of the album w
 If you specify album flag, the arguments would be ignored.
 Both artist and album flags can not be specified at the same time.
 
+Before liking each track, you would be asked to confirm.
+You can answer "a" to like all the remaining tracks without confirming, or "q" to quit.
+If more tracks than the confirm threshold are affected, you would be asked only once with the summary.
+
 ` + likeTrackUsageTemplate
 	// likeTrackUsageTemplate is a template for the usage message of the like track command.
 	likeTrackUsageTemplate = `Usage:
@@ -290,9 +450,16 @@ Flags:
   -A, --artist  🆔 an ID of the artist to like all albums released by the artist
   -a, --album   🆔 an ID of the album to like all tracks in the album
   --no-confirm  🚫 do not confirm before liking the track
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for track
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the tracks (e.g: "20q73dOrP7ceLGAJQVtuTq 5A7nqzXUt5IZIOA7oNBv6M")
 `
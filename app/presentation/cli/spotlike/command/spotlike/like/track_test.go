@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	o "os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -150,11 +151,12 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅🤍🎵 Successfully liked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -164,7 +166,7 @@ func Test_runLikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -202,7 +204,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -229,7 +231,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -244,6 +246,542 @@ func Test_runLikeTrack(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (all remaining items are confirmed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Artist = "test_artist_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Green("✅🤍🎵 Successfully liked tracks below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("a", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (confirmed once with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Artist = "test_artist_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!" + formatter.Green("✅🤍🎵 Successfully liked tracks below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking 1 tracks above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (quit the remaining items)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Artist = "test_artist_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🚫 Cancelled liking the remaining tracks..."),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikecanceledTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("q", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (cancelled with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Artist = "test_artist_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("🚫 Cancelled liking tracks..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking 1 tracks above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "positive testing (album option is set)",
 			fields: fields{
@@ -283,11 +821,12 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅🤍🎵 Successfully liked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -324,7 +863,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -351,7 +890,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -404,11 +943,12 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅🤍🎵 Successfully liked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -438,7 +978,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -465,7 +1005,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -479,6 +1019,114 @@ func Test_runLikeTrack(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (dry run)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🧪🤍🎵 Tracks below would be liked... (dry run)"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikeplannedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.DryRun = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.DryRun = false
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (both artist and album options are set)",
 			fields: fields{
@@ -665,7 +1313,7 @@ func Test_runLikeTrack(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -679,6 +1327,7 @@ func Test_runLikeTrack(t *testing.T) {
 			cleanup: func() {
 				api.GetClientManagerFunc = origGetClientManagerFunc
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
@@ -860,7 +1509,7 @@ func Test_runLikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -950,7 +1599,7 @@ func Test_runLikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -968,8 +1617,266 @@ func Test_runLikeTrack(t *testing.T) {
 						},
 					},
 					nil,
-				)
-				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by artist id"))
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by artist id"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to get album)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Album = "test_album_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get album" {
+							t.Errorf("Failed to run the likeTrack command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gaucoDto is nil)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Album = "test_album_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the likeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				likeTrackOps = origLikeTrackOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to get all tracks by album id)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					likeTrackCmd := NewLikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					likeTrackOps.Album = "test_album_id"
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get all tracks by album id" {
+							t.Errorf("Failed to run the likeTrack command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				).AnyTimes()
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by album id"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1005,7 +1912,7 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (failed to get album)",
+			name: "negative testing (failed to get track)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1035,9 +1942,8 @@ func Test_runLikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					likeTrackOps.Album = "test_album_id"
-					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get album" {
+					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to get track" {
 							t.Errorf("Failed to run the likeTrack command: %v", err)
 						}
 					}
@@ -1050,7 +1956,7 @@ func Test_runLikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("failed to get track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1081,12 +1987,12 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (gaucoDto is nil)",
+			name: "negative testing (gtucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1116,20 +2022,21 @@ func Test_runLikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					likeTrackOps.Album = "test_album_id"
-					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
-						t.Errorf("Failed to run the likeTrack command: %v", err)
+					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to get track" {
+							t.Errorf("Failed to run the likeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("⚡ The id test_track_id is not found or it is not a track..."),
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracklikenot_foundTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1160,12 +2067,12 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				likeTrackOps = origLikeTrackOps
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get all tracks by album id)",
+			name: "negative testing (failed to check if track is already liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1195,9 +2102,8 @@ func Test_runLikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					likeTrackOps.Album = "test_album_id"
-					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get all tracks by album id" {
+					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to check if track is already liked" {
 							t.Errorf("Failed to run the likeTrack command: %v", err)
 						}
 					}
@@ -1210,9 +2116,20 @@ func Test_runLikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
-					&spotify.FullAlbum{
-						SimpleAlbum: spotify.SimpleAlbum{
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
 							ID:   "test_album_id",
 							Name: "test_album_name",
 							Artists: []spotify.SimpleArtist{
@@ -1226,8 +2143,8 @@ func Test_runLikeTrack(t *testing.T) {
 						},
 					},
 					nil,
-				).AnyTimes()
-				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by album id"))
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return(nil, errors.New("failed to check if track is already liked"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1258,12 +2175,11 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				likeTrackOps = origLikeTrackOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get track)",
+			name: "negative testing (track is already liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1294,20 +2210,46 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to get track" {
-							t.Errorf("Failed to run the likeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the likeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Blue("⏩ Track #" + "1" + " " + "test_track_name" + " (" + "test_track_id" + ")" + " on " + "test_album_name" + " rereased by " + "test_artist_name" + " is already liked. skipping..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikeskippedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("failed to get track"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1338,12 +2280,11 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (gtucoDto is nil)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Track #\"+fmt.Sprint(gtucoDto.TrackNumber)+\" \"+gtucoDto.Name+\" (\"+gtucoDto.ID+\")\"+\" on \"+gtucoDto.Album+\" rereased by \"+gtucoDto.Artists+\" is already liked. skipping...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1374,7 +2315,7 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to get track" {
+						if err.Error() != "Print() failed" {
 							t.Errorf("Failed to run the likeTrack command: %v", err)
 						}
 					}
@@ -1382,12 +2323,40 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_track_id is not found or it is not a track..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1413,17 +2382,23 @@ func Test_runLikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, formatter.Blue("⏩ Track #"+"1"+" "+"test_track_name"+" ("+"test_track_id"+")"+" on "+"test_album_name"+" rereased by "+"test_artist_name"+" is already liked. skipping...")) {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to check if track is already liked)",
+			name: "negative testing (like cancelled with ^C)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1431,6 +2406,9 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
+					exit = func(code int) {
+						// do nothing
+					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -1454,13 +2432,11 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to check if track is already liked" {
-							t.Errorf("Failed to run the likeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the likeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("\n🚫 Cancelled liking..."),
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1495,7 +2471,7 @@ func Test_runLikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return(nil, errors.New("failed to check if track is already liked"))
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1521,16 +2497,24 @@ func Test_runLikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				exit = o.Exit
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (track is already liked)",
+			name: "negative testing (like cancelled with ^C and presenter.Print(\"\n\") failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1561,11 +2545,13 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the likeTrack command: %v", err)
+						if err.Error() != "Print() failed" {
+							t.Errorf("Failed to run the likeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Blue("⏩ Track #" + "1" + " " + "test_track_name" + " (" + "test_track_id" + ")" + " on " + "test_album_name" + " rereased by " + "test_artist_name" + " is already liked. skipping..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1600,7 +2586,7 @@ func Test_runLikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1626,16 +2612,30 @@ func Test_runLikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "\n") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Track #\"+fmt.Sprint(gtucoDto.TrackNumber)+\" \"+gtucoDto.Name+\" (\"+gtucoDto.ID+\")\"+\" on \"+gtucoDto.Album+\" rereased by \"+gtucoDto.Artists+\" is already liked. skipping...\")) failed)",
+			name: "negative testing (like cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled liking...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1707,7 +2707,7 @@ func Test_runLikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1733,8 +2733,14 @@ func Test_runLikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, formatter.Blue("⏩ Track #"+"1"+" "+"test_track_name"+" ("+"test_track_id"+")"+" on "+"test_album_name"+" rereased by "+"test_artist_name"+" is already liked. skipping...")) {
+					if strings.Contains(output, "🚫 Cancelled liking...") {
 						return errors.New("Print() failed")
 					}
 					return origPrint(writer, output)
@@ -1744,11 +2750,13 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
 				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (like cancelled with ^C)",
+			name: "negative testing (presenter.Runprompt() failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1756,9 +2764,6 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
-					exit = func(code int) {
-						// do nothing
-					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -1782,11 +2787,13 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the likeTrack command: %v", err)
+						if err.Error() != "failed to run prompt" {
+							t.Errorf("Failed to run the likeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Yellow("\n🚫 Cancelled liking..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1848,8 +2855,8 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -1858,12 +2865,12 @@ func Test_runLikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				exit = o.Exit
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (like cancelled with ^C and presenter.Print(\"\n\") failed)",
+			name: "negative testing (skipped to like track)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1894,15 +2901,13 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "Print() failed" {
-							t.Errorf("Failed to run the likeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the likeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("🚫 Cancelled liking track test_track_name (test_track_id) ..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikecanceledTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
@@ -1962,28 +2967,22 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "\n") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (like cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled liking...\")) failed)",
+			name: "negative testing (like track failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2014,7 +3013,7 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "Print() failed" {
+						if err.Error() != "failed to like track" {
 							t.Errorf("Failed to run the likeTrack command: %v", err)
 						}
 					}
@@ -2056,6 +3055,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2082,28 +3082,22 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "🚫 Cancelled liking...") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Runprompt() failed)",
+			name: "positive testing (keep going after like track failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2134,17 +3128,16 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
-							t.Errorf("Failed to run the likeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the likeTrack command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikefailedfailedtoliketrackTOTAL:1results!❌Failedtolike1contentsbelow...tracktest_track_name(test_track_id):failedtoliketrack",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
@@ -2176,6 +3169,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2202,21 +3196,23 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (skipped to like track)",
+			name: "positive testing (retry the failed track in the report)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2246,16 +3242,22 @@ func Test_runLikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+					if err := likeTrackCmd.RunE(cmd, []string{}); err != nil {
 						t.Errorf("Failed to run the likeTrack command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("🚫 Cancelled liking track test_track_name (test_track_id)..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikefailedfailedtoliketrackTOTAL:1results!❌Failedtolike1contentsbelow...tracktest_track_name(test_track_id):failedtoliketrack",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				report := filepath.Join(t.TempDir(), "report.json")
+				if err := o.WriteFile(report, []byte(`[{"type":"track","id":"test_track_id","name":"test_track_name","action":"like","status":"failed","error":"failed to like track"}]`), 0600); err != nil {
+					t.Errorf("Failed to create a report: %v", err)
+				}
+				spotlike.GlobalOps.RetryFailed = report
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
@@ -2287,6 +3289,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2313,13 +3316,15 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.RetryFailed = ""
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -2328,7 +3333,7 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (like track failed)",
+			name: "negative testing (failed to record the operation)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2359,7 +3364,7 @@ func Test_runLikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := likeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to like track" {
+						if !strings.Contains(err.Error(), "not a directory") {
 							t.Errorf("Failed to run the likeTrack command: %v", err)
 						}
 					}
@@ -2370,8 +3375,14 @@ func Test_runLikeTrack(t *testing.T) {
 			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				file := filepath.Join(t.TempDir(), "file")
+				if err := o.WriteFile(file, []byte{}, 0600); err != nil {
+					t.Errorf("Failed to create a file: %v", err)
+				}
+				spotlike.GlobalOps.DataDir = filepath.Join(file, "spotlike")
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2401,7 +3412,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2428,13 +3439,14 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.DataDir = ""
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -2488,6 +3500,7 @@ func Test_runLikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2517,7 +3530,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2544,7 +3557,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2604,6 +3617,7 @@ func Test_runLikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2633,7 +3647,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2660,7 +3674,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2721,11 +3735,12 @@ func Test_runLikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2755,7 +3770,7 @@ func Test_runLikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
-				mockSpotifyClient.EXPECT().AddTracksToLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2782,7 +3797,7 @@ func Test_runLikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with liking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
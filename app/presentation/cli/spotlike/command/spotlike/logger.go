@@ -0,0 +1,54 @@
+package spotlike
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+)
+
+var (
+	// logFile is the file the logs are written to, which is nil if the logs are written to stderr or not written.
+	logFile *os.File
+)
+
+// SetUpLogger sets up the logger with the verbose, debug and log file flags.
+// The logs are written only when any of them is specified, and the debug flag lowers the level to debug.
+func SetUpLogger() error {
+	if !GlobalOps.Verbose && !GlobalOps.Debug && GlobalOps.LogFile == "" {
+		api.SetLogger(nil)
+		return nil
+	}
+
+	level := slog.LevelInfo
+	if GlobalOps.Debug {
+		level = slog.LevelDebug
+	}
+
+	var writer io.Writer = os.Stderr
+	if GlobalOps.LogFile != "" {
+		file, err := os.OpenFile(GlobalOps.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		logFile = file
+		writer = file
+	}
+	api.SetLogger(api.NewLogger(writer, level))
+
+	return nil
+}
+
+// CloseLogger stops writing the logs and closes the log file if any.
+func CloseLogger() error {
+	api.SetLogger(nil)
+	if logFile == nil {
+		return nil
+	}
+
+	err := logFile.Close()
+	logFile = nil
+
+	return err
+}
@@ -0,0 +1,99 @@
+package spotlike
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+)
+
+func TestSetUpLogger(t *testing.T) {
+	tests := []struct {
+		name      string
+		verbose   bool
+		debug     bool
+		logFile   string
+		wantLevel slog.Level
+		wantLog   bool
+		wantErr   bool
+	}{
+		{
+			name:    "positive testing (no flags)",
+			wantLog: false,
+			wantErr: false,
+		},
+		{
+			name:      "positive testing (verbose)",
+			verbose:   true,
+			wantLevel: slog.LevelInfo,
+			wantLog:   true,
+			wantErr:   false,
+		},
+		{
+			name:      "positive testing (debug)",
+			debug:     true,
+			wantLevel: slog.LevelDebug,
+			wantLog:   true,
+			wantErr:   false,
+		},
+		{
+			name:      "positive testing (log file)",
+			logFile:   "spotlike.log",
+			wantLevel: slog.LevelInfo,
+			wantLog:   true,
+			wantErr:   false,
+		},
+		{
+			name:    "negative testing (log file can not be opened)",
+			logFile: filepath.Join("not_exist", "spotlike.log"),
+			wantLog: false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			GlobalOps.Verbose = tt.verbose
+			GlobalOps.Debug = tt.debug
+			GlobalOps.LogFile = ""
+			if tt.logFile != "" {
+				GlobalOps.LogFile = filepath.Join(t.TempDir(), tt.logFile)
+			}
+			defer func() {
+				GlobalOps.Verbose = false
+				GlobalOps.Debug = false
+				GlobalOps.LogFile = ""
+				if err := CloseLogger(); err != nil {
+					t.Errorf("CloseLogger() error = %v", err)
+				}
+			}()
+
+			if err := SetUpLogger(); (err != nil) != tt.wantErr {
+				t.Errorf("SetUpLogger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			ctx := context.Background()
+			if got := api.Logger().Enabled(ctx, slog.LevelError); got != tt.wantLog {
+				t.Errorf("SetUpLogger() enabled the logger = %v, want %v", got, tt.wantLog)
+			}
+			if tt.wantLog && (!api.Logger().Enabled(ctx, tt.wantLevel) || api.Logger().Enabled(ctx, tt.wantLevel-1)) {
+				t.Errorf("SetUpLogger() did not set the level to %v", tt.wantLevel)
+			}
+			if tt.logFile != "" && !tt.wantErr {
+				api.Logger().Info("test log")
+				if err := CloseLogger(); err != nil {
+					t.Errorf("CloseLogger() error = %v", err)
+				}
+				data, err := os.ReadFile(GlobalOps.LogFile)
+				if err != nil {
+					t.Fatalf("Failed to read the log file: %v", err)
+				}
+				if !strings.Contains(string(data), "test log") {
+					t.Errorf("the log file = %q, want to contain the log", string(data))
+				}
+			}
+		})
+	}
+}
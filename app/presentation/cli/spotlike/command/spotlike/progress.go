@@ -0,0 +1,27 @@
+package spotlike
+
+import (
+	"context"
+	"os"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+)
+
+// NewProgressReporter returns the progress reporter writing to stderr.
+// The progress is reported only when stderr is a terminal, the format is table and the quiet flag is not set.
+func NewProgressReporter(format string) *presenter.ProgressReporter {
+	return presenter.NewProgressReporter(
+		os.Stderr,
+		!GlobalOps.Quiet && format == "table" && presenter.IsTerminal(os.Stderr),
+	)
+}
+
+// WithProgress returns a copy of the context carrying the progress reporter called back from the repositories.
+func WithProgress(ctx context.Context, progress *presenter.ProgressReporter) context.Context {
+	if !progress.Enabled() {
+		return ctx
+	}
+
+	return api.WithProgress(ctx, progress.Report)
+}
@@ -0,0 +1,48 @@
+package spotlike
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewProgressReporter(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		quiet  bool
+	}{
+		{
+			name:   "positive testing (stderr is not a terminal)",
+			format: "table",
+			quiet:  false,
+		},
+		{
+			name:   "positive testing (json format)",
+			format: "json",
+			quiet:  false,
+		},
+		{
+			name:   "positive testing (quiet)",
+			format: "table",
+			quiet:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			GlobalOps.Quiet = tt.quiet
+			defer func() {
+				GlobalOps.Quiet = false
+			}()
+			if got := NewProgressReporter(tt.format); got.Enabled() {
+				t.Errorf("NewProgressReporter().Enabled() = %v, want false", got.Enabled())
+			}
+		})
+	}
+}
+
+func TestWithProgress(t *testing.T) {
+	ctx := context.Background()
+	if got := WithProgress(ctx, NewProgressReporter("json")); got != ctx {
+		t.Errorf("WithProgress() = %v, want the context as is since the progress is disabled", got)
+	}
+}
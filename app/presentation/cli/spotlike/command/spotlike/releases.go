@@ -0,0 +1,408 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// ReleasesOptions provides the options for the releases command.
+type ReleasesOptions struct {
+	Since     string
+	Like      string
+	NoConfirm bool
+	Format    string
+}
+
+var (
+	// releasesOps is a variable to store the releases options with the default values for injecting the dependencies in testing.
+	releasesOps = ReleasesOptions{
+		Since:     "",
+		Like:      "",
+		NoConfirm: false,
+		Format:    "table",
+	}
+	// releasesNow is a function to get the current time for injecting the dependencies in testing.
+	releasesNow = time.Now
+)
+
+const (
+	// releasesStateKey is the key of the last run of the releases command in the state.
+	releasesStateKey = "releases"
+	// releasesDateLayout is the layout of the date to specify since when the releases are shown.
+	releasesDateLayout = "2006-01-02"
+)
+
+// releaseTarget is a struct that represents a content of the releases to like.
+type releaseTarget struct {
+	contentType string
+	id          string
+	name        string
+}
+
+// NewReleasesCommand returns a new instance of the releases command.
+func NewReleasesCommand(
+	exit func(int),
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("releases")
+	cmd.SetAliases([]string{"re", "r"})
+	cmd.SetUsageTemplate(releasesUsageTemplate)
+	cmd.SetHelpTemplate(releasesHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&releasesOps.Since,
+		"since",
+		"s",
+		"",
+		"📅 show the releases since the date (e.g: \"2024-01-01\", default the last run or 30 days ago)",
+	)
+	cmd.Flags().StringVarP(
+		&releasesOps.Like,
+		"like",
+		"l",
+		"",
+		"🤍 like the releases (e.g: \"albums\", \"tracks\")",
+	)
+	cmd.Flags().BoolVarP(
+		&releasesOps.NoConfirm,
+		"no-confirm",
+		"",
+		false,
+		"🚫 do not confirm before liking the releases",
+	)
+	cmd.Flags().StringVarP(
+		&releasesOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runReleases(exit, cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runReleases runs the releases command.
+func runReleases(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if releasesOps.Like != "" && releasesOps.Like != "albums" && releasesOps.Like != "tracks" {
+		o := formatter.Yellow("⚡ Invalid like option... (e.g: \"albums\", \"tracks\")")
+		*output = o
+		return nil
+	}
+
+	startedAt := releasesNow()
+	releaseState := state.NewState(GlobalOps.DataDir)
+	since, err := releasesSince(releaseState, startedAt)
+	if err != nil {
+		o := formatter.Yellow("⚡ Invalid since date... (e.g: \"2024-01-01\")")
+		*output = o
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	w := MessageWriter(releasesOps.Format)
+	progress := NewProgressReporter(releasesOps.Format)
+	gfauc := spotlikeApp.NewGetFollowedArtistsUseCase(NewArtistRepository())
+	gfaucoDtos, err := gfauc.Run(WithProgress(cmd.Context(), progress))
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	// the discographies are looked up without the cache, so that the releases are found as soon as they come out
+	albumRepo := repository.NewAlbumRepository()
+	gaaAuc := spotlikeApp.NewGetAllAlbumsByArtistIdUseCase(albumRepo)
+	var releases []*spotlikeApp.GetAlbumUseCaseOutputDto
+	found := map[string]bool{}
+	for i, gfaucoDto := range gfaucoDtos {
+		progress.Report("artists checked", i, len(gfaucoDtos))
+		gaaAucoDtos, err := gaaAuc.Run(cmd.Context(), gfaucoDto.ID)
+		if err != nil {
+			progress.Done()
+			return err
+		}
+		for _, gaaAucoDto := range gaaAucoDtos {
+			// the album released by the followed artists together is shown only once
+			if gaaAucoDto.ReleaseDate.Before(since) || found[gaaAucoDto.ID] {
+				continue
+			}
+			found[gaaAucoDto.ID] = true
+			releases = append(
+				releases,
+				&spotlikeApp.GetAlbumUseCaseOutputDto{
+					ID:          gaaAucoDto.ID,
+					Name:        gaaAucoDto.Name,
+					Artists:     gaaAucoDto.Artists,
+					ReleaseDate: gaaAucoDto.ReleaseDate,
+				},
+			)
+		}
+	}
+	progress.Done()
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].ReleaseDate.Before(releases[j].ReleaseDate)
+	})
+
+	if len(releases) == 0 {
+		if err := saveReleasesLastRun(cmd, releaseState, startedAt); err != nil {
+			return err
+		}
+		o := formatter.Yellow("⚡ No new releases found since " + since.Format(releasesDateLayout) + "...")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(releasesOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(releases)
+	if err != nil {
+		return err
+	}
+	if releasesOps.Like == "" {
+		*output = "\n" + o
+		return saveReleasesLastRun(cmd, releaseState, startedAt)
+	}
+	if err := presenter.Print(w, "\n"+o); err != nil {
+		return err
+	}
+
+	contentType := strings.TrimSuffix(releasesOps.Like, "s")
+	var targets []*releaseTarget
+	if contentType == "album" {
+		for _, release := range releases {
+			targets = append(targets, &releaseTarget{contentType: "album", id: release.ID, name: release.Name})
+		}
+	} else {
+		gatAuc := spotlikeApp.NewGetAllTracksByAlbumIdUseCase(NewTrackRepository())
+		for _, release := range releases {
+			gatAucoDtos, err := gatAuc.Run(cmd.Context(), release.ID)
+			if err != nil {
+				return err
+			}
+			for _, gatAucoDto := range gatAucoDtos {
+				targets = append(targets, &releaseTarget{contentType: "track", id: gatAucoDto.ID, name: gatAucoDto.Name})
+			}
+		}
+	}
+
+	var results []*spotlikeApp.OperationResultDto
+	var likeTargets []*releaseTarget
+	for _, target := range targets {
+		alreadyLiked, err := checkLike(cmd.Context(), target.contentType, target.id)
+		if err != nil {
+			if !GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(target.contentType, target.id, target.name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if alreadyLiked {
+			if err := presenter.Print(w, formatter.Blue("⏩ The "+target.contentType+" "+target.name+" ("+target.id+") is already liked. skipping...")); err != nil {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(target.contentType, target.id, target.name, "like", spotlikeApp.OperationResultStatusSkipped, nil))
+			continue
+		}
+
+		likeTargets = append(likeTargets, target)
+	}
+
+	if len(likeTargets) != 0 && !releasesOps.NoConfirm && !GlobalOps.DryRun {
+		if answer, err := presenter.RunPrompt(
+			"Proceed with liking " + fmt.Sprint(len(likeTargets)) + " " + releasesOps.Like + " of the releases above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled liking...")); err != nil {
+				return err
+			}
+			exit(ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled liking the releases...")
+			*output = o
+			SetExitCode(ExitCodeCanceled)
+			return nil
+		}
+	}
+
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(GlobalOps.DataDir), repository.NewUserRepository())
+	liked := 0
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, target := range likeTargets {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled liking the remaining "+releasesOps.Like+"...")); err != nil {
+				return err
+			}
+			for _, remaining := range likeTargets[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto(remaining.contentType, remaining.id, remaining.name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		progress.Report(releasesOps.Like+" liked", i, len(likeTargets))
+		if GlobalOps.DryRun {
+			liked++
+			results = append(results, spotlikeApp.NewOperationResultDto(target.contentType, target.id, target.name, "like", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if err := ApplyAction(ctx, "like", target.contentType, target.id); err != nil {
+			if !GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(target.contentType, target.id, target.name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        target.contentType,
+				ID:          target.id,
+				Name:        target.name,
+				Action:      "like",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
+			return err
+		}
+
+		liked++
+		results = append(results, spotlikeApp.NewOperationResultDto(target.contentType, target.id, target.name, "like", spotlikeApp.OperationResultStatusLiked, nil))
+	}
+	progress.Done()
+
+	o, err = f.Format(results)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	if failed := FailedResultsMessage(results); failed != "" {
+		if releasesOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if liked != 0 {
+		message := formatter.Green("✅🤍🆕 Successfully liked " + releasesOps.Like + " of the releases below!")
+		if GlobalOps.DryRun {
+			message = formatter.Yellow("🧪🤍🆕 " + strings.ToUpper(releasesOps.Like[:1]) + releasesOps.Like[1:] + " of the releases below would be liked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Context().Err() != nil {
+		SetExitCode(ExitCodeCanceled)
+		return nil
+	}
+	SetExitCode(ResultsExitCode(results))
+
+	return saveReleasesLastRun(cmd, releaseState, startedAt)
+}
+
+// releasesSince returns the date since when the releases are shown.
+// It is the date specified with the since flag, the date of the last run, or the date 30 days ago in this order.
+func releasesSince(releaseState state.State, now time.Time) (time.Time, error) {
+	if releasesOps.Since != "" {
+		return time.Parse(releasesDateLayout, releasesOps.Since)
+	}
+
+	return sinceLastRun(releaseState, releasesStateKey, now)
+}
+
+// saveReleasesLastRun saves the time the releases command started as the last run unless it is a dry run or interrupted.
+func saveReleasesLastRun(cmd *c.Command, releaseState state.State, startedAt time.Time) error {
+	if GlobalOps.DryRun || cmd.Context().Err() != nil {
+		return nil
+	}
+
+	return releaseState.SaveLastRun(releasesStateKey, startedAt)
+}
+
+const (
+	// releasesHelpTemplate is the help template of the releases command.
+	releasesHelpTemplate = `🆕 Browse new releases from the followed artists and like them.
+
+You can browse the albums released by the artists you follow on Spotify.
+The releases since the last run are shown by default, or the releases in the last 30 days at the first run.
+Also, you can show the releases since the date by specifying the "-s" or "--since" option.
+The last run is stored in the data directory, and it is not updated with the dry run.
+
+Also, you can like the albums or all tracks of them by specifying the "-l" or "--like" option.
+Before liking, you would be asked to confirm once with the summary.
+The contents already liked would be skipped.
+
+` + releasesUsageTemplate
+	// releasesUsageTemplate is the usage template of the releases command.
+	releasesUsageTemplate = `Usage:
+  spotlike releases [flags]
+  spotlike re       [flags]
+  spotlike r        [flags]
+
+Flags:
+  -s, --since   📅 show the releases since the date (e.g: "2024-01-01", default the last run or 30 days ago)
+  -l, --like    🤍 like the releases (e.g: "albums", "tracks")
+  --no-confirm  🚫 do not confirm before liking the releases
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for releases
+
+Global Flags:
+  --dry-run     🧪 show the plan of like and unlike without executing it
+  --keep-going  🏃 keep liking and unliking the remaining items even if some of them failed
+  -q, --quiet   🤫 do not show the progress of long running operations
+`
+)
@@ -0,0 +1,524 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewReleasesCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		exit    func(int)
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				exit:  exit,
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewReleasesCommand(tt.args.exit, tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewReleasesCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the releases command : %v", err)
+				}
+				SetExitCode(ExitCodeOk)
+			}
+		})
+	}
+}
+
+func Test_runReleases(t *testing.T) {
+	output := ""
+	exit := func(code int) {
+		SetExitCode(code)
+	}
+	origReleasesOps := releasesOps
+	origReleasesNow := releasesNow
+	origGlobalOps := GlobalOps
+	origPu := presenter.Pu
+	origNewFormatter := formatter.NewFormatter
+	su := utility.NewStringsUtil()
+	now := time.Date(2000, 1, 20, 12, 0, 0, 0, time.UTC)
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	expectReleases := func(mockSpotifyClient *proxy.MockClient) {
+		mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(
+			&spotify.FullArtistCursorPage{
+				Artists: []spotify.FullArtist{
+					{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id_1",
+							Name: "test_artist_name_1",
+						},
+					},
+					{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id_2",
+							Name: "test_artist_name_2",
+						},
+					},
+				},
+			},
+			nil,
+		)
+		albums := &spotify.SimpleAlbumPage{
+			Albums: []spotify.SimpleAlbum{
+				{
+					ID:   "test_album_id_1",
+					Name: "test_album_name_1",
+					Artists: []spotify.SimpleArtist{
+						{
+							ID:   "test_artist_id_1",
+							Name: "test_artist_name_1",
+						},
+					},
+					ReleaseDate:          "2000-01-10",
+					ReleaseDatePrecision: "day",
+				},
+				{
+					ID:   "test_album_id_2",
+					Name: "test_album_name_2",
+					Artists: []spotify.SimpleArtist{
+						{
+							ID:   "test_artist_id_1",
+							Name: "test_artist_name_1",
+						},
+					},
+					ReleaseDate:          "1999-01-01",
+					ReleaseDatePrecision: "day",
+				},
+			},
+		}
+		mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id_1"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+		mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id_2"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+	}
+	seedCache := func(mockCtrl *gomock.Controller) {
+		GlobalOps.CacheDir = t.TempDir()
+		GlobalOps.NoCache = false
+		GlobalOps.CacheTTL = 24
+		mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+		mockAlbumRepo.EXPECT().FindByArtistId(gomock.Any(), gomock.Any()).Return([]*albumDomain.Album{}, nil).Times(2)
+		cachedAlbumRepo := repository.NewCachedAlbumRepository(mockAlbumRepo, cache.NewCache(GlobalOps.CacheDir), 24*time.Hour)
+		for _, id := range []spotify.ID{"test_artist_id_1", "test_artist_id_2"} {
+			if _, err := cachedAlbumRepo.FindByArtistId(context.Background(), id); err != nil {
+				t.Errorf("Failed to seed the cache: %v", err)
+			}
+		}
+	}
+	setPrompt := func(mockCtrl *gomock.Controller, label string, answer string, err error) {
+		mockPrompt := proxy.NewMockPrompt(mockCtrl)
+		mockPrompt.EXPECT().SetLabel(label)
+		mockPrompt.EXPECT().Run().Return(answer, err)
+		mockPromptui := proxy.NewMockPromptui(mockCtrl)
+		mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+		presenter.Pu = utility.NewPromptUtil(mockPromptui)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		releasesOps = origReleasesOps
+		releasesNow = origReleasesNow
+		GlobalOps = origGlobalOps
+		presenter.Pu = origPu
+		formatter.NewFormatter = origNewFormatter
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		wantLastRun  time.Time
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (show the releases since 30 days ago)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_1]Album:test_album_name_1releasedat2000-01-10bytest_artist_name_1",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (the discographies are not read from the cache)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_1]Album:test_album_name_1releasedat2000-01-10bytest_artist_name_1",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Format = "plain"
+				seedCache(mockCtrl)
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (show the releases since the date)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_2]Album:test_album_name_2releasedat1999-01-01bytest_artist_name_1[test_album_id_1]Album:test_album_name_1releasedat2000-01-10bytest_artist_name_1",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Since = "1999-01-01"
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (show the releases since the last run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Nonewreleasesfoundsince2000-01-15..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				if err := state.NewState(GlobalOps.DataDir).SaveLastRun(releasesStateKey, time.Date(2000, 1, 15, 12, 0, 0, 0, time.UTC)); err != nil {
+					t.Errorf("Failed to save the last run: %v", err)
+				}
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (like albums)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_1]likealbum:test_album_name_1=>liked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Like = "albums"
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectReleases(mockSpotifyClient)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with liking 1 albums of the releases above ? [y/N]", "y", nil)
+			},
+		},
+		{
+			name: "positive testing (like tracks without confirming)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_track_id_2]liketrack:test_track_name_2=>skipped[test_track_id_1]liketrack:test_track_name_1=>liked",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Like = "tracks"
+				releasesOps.NoConfirm = true
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectReleases(mockSpotifyClient)
+				mockSpotifyClient.EXPECT().GetAlbum(gomock.Any(), spotify.ID("test_album_id_1")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:                   "test_album_id_1",
+							Name:                 "test_album_name_1",
+							ReleaseDate:          "2000-01-10",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(gomock.Any(), spotify.ID("test_album_id_1")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:          "test_track_id_1",
+								Name:        "test_track_name_1",
+								TrackNumber: 1,
+							},
+							{
+								ID:          "test_track_id_2",
+								Name:        "test_track_name_2",
+								TrackNumber: 2,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id_2")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(gomock.Any(), spotify.ID("test_track_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_album_id_1]likealbum:test_album_name_1=>planned",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  time.Time{},
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DryRun = true
+				releasesOps.Like = "albums"
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (like option is invalid)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Invalidlikeoption...(e.g:\"albums\",\"tracks\")"),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  time.Time{},
+			setup: func(_ *gomock.Controller) {
+				releasesOps.Like = "artists"
+			},
+		},
+		{
+			name: "negative testing (since date is invalid)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Invalidsincedate...(e.g:\"2024-01-01\")"),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  time.Time{},
+			setup: func(_ *gomock.Controller) {
+				releasesOps.Since = "2000/01/01"
+			},
+		},
+		{
+			name: "negative testing (failed to get the followed artists)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  time.Time{},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get the followed artists"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (liking cancelled)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("🚫Cancelledlikingthereleases..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			wantLastRun:  time.Time{},
+			setup: func(mockCtrl *gomock.Controller) {
+				releasesOps.Like = "albums"
+				releasesOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with liking 1 albums of the releases above ? [y/N]", "n", nil)
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Red("❌Failedtocreateaformatter..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  time.Time{},
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectReleases(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("invalid format")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			GlobalOps.DataDir = t.TempDir()
+			releasesNow = func() time.Time { return now }
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runReleases(exit, tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runReleases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runReleases() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runReleases() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+			lastRun, err := state.NewState(GlobalOps.DataDir).LastRun(releasesStateKey)
+			if err != nil {
+				t.Errorf("Failed to get the last run: %v", err)
+			}
+			if !lastRun.Equal(tt.wantLastRun) {
+				t.Errorf("runReleases() last run = %v, want %v", lastRun, tt.wantLastRun)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package spotlike
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+)
+
+// MessageWriter returns the writer to print the messages of like and unlike commands.
+// The messages are printed to stderr with the json format not to break the report printed to stdout.
+func MessageWriter(format string) io.Writer {
+	if format == "json" {
+		return os.Stderr
+	}
+
+	return os.Stdout
+}
+
+// LoadFailedIds returns the IDs of the contents failed to be liked or unliked in the report saved with the json format.
+func LoadFailedIds(path string, contentType string, action string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*spotlikeApp.OperationResultDto
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, result := range results {
+		if result.Status == spotlikeApp.OperationResultStatusFailed &&
+			result.Type == contentType &&
+			result.Action == action {
+			ids = append(ids, result.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+// FailedResultsMessage returns the message listing the contents failed to be liked or unliked, or an empty string if there is no failure.
+func FailedResultsMessage(results []*spotlikeApp.OperationResultDto) string {
+	var action string
+	var lines []string
+	for _, result := range results {
+		if result.Status != spotlikeApp.OperationResultStatusFailed {
+			continue
+		}
+		line := "  " + result.Type + " " + result.ID
+		if result.Name != "" {
+			line = "  " + result.Type + " " + result.Name + " (" + result.ID + ")"
+		}
+		action = result.Action
+		lines = append(lines, line+" : "+result.Error)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return formatter.Red("❌ Failed to "+action+" "+fmt.Sprint(len(lines))+" contents below...") + "\n" + strings.Join(lines, "\n")
+}
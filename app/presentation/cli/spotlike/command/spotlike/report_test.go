@@ -0,0 +1,138 @@
+package spotlike
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+)
+
+func TestMessageWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		want   *os.File
+	}{
+		{
+			name:   "positive testing (table)",
+			format: "table",
+			want:   os.Stdout,
+		},
+		{
+			name:   "positive testing (json)",
+			format: "json",
+			want:   os.Stderr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MessageWriter(tt.format); got != tt.want {
+				t.Errorf("MessageWriter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFailedIds(t *testing.T) {
+	type args struct {
+		contentType string
+		action      string
+	}
+	tests := []struct {
+		name    string
+		report  string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "positive testing",
+			report: `[
+  {"type": "album", "id": "test_album_id_1", "name": "test_album_name_1", "action": "like", "status": "failed", "error": "test error"},
+  {"type": "album", "id": "test_album_id_2", "name": "test_album_name_2", "action": "like", "status": "liked"},
+  {"type": "album", "id": "test_album_id_3", "name": "test_album_name_3", "action": "unlike", "status": "failed", "error": "test error"},
+  {"type": "track", "id": "test_track_id", "name": "test_track_name", "action": "like", "status": "failed", "error": "test error"}
+]`,
+			args:    args{contentType: "album", action: "like"},
+			want:    []string{"test_album_id_1"},
+			wantErr: false,
+		},
+		{
+			name:    "positive testing (no failed contents)",
+			report:  `[{"type": "album", "id": "test_album_id", "name": "test_album_name", "action": "like", "status": "liked"}]`,
+			args:    args{contentType: "album", action: "like"},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "negative testing (report does not exist)",
+			report:  "",
+			args:    args{contentType: "album", action: "like"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "negative testing (report is not in the json format)",
+			report:  "test_album_id like album : test_album_name => failed",
+			args:    args{contentType: "album", action: "like"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report.json")
+			if tt.report != "" {
+				if err := os.WriteFile(path, []byte(tt.report), 0600); err != nil {
+					t.Fatalf("Failed to create a report: %v", err)
+				}
+			}
+			got, err := LoadFailedIds(path, tt.args.contentType, tt.args.action)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadFailedIds() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadFailedIds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailedResultsMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*spotlikeApp.OperationResultDto
+		want    string
+	}{
+		{
+			name: "positive testing",
+			results: []*spotlikeApp.OperationResultDto{
+				spotlikeApp.NewOperationResultDto("track", "test_track_id_1", "test_track_name_1", "like", spotlikeApp.OperationResultStatusLiked, nil),
+				spotlikeApp.NewOperationResultDto("track", "test_track_id_2", "test_track_name_2", "like", spotlikeApp.OperationResultStatusFailed, errors.New("test error")),
+				spotlikeApp.NewOperationResultDto("track", "test_track_id_3", "", "like", spotlikeApp.OperationResultStatusFailed, errors.New("test error")),
+			},
+			want: formatter.Red("❌ Failed to like 2 contents below...") + "\n" +
+				"  track test_track_name_2 (test_track_id_2) : test error\n" +
+				"  track test_track_id_3 : test error",
+		},
+		{
+			name: "positive testing (no failed contents)",
+			results: []*spotlikeApp.OperationResultDto{
+				spotlikeApp.NewOperationResultDto("track", "test_track_id", "test_track_name", "like", spotlikeApp.OperationResultStatusLiked, nil),
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FailedResultsMessage(tt.results); got != tt.want {
+				t.Errorf("FailedResultsMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package spotlike
+
+import (
+	"time"
+
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
+	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+)
+
+// NewAlbumRepository returns a new album repository which caches the catalog lookups unless the cache is disabled.
+func NewAlbumRepository() albumDomain.AlbumRepository {
+	repo := repository.NewAlbumRepository()
+	if dir := cacheDir(); dir != "" && cacheTTL() > 0 {
+		return repository.NewCachedAlbumRepository(repo, cache.NewCache(dir), cacheTTL())
+	}
+
+	return repo
+}
+
+// NewArtistRepository returns a new artist repository which caches the catalog lookups unless the cache is disabled.
+func NewArtistRepository() artistDomain.ArtistRepository {
+	repo := repository.NewArtistRepository()
+	if dir := cacheDir(); dir != "" && cacheTTL() > 0 {
+		return repository.NewCachedArtistRepository(repo, cache.NewCache(dir), cacheTTL())
+	}
+
+	return repo
+}
+
+// NewTrackRepository returns a new track repository which caches the catalog lookups unless the cache is disabled.
+func NewTrackRepository() trackDomain.TrackRepository {
+	repo := repository.NewTrackRepository()
+	if dir := cacheDir(); dir != "" && cacheTTL() > 0 {
+		return repository.NewCachedTrackRepository(repo, cache.NewCache(dir), cacheTTL())
+	}
+
+	return repo
+}
+
+// NewSearchRepository returns a new search repository which caches the searches across the types unless the cache is disabled.
+func NewSearchRepository() searchDomain.SearchRepository {
+	repo := repository.NewSearchRepository()
+	if dir := cacheDir(); dir != "" && cacheTTL() > 0 {
+		return repository.NewCachedSearchRepository(repo, cache.NewCache(dir), cacheTTL())
+	}
+
+	return repo
+}
+
+// cacheTTL returns the time to live of the cache of the catalog lookups.
+func cacheTTL() time.Duration {
+	return time.Duration(GlobalOps.CacheTTL) * time.Hour
+}
@@ -0,0 +1,80 @@
+package spotlike
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewRepository(t *testing.T) {
+	origGlobalOps := GlobalOps
+
+	tests := []struct {
+		name       string
+		cacheDir   string
+		noCache    bool
+		cacheTTL   int
+		wantCached bool
+	}{
+		{
+			name:       "positive testing (cache is enabled)",
+			cacheDir:   "test_cache_dir",
+			noCache:    false,
+			cacheTTL:   24,
+			wantCached: true,
+		},
+		{
+			name:       "positive testing (no cache is specified)",
+			cacheDir:   "test_cache_dir",
+			noCache:    true,
+			cacheTTL:   24,
+			wantCached: false,
+		},
+		{
+			name:       "positive testing (cache ttl is zero)",
+			cacheDir:   "test_cache_dir",
+			noCache:    false,
+			cacheTTL:   0,
+			wantCached: false,
+		},
+		{
+			name:       "positive testing (cache dir is empty)",
+			cacheDir:   "",
+			noCache:    false,
+			cacheTTL:   24,
+			wantCached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			GlobalOps.CacheDir = tt.cacheDir
+			GlobalOps.NoCache = tt.noCache
+			GlobalOps.CacheTTL = tt.cacheTTL
+			defer func() {
+				GlobalOps = origGlobalOps
+			}()
+			want := map[string]string{
+				"album":  "*repository.albumRepository",
+				"artist": "*repository.artistRepository",
+				"search": "*repository.searchRepository",
+				"track":  "*repository.trackRepository",
+			}
+			if tt.wantCached {
+				want = map[string]string{
+					"album":  "*repository.cachedAlbumRepository",
+					"artist": "*repository.cachedArtistRepository",
+					"search": "*repository.cachedSearchRepository",
+					"track":  "*repository.cachedTrackRepository",
+				}
+			}
+			got := map[string]string{
+				"album":  reflect.TypeOf(NewAlbumRepository()).String(),
+				"artist": reflect.TypeOf(NewArtistRepository()).String(),
+				"search": reflect.TypeOf(NewSearchRepository()).String(),
+				"track":  reflect.TypeOf(NewTrackRepository()).String(),
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("New*Repository() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -1,36 +1,56 @@
 package spotlike
 
 import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
 	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
 )
 
 // SearchOptions provides the options for the search command.
 type SearchOptions struct {
-	Artist bool
-	Album  bool
-	Track  bool
-	Max    int
-	Format string
+	Artist      bool
+	Album       bool
+	Track       bool
+	Max         int
+	Format      string
+	Interactive bool
+	ShowLiked   bool
 }
 
 var (
 	// searchOps is a variable to store the search options with the default values for injecting the dependencies in testing.
 	searchOps = SearchOptions{
-		Artist: false,
-		Album:  false,
-		Track:  false,
-		Max:    10,
-		Format: "table",
+		Artist:      false,
+		Album:       false,
+		Track:       false,
+		Max:         10,
+		Format:      "table",
+		Interactive: false,
+		ShowLiked:   false,
 	}
 )
 
+// searchItem is an item to be selected in the interactive search.
+type searchItem struct {
+	contentType string
+	id          string
+	name        string
+	label       string
+}
+
 // NewSearchCommand returns a new instance of the search command.
 func NewSearchCommand(
 	cobra proxy.Cobra,
@@ -76,7 +96,21 @@ func NewSearchCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.Flags().BoolVarP(
+		&searchOps.Interactive,
+		"interactive",
+		"i",
+		false,
+		"👆 select the search results to like or unlike interactively",
+	)
+	cmd.Flags().BoolVarP(
+		&searchOps.ShowLiked,
+		"show-liked",
+		"",
+		false,
+		"🤍 show whether each of the search results is liked",
 	)
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
@@ -101,14 +135,6 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 		return nil
 	}
 
-	if searchOps.Artist && searchOps.Album ||
-		searchOps.Artist && searchOps.Track ||
-		searchOps.Album && searchOps.Track {
-		o := formatter.Yellow("⚡ Multiple search types is not supported...")
-		*output = o
-		return nil
-	}
-
 	if searchOps.Max < 1 {
 		o := formatter.Yellow("⚡ Invalid search result number...")
 		*output = o
@@ -122,15 +148,59 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
 	}
 
-	var dtos any
+	var searchType spotify.SearchType
+	var searchTypeCount int
 	if searchOps.Artist {
-		artistRepo := repository.NewArtistRepository()
+		searchType |= spotify.SearchTypeArtist
+		searchTypeCount++
+	}
+	if searchOps.Album {
+		searchType |= spotify.SearchTypeAlbum
+		searchTypeCount++
+	}
+	if searchOps.Track {
+		searchType |= spotify.SearchTypeTrack
+		searchTypeCount++
+	}
+
+	var dtos any
+	var items []*searchItem
+	var artists []*spotlikeApp.SearchArtistUseCaseOutputDto
+	var albums []*spotlikeApp.SearchAlbumUseCaseOutputDto
+	var tracks []*spotlikeApp.SearchTrackUseCaseOutputDto
+	if searchTypeCount > 1 {
+		searchRepo := NewSearchRepository()
+		suc := spotlikeApp.NewSearchUseCase(searchRepo)
+		soDto, err := suc.Run(cmd.Context(), args, searchType, searchOps.Max)
+		if err != nil {
+			return err
+		}
+
+		if len(soDto.Artists) == 0 && len(soDto.Albums) == 0 && len(soDto.Tracks) == 0 {
+			o := formatter.Yellow("⚡ No results found...")
+			*output = o
+			SetExitCode(ExitCodeNotFound)
+			return nil
+		}
+		dtos = soDto
+		artists, albums, tracks = soDto.Artists, soDto.Albums, soDto.Tracks
+		for _, sAoDto := range soDto.Artists {
+			items = append(items, newArtistSearchItem(sAoDto))
+		}
+		for _, saoDto := range soDto.Albums {
+			items = append(items, newAlbumSearchItem(saoDto))
+		}
+		for _, stoDto := range soDto.Tracks {
+			items = append(items, newTrackSearchItem(stoDto))
+		}
+	} else if searchOps.Artist {
+		artistRepo := NewArtistRepository()
 		sAuc := spotlikeApp.NewSearchArtistUseCase(artistRepo)
 		sAoDtos, err := sAuc.Run(cmd.Context(), args, searchOps.Max)
 		if err != nil {
@@ -140,13 +210,16 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 		if len(sAoDtos) == 0 {
 			o := formatter.Yellow("⚡ No artists found...")
 			*output = o
+			SetExitCode(ExitCodeNotFound)
 			return nil
 		}
 		dtos = sAoDtos
-	}
-
-	if searchOps.Album {
-		albumRepo := repository.NewAlbumRepository()
+		artists = sAoDtos
+		for _, sAoDto := range sAoDtos {
+			items = append(items, newArtistSearchItem(sAoDto))
+		}
+	} else if searchOps.Album {
+		albumRepo := NewAlbumRepository()
 		sauc := spotlikeApp.NewSearchAlbumUseCase(albumRepo)
 		saoDtos, err := sauc.Run(cmd.Context(), args, searchOps.Max)
 		if err != nil {
@@ -156,13 +229,16 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 		if len(saoDtos) == 0 {
 			o := formatter.Yellow("⚡ No albums found...")
 			*output = o
+			SetExitCode(ExitCodeNotFound)
 			return nil
 		}
 		dtos = saoDtos
-	}
-
-	if searchOps.Track {
-		trackRepo := repository.NewTrackRepository()
+		albums = saoDtos
+		for _, saoDto := range saoDtos {
+			items = append(items, newAlbumSearchItem(saoDto))
+		}
+	} else if searchOps.Track {
+		trackRepo := NewTrackRepository()
 		stuc := spotlikeApp.NewSearchTrackUseCase(trackRepo)
 		stoDtos, err := stuc.Run(cmd.Context(), args, searchOps.Max)
 		if err != nil {
@@ -172,9 +248,24 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 		if len(stoDtos) == 0 {
 			o := formatter.Yellow("⚡ No tracks found...")
 			*output = o
+			SetExitCode(ExitCodeNotFound)
 			return nil
 		}
 		dtos = stoDtos
+		tracks = stoDtos
+		for _, stoDto := range stoDtos {
+			items = append(items, newTrackSearchItem(stoDto))
+		}
+	}
+
+	if searchOps.Interactive {
+		return runSearchInteractive(cmd, output, items)
+	}
+
+	if searchOps.ShowLiked {
+		if err := showSearchLiked(cmd.Context(), artists, albums, tracks); err != nil {
+			return err
+		}
 	}
 
 	f, err := formatter.NewFormatter(searchOps.Format)
@@ -192,6 +283,231 @@ func runSearch(cmd *c.Command, authCmd proxy.Command, output *string, args []str
 	return nil
 }
 
+// showSearchLiked sets whether each of the search results is liked checking them at once for each type.
+func showSearchLiked(
+	ctx context.Context,
+	artists []*spotlikeApp.SearchArtistUseCaseOutputDto,
+	albums []*spotlikeApp.SearchAlbumUseCaseOutputDto,
+	tracks []*spotlikeApp.SearchTrackUseCaseOutputDto,
+) error {
+	contents := []struct {
+		contentType string
+		ids         []string
+		set         func(i int, liked bool)
+	}{
+		{contentType: "artist", set: func(i int, liked bool) { artists[i].Liked = &liked }},
+		{contentType: "album", set: func(i int, liked bool) { albums[i].Liked = &liked }},
+		{contentType: "track", set: func(i int, liked bool) { tracks[i].Liked = &liked }},
+	}
+	for _, artist := range artists {
+		contents[0].ids = append(contents[0].ids, artist.ID)
+	}
+	for _, album := range albums {
+		contents[1].ids = append(contents[1].ids, album.ID)
+	}
+	for _, track := range tracks {
+		contents[2].ids = append(contents[2].ids, track.ID)
+	}
+
+	for _, content := range contents {
+		if len(content.ids) == 0 {
+			continue
+		}
+		likes, err := CheckLikes(ctx, content.contentType, content.ids)
+		if err != nil {
+			return err
+		}
+		for i, liked := range likes {
+			content.set(i, liked)
+		}
+	}
+
+	return nil
+}
+
+// newArtistSearchItem returns a new search item of the artist.
+func newArtistSearchItem(dto *spotlikeApp.SearchArtistUseCaseOutputDto) *searchItem {
+	return &searchItem{
+		contentType: "artist",
+		id:          dto.ID,
+		name:        dto.Name,
+		label:       "🎤 " + dto.Name + " (" + dto.ID + ")",
+	}
+}
+
+// newAlbumSearchItem returns a new search item of the album.
+func newAlbumSearchItem(dto *spotlikeApp.SearchAlbumUseCaseOutputDto) *searchItem {
+	return &searchItem{
+		contentType: "album",
+		id:          dto.ID,
+		name:        dto.Name,
+		label:       "💿 " + dto.Name + " released by " + dto.Artists + " (" + dto.ID + ")",
+	}
+}
+
+// newTrackSearchItem returns a new search item of the track.
+func newTrackSearchItem(dto *spotlikeApp.SearchTrackUseCaseOutputDto) *searchItem {
+	return &searchItem{
+		contentType: "track",
+		id:          dto.ID,
+		name:        dto.Name,
+		label:       "🎵 " + dto.Name + " on " + dto.Album + " released by " + dto.Artists + " (" + dto.ID + ")",
+	}
+}
+
+// runSearchInteractive lets the user select the search results and likes or unlikes the selected items.
+func runSearchInteractive(cmd *c.Command, output *string, items []*searchItem) error {
+	// the items are checked whether they are liked at once for each type
+	liked := make([]bool, len(items))
+	var contentTypes []string
+	indexesByType := map[string][]int{}
+	for i, item := range items {
+		if _, ok := indexesByType[item.contentType]; !ok {
+			contentTypes = append(contentTypes, item.contentType)
+		}
+		indexesByType[item.contentType] = append(indexesByType[item.contentType], i)
+	}
+	for _, contentType := range contentTypes {
+		var ids []string
+		for _, i := range indexesByType[contentType] {
+			ids = append(ids, items[i].id)
+		}
+		likes, err := CheckLikes(cmd.Context(), contentType, ids)
+		if err != nil {
+			return err
+		}
+		for j, i := range indexesByType[contentType] {
+			liked[i] = likes[j]
+		}
+	}
+
+	var labels []string
+	for i, item := range items {
+		label := "🤍 " + item.label
+		if liked[i] {
+			label = "💚 " + item.label
+		}
+		labels = append(labels, label)
+	}
+
+	indexes, err := presenter.RunMultiSelect("Select items to like (💚 liked items would be unliked)", labels)
+	if err != nil && errors.Is(err, api.ErrCanceled) {
+		o := formatter.Yellow("🚫 Cancelled selecting...")
+		*output = o
+		SetExitCode(ExitCodeCanceled)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		o := formatter.Yellow("⚡ No items selected...")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	w := MessageWriter(searchOps.Format)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(GlobalOps.DataDir), repository.NewUserRepository())
+	var results []*spotlikeApp.OperationResultDto
+	var applied int
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, index := range indexes {
+		item := items[index]
+		action := searchAction(liked[index])
+		if cmd.Context().Err() != nil {
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled liking or unliking the remaining items...")); err != nil {
+				return err
+			}
+			for _, remaining := range indexes[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto(items[remaining].contentType, items[remaining].id, items[remaining].name, searchAction(liked[remaining]), spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+
+		if GlobalOps.DryRun {
+			applied++
+			results = append(results, spotlikeApp.NewOperationResultDto(item.contentType, item.id, item.name, action, spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if err := ApplyAction(ctx, action, item.contentType, item.id); err != nil {
+			if !GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(item.contentType, item.id, item.name, action, spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        item.contentType,
+				ID:          item.id,
+				Name:        item.name,
+				Action:      action,
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
+			return err
+		}
+
+		applied++
+		status := spotlikeApp.OperationResultStatusLiked
+		if action == "unlike" {
+			status = spotlikeApp.OperationResultStatusUnliked
+		}
+		results = append(results, spotlikeApp.NewOperationResultDto(item.contentType, item.id, item.name, action, status, nil))
+	}
+
+	f, err := formatter.NewFormatter(searchOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(results)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	if failed := FailedResultsMessage(results); failed != "" {
+		if searchOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if applied != 0 {
+		message := formatter.Green("✅🔍 Successfully liked or unliked the selected items below!")
+		if GlobalOps.DryRun {
+			message = formatter.Yellow("🧪🔍 Selected items below would be liked or unliked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Context().Err() != nil {
+		SetExitCode(ExitCodeCanceled)
+		return nil
+	}
+	SetExitCode(ResultsExitCode(results))
+
+	return nil
+}
+
+// searchAction returns the action for the selected item, which unlikes the item already liked.
+func searchAction(liked bool) string {
+	if liked {
+		return "unlike"
+	}
+
+	return "like"
+}
+
 const (
 	// searchHelpTemplate is the help template of the search command.
 	searchHelpTemplate = `🔍 Search for the ID of content in Spotify.
@@ -201,9 +517,16 @@ You can search for artists, albums, and tracks by specifying the type of content
 If you want to search artists, specify the "-A" or "--artist" option.
 If you want to search albums, specify the "-a" or "--album" option.
 If you want to search tracks, specify the "-t" or "--track" option.
+You can specify multiple types at once, and the results are shown in the sections of each type.
 
 Also, you can specify the maximum number of search results by specifying the "-m" or "--max" option.
 
+If you specify the "-i" or "--interactive" option, you can select the search results to like in the list.
+The liked results are marked with 💚, and they would be unliked if you select them.
+The results of liking or unliking the selected items are shown in the format of the output.
+
+If you specify the "--show-liked" option, whether each of the search results is liked is shown in the output.
+
 ` + searchUsageTemplate
 	// searchUsageTemplate is the usage template of the search command.
 	searchUsageTemplate = `Usage:
@@ -212,12 +535,17 @@ Also, you can specify the maximum number of search results by specifying the "-m
   spotlike s      [flags] [arguments]
 
 Flags:
-  -A, --artist  🎤 search for artists
-  -a, --album   💿 search for albums
-  -t, --track   🎵 search for tracks
-  -m, --max     🔢 maximum number of search results (default 10)
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
-  -h, --help    🤝 help for search
+  -A, --artist       🎤 search for artists
+  -a, --album        💿 search for albums
+  -t, --track        🎵 search for tracks
+  -m, --max          🔢 maximum number of search results (default 10)
+  -f, --format       📝 format of the output (default "table", e.g: "plain", "json")
+  -i, --interactive  👆 select the search results to like or unlike interactively
+  --show-liked       🤍 show whether each of the search results is liked
+  -h, --help         🤝 help for search
+
+Global Flags:
+  --dry-run  🧪 show the plan of like and unlike without executing it
 
 Arguments:
   keywords  🔡 search content by keywords (multiple keywords are separated by a space)
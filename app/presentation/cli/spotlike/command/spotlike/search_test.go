@@ -14,10 +14,12 @@ import (
 	baseconfig "github.com/yanosea/spotlike/app/config"
 	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
 	artistDomain "github.com/yanosea/spotlike/app/domain/spotify/artist"
+	searchDomain "github.com/yanosea/spotlike/app/domain/spotify/search"
 	trackDomain "github.com/yanosea/spotlike/app/domain/spotify/track"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
 	"github.com/yanosea/spotlike/pkg/utility"
@@ -83,7 +85,9 @@ func Test_runSearch(t *testing.T) {
 	origNewSearchArtistUseCase := spotlikeApp.NewSearchArtistUseCase
 	origNewSearchAlbumUseCase := spotlikeApp.NewSearchAlbumUseCase
 	origNewSearchTrackUseCase := spotlikeApp.NewSearchTrackUseCase
+	origNewSearchUseCase := spotlikeApp.NewSearchUseCase
 	origNewFormatter := formatter.NewFormatter
+	origPu := presenter.Pu
 	su := utility.NewStringsUtil()
 
 	type args struct {
@@ -180,6 +184,98 @@ func Test_runSearch(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (search interactively)",
+			args: args{
+				cmd: &c.Command{},
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				args:   []string{"test", "artist"},
+				output: &output,
+			},
+			wantOutput: formatter.Yellow("⚡Noitemsselected..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				searchOps.Artist = true
+				searchOps.Interactive = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().Search(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					&spotify.SearchResult{
+						Artists: &spotify.FullArtistPage{
+							Artists: []spotify.FullArtist{
+								{
+									SimpleArtist: spotify.SimpleArtist{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", spotify.ID("test_artist_id")).Return([]bool{false}, nil)
+				mockSelect := proxy.NewMockSelect(mockCtrl)
+				mockSelect.EXPECT().SetLabel("Select items to like (💚 liked items would be unliked)")
+				mockSelect.EXPECT().SetItems([]string{"[ ] 🤍 🎤 test_artist_name (test_artist_id)", "✅ Done"})
+				mockSelect.EXPECT().SetSize(2)
+				mockSelect.EXPECT().SetCursorPos(0)
+				mockSelect.EXPECT().Run().Return(1, "✅ Done", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewSelect().Return(mockSelect)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(ctx)
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "positive testing (search for an album)",
 			args: args{
@@ -265,6 +361,180 @@ func Test_runSearch(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (search for an album with showing liked)",
+			args: args{
+				cmd: &c.Command{},
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				args:   []string{"test", "album"},
+				output: &output,
+			},
+			wantOutput: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATE🤍LIKEDtest_album_idtest_album_nametest_artist_name0000-01-01💚TOTAL:1albums!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				searchOps.Album = true
+				searchOps.ShowLiked = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().Search(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					&spotify.SearchResult{
+						Albums: &spotify.SimpleAlbumPage{
+							Albums: []spotify.SimpleAlbum{
+								{
+									ID:   "test_album_id",
+									Name: "test_album_name",
+									Artists: []spotify.SimpleArtist{
+										{
+											ID:   "test_artist_id",
+											Name: "test_artist_name",
+										},
+									},
+									ReleaseDate: "2000-01-01",
+								},
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, spotify.ID("test_album_id")).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(ctx)
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (showSearchLiked(cmd.Context(), artists, albums, tracks) failed)",
+			args: args{
+				cmd: &c.Command{},
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				args:   []string{"test", "album"},
+				output: &output,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				searchOps.Album = true
+				searchOps.ShowLiked = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().Search(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					&spotify.SearchResult{
+						Albums: &spotify.SimpleAlbumPage{
+							Albums: []spotify.SimpleAlbum{
+								{
+									ID:   "test_album_id",
+									Name: "test_album_name",
+									Artists: []spotify.SimpleArtist{
+										{
+											ID:   "test_artist_id",
+											Name: "test_artist_name",
+										},
+									},
+									ReleaseDate: "2000-01-01",
+								},
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to check the albums"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(ctx)
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
 		{
 			name: "positive testing (search for a track)",
 			args: args{
@@ -480,7 +750,7 @@ func Test_runSearch(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (searchOps.Artist && searchOps.Album || searchOps.Artist && searchOps.Track || searchOps.Album && searchOps.Track)",
+			name: "positive testing (search for multiple types)",
 			args: args{
 				cmd: &c.Command{},
 				authCmd: NewAuthCommand(
@@ -500,13 +770,173 @@ func Test_runSearch(t *testing.T) {
 				args:   []string{"test"},
 				output: &output,
 			},
-			wantOutput: formatter.Yellow("⚡Multiplesearchtypesisnotsupported..."),
+			wantOutput: "🎤Artists🆔ID🎤ARTISTtest_artist_idtest_artist_nameTOTAL:1artists!🎵Tracks🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_name0000-01-01TOTAL:1tracks!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller, tt *args) {
 				searchOps.Artist = true
 				searchOps.Album = true
 				searchOps.Track = true
 				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().Search(ctx, "test", spotify.SearchTypeArtist|spotify.SearchTypeAlbum|spotify.SearchTypeTrack, gomock.Any()).Return(
+					&spotify.SearchResult{
+						Artists: &spotify.FullArtistPage{
+							Artists: []spotify.FullArtist{
+								{
+									SimpleArtist: spotify.SimpleArtist{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+							},
+						},
+						Albums: &spotify.SimpleAlbumPage{},
+						Tracks: &spotify.FullTrackPage{
+							Tracks: []spotify.FullTrack{
+								{
+									SimpleTrack: spotify.SimpleTrack{
+										ID:          "test_track_id",
+										Name:        "test_track_name",
+										TrackNumber: 1,
+									},
+								},
+							},
+						},
+					},
+					nil,
+				)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(ctx)
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (suc.Run((cmd.Context()), args, searchType, searchOps.Max) failed)",
+			args: args{
+				cmd: &c.Command{},
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				args:   []string{"test"},
+				output: &output,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				searchOps.Artist = true
+				searchOps.Album = true
+				ctx := context.Background()
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				mockSearchRepository := searchDomain.NewMockSearchRepository(mockCtrl)
+				mockSearchRepository.EXPECT().FindByNameLimit(ctx, "test", spotify.SearchTypeArtist|spotify.SearchTypeAlbum, 10).Return(nil, errors.New("failed to find by name limit"))
+				spotlikeApp.NewSearchUseCase = func(searchDomain.SearchRepository) *spotlikeApp.SearchUseCaseStruct {
+					return origNewSearchUseCase(mockSearchRepository)
+				}
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				cmd := &c.Command{}
+				cmd.SetContext(ctx)
+				tt.cmd = cmd
+				output = ""
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				spotlikeApp.NewSearchUseCase = origNewSearchUseCase
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (no results found for multiple types)",
+			args: args{
+				cmd: &c.Command{},
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				args:   []string{"test"},
+				output: &output,
+			},
+			wantOutput: formatter.Yellow("⚡Noresultsfound..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller, tt *args) {
+				searchOps.Artist = true
+				searchOps.Album = true
+				ctx := context.Background()
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
 				mockHttp := proxy.NewMockHttp(mockCtrl)
 				mockRandstr := proxy.NewMockRandstr(mockCtrl)
@@ -517,6 +947,11 @@ func Test_runSearch(t *testing.T) {
 					mockRandstr,
 					mockUrl,
 				)
+				mockSearchRepository := searchDomain.NewMockSearchRepository(mockCtrl)
+				mockSearchRepository.EXPECT().FindByNameLimit(ctx, "test", spotify.SearchTypeArtist|spotify.SearchTypeAlbum, 10).Return(searchDomain.NewSearchResult(nil, nil, nil), nil)
+				spotlikeApp.NewSearchUseCase = func(searchDomain.SearchRepository) *spotlikeApp.SearchUseCaseStruct {
+					return origNewSearchUseCase(mockSearchRepository)
+				}
 				if err := cm.InitializeClient(
 					ctx,
 					&api.ClientConfig{
@@ -538,6 +973,7 @@ func Test_runSearch(t *testing.T) {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				searchOps = origSearchOps
+				spotlikeApp.NewSearchUseCase = origNewSearchUseCase
 				output = ""
 			},
 		},
@@ -1282,3 +1718,283 @@ func Test_runSearch(t *testing.T) {
 		})
 	}
 }
+
+func Test_runSearchInteractive(t *testing.T) {
+	output := ""
+	origSearchOps := searchOps
+	origGlobalOps := GlobalOps
+	origPu := presenter.Pu
+	su := utility.NewStringsUtil()
+	items := []*searchItem{
+		{
+			contentType: "track",
+			id:          "test_track_id",
+			name:        "test_track_name",
+			label:       "🎵 test_track_name on test_album_name released by test_artist_name (test_track_id)",
+		},
+		{
+			contentType: "artist",
+			id:          "test_artist_id",
+			name:        "test_artist_name",
+			label:       "🎤 test_artist_name (test_artist_id)",
+		},
+	}
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	setSelect := func(mockCtrl *gomock.Controller, indexes []int, err error) {
+		mockSelect := proxy.NewMockSelect(mockCtrl)
+		mockSelect.EXPECT().SetLabel(gomock.Any()).AnyTimes()
+		mockSelect.EXPECT().SetItems(gomock.Any()).AnyTimes()
+		mockSelect.EXPECT().SetSize(gomock.Any()).AnyTimes()
+		mockSelect.EXPECT().SetCursorPos(gomock.Any()).AnyTimes()
+		for _, index := range indexes {
+			mockSelect.EXPECT().Run().Return(index, "", err)
+		}
+		mockPromptui := proxy.NewMockPromptui(mockCtrl)
+		mockPromptui.EXPECT().NewSelect().Return(mockSelect).Times(len(indexes))
+		presenter.Pu = utility.NewPromptUtil(mockPromptui)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		items  []*searchItem
+	}
+	tests := []struct {
+		name         string
+		args         args
+		interrupted  bool
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput:   "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikelikedtest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:2results!",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0, 1, 2}, nil)
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput:   "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeplannedTOTAL:1results!",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DryRun = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{1, 2}, nil)
+			},
+		},
+		{
+			name: "positive testing (keep going after failed to like the item)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput:   "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikefailedfailedtoliketracktest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:2results!" + formatter.Red("❌Failedtolike1contentsbelow...") + "tracktest_track_name(test_track_id):failedtoliketrack",
+			wantErr:      false,
+			wantExitCode: ExitCodePartialFailure,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+				GlobalOps.KeepGoing = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(errors.New("failed to like track"))
+				mockSpotifyClient.EXPECT().UnfollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0, 1, 2}, nil)
+			},
+		},
+		{
+			name: "positive testing (interrupted)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			interrupted:  true,
+			wantOutput:   "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_namelikecanceledTOTAL:1results!",
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0, 2}, nil)
+			},
+		},
+		{
+			name: "negative testing (no items selected)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput:   formatter.Yellow("⚡Noitemsselected..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{2}, nil)
+			},
+		},
+		{
+			name: "negative testing (selecting cancelled with ^C)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput:   formatter.Yellow("🚫Cancelledselecting..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0}, proxy.ErrInterrupt)
+			},
+		},
+		{
+			name: "negative testing (presenter.RunMultiSelect() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0}, errors.New("failed to select"))
+			},
+		},
+		{
+			name: "negative testing (failed to check if the item is liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return(nil, errors.New("failed to check"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to like the item)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				items:  items,
+			},
+			wantOutput: "",
+			wantErr:    true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().AddTracksToLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(errors.New("failed to like track"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setSelect(mockCtrl, []int{0, 2}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				searchOps = origSearchOps
+				GlobalOps = origGlobalOps
+				presenter.Pu = origPu
+				output = ""
+				SetExitCode(ExitCodeOk)
+			}()
+			SetExitCode(ExitCodeOk)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.interrupted {
+				cancel()
+			}
+			tt.args.cmd.SetContext(ctx)
+			if err := runSearchInteractive(tt.args.cmd, tt.args.output, tt.args.items); (err != nil) != tt.wantErr {
+				t.Errorf("runSearchInteractive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runSearchInteractive() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if GetExitCode() != tt.wantExitCode {
+				t.Errorf("runSearchInteractive() exit code = %v, want %v", GetExitCode(), tt.wantExitCode)
+			}
+		})
+	}
+}
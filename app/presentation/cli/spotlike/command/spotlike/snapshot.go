@@ -0,0 +1,39 @@
+package spotlike
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+)
+
+// LoadLibrarySnapshot returns the library in the snapshot saved with the json format.
+func LoadLibrarySnapshot(path string) (*spotlikeApp.GetLibraryUseCaseOutputDto, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var library spotlikeApp.GetLibraryUseCaseOutputDto
+	if err := json.Unmarshal(data, &library); err != nil {
+		return nil, err
+	}
+
+	return &library, nil
+}
+
+// SaveLibrarySnapshot saves the library to the snapshot with the json format.
+func SaveLibrarySnapshot(path string, library *spotlikeApp.GetLibraryUseCaseOutputDto) error {
+	data, err := json.MarshalIndent(library, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
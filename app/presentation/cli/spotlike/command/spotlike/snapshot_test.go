@@ -0,0 +1,139 @@
+package spotlike
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+)
+
+func TestLoadLibrarySnapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot string
+		want     *spotlikeApp.GetLibraryUseCaseOutputDto
+		wantErr  bool
+	}{
+		{
+			name: "positive testing",
+			snapshot: `{
+  "exported_at": "2024-01-01T00:00:00Z",
+  "artists": [{"id": "test_artist_id", "name": "test_artist_name", "release_date": "0001-01-01T00:00:00Z", "liked_at": "0001-01-01T00:00:00Z"}],
+  "albums": [],
+  "tracks": [{"id": "test_track_id", "name": "test_track_name", "artists": "test_artist_name", "release_date": "2000-01-01T00:00:00Z", "liked_at": "2020-01-01T00:00:00Z"}]
+}`,
+			want: &spotlikeApp.GetLibraryUseCaseOutputDto{
+				ExportedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Artists: []*spotlikeApp.LibraryItemDto{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				Albums: []*spotlikeApp.LibraryItemDto{},
+				Tracks: []*spotlikeApp.LibraryItemDto{
+					{
+						ID:          "test_track_id",
+						Name:        "test_track_name",
+						Artists:     "test_artist_name",
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						LikedAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "negative testing (snapshot does not exist)",
+			snapshot: "",
+			want:     nil,
+			wantErr:  true,
+		},
+		{
+			name:     "negative testing (snapshot is not in the json format)",
+			snapshot: "test_track_id test_track_name",
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "library.json")
+			if tt.snapshot != "" {
+				if err := os.WriteFile(path, []byte(tt.snapshot), 0600); err != nil {
+					t.Fatalf("Failed to create a snapshot: %v", err)
+				}
+			}
+			got, err := LoadLibrarySnapshot(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadLibrarySnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadLibrarySnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveLibrarySnapshot(t *testing.T) {
+	library := &spotlikeApp.GetLibraryUseCaseOutputDto{
+		ExportedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Artists: []*spotlikeApp.LibraryItemDto{
+			{
+				ID:   "test_artist_id",
+				Name: "test_artist_name",
+			},
+		},
+		Albums: []*spotlikeApp.LibraryItemDto{},
+		Tracks: []*spotlikeApp.LibraryItemDto{},
+	}
+
+	tests := []struct {
+		name    string
+		path    func(dir string) string
+		wantErr bool
+	}{
+		{
+			name: "positive testing",
+			path: func(dir string) string {
+				return filepath.Join(dir, "snapshots", "library.json")
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative testing (directory is a file)",
+			path: func(dir string) string {
+				file := filepath.Join(dir, "file")
+				if err := os.WriteFile(file, []byte{}, 0600); err != nil {
+					t.Fatalf("Failed to create a file: %v", err)
+				}
+				return filepath.Join(file, "library.json")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path(t.TempDir())
+			if err := SaveLibrarySnapshot(path, library); (err != nil) != tt.wantErr {
+				t.Errorf("SaveLibrarySnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := LoadLibrarySnapshot(path)
+			if err != nil {
+				t.Errorf("LoadLibrarySnapshot() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, library) {
+				t.Errorf("SaveLibrarySnapshot() saved %v, want %v", got, library)
+			}
+		})
+	}
+}
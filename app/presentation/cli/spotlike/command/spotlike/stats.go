@@ -0,0 +1,147 @@
+package spotlike
+
+import (
+	"errors"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// StatsOptions provides the options for the stats command.
+type StatsOptions struct {
+	Top    int
+	Format string
+}
+
+var (
+	// statsOps is a variable to store the stats options with the default values for injecting the dependencies in testing.
+	statsOps = StatsOptions{
+		Top:    10,
+		Format: "table",
+	}
+)
+
+// NewStatsCommand returns a new instance of the stats command.
+func NewStatsCommand(
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("stats")
+	cmd.SetAliases([]string{"st", "S"})
+	cmd.SetUsageTemplate(statsUsageTemplate)
+	cmd.SetHelpTemplate(statsHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().IntVarP(
+		&statsOps.Top,
+		"top",
+		"t",
+		10,
+		"🏆 number of the top artists and genres to show (default 10)",
+	)
+	cmd.Flags().StringVarP(
+		&statsOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runStats(cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runStats runs the stats command.
+func runStats(cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if statsOps.Top < 1 {
+		o := formatter.Yellow("⚡ Invalid top option... (e.g: 10)")
+		*output = o
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	progress := NewProgressReporter(statsOps.Format)
+	glsuc := spotlikeApp.NewGetLibraryStatsUseCase(NewArtistRepository(), NewAlbumRepository(), NewTrackRepository())
+	glsucoDto, err := glsuc.Run(WithProgress(cmd.Context(), progress), statsOps.Top)
+	progress.Done()
+	if err != nil {
+		return err
+	}
+	if glsucoDto.FollowedArtists == 0 && glsucoDto.LikedAlbums == 0 && glsucoDto.LikedTracks == 0 {
+		o := formatter.Yellow("⚡ No contents found in your library...")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(statsOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(glsucoDto)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+
+	return nil
+}
+
+const (
+	// statsHelpTemplate is the help template of the stats command.
+	statsHelpTemplate = `📊 Show the statistics of your library.
+
+The statistics are computed from the artists you follow and the albums and the tracks you like.
+
+  - the numbers of the albums and the tracks by the top artists
+  - the numbers of the albums and the tracks released in each year and decade
+  - the numbers of the albums and the tracks in the top genres of their artists
+  - the numbers of the albums and the tracks added to your library in each month
+  - the coverages of the tracks you like out of all the tracks by the top artists
+
+The coverages need to look up all the tracks by the top artists, so it might take a while for the first time.
+
+` + statsUsageTemplate
+	// statsUsageTemplate is the usage template of the stats command.
+	statsUsageTemplate = `Usage:
+  spotlike stats [flags]
+  spotlike st    [flags]
+  spotlike S     [flags]
+
+Flags:
+  -t, --top     🏆 number of the top artists and genres to show (default 10)
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for stats
+
+Global Flags:
+  -q, --quiet   🤫 do not show the progress of long running operations
+`
+)
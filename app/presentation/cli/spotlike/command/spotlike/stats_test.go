@@ -0,0 +1,324 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewStatsCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewStatsCommand(tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewStatsCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the stats command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runStats(t *testing.T) {
+	output := ""
+	exit := os.Exit
+	origStatsOps := statsOps
+	origGlobalOps := GlobalOps
+	su := utility.NewStringsUtil()
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	artist := spotify.SimpleArtist{
+		ID:   "test_artist_id",
+		Name: "test_artist_name",
+	}
+	expectLibrary := func(mockSpotifyClient *proxy.MockClient, liked bool) {
+		followed := &spotify.FullArtistCursorPage{}
+		tracks := &spotify.SavedTrackPage{}
+		if liked {
+			followed.Artists = []spotify.FullArtist{
+				{
+					SimpleArtist: artist,
+					Genres:       []string{"test_genre"},
+				},
+			}
+			tracks.Tracks = []spotify.SavedTrack{
+				{
+					AddedAt: "2020-01-01T00:00:00Z",
+					FullTrack: spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:      "test_track_id_1",
+							Name:    "test_track_name_1",
+							Artists: []spotify.SimpleArtist{artist},
+						},
+						Album: spotify.SimpleAlbum{
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+				},
+			}
+		}
+		mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(followed, nil)
+		mockSpotifyClient.EXPECT().CurrentUsersAlbums(gomock.Any(), gomock.Any(), gomock.Any()).Return(&spotify.SavedAlbumPage{}, nil)
+		mockSpotifyClient.EXPECT().CurrentUsersTracks(gomock.Any(), gomock.Any(), gomock.Any()).Return(tracks, nil)
+	}
+	expectCatalog := func(mockSpotifyClient *proxy.MockClient) {
+		mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+			&spotify.SimpleAlbumPage{
+				Albums: []spotify.SimpleAlbum{
+					{
+						ID:      "test_album_id",
+						Name:    "test_album_name",
+						Artists: []spotify.SimpleArtist{artist},
+					},
+				},
+			},
+			nil,
+		)
+		mockSpotifyClient.EXPECT().GetAlbumTracks(gomock.Any(), spotify.ID("test_album_id")).Return(
+			&spotify.SimpleTrackPage{
+				Tracks: []spotify.SimpleTrack{
+					{ID: "test_track_id_1", Name: "test_track_name_1", Artists: []spotify.SimpleArtist{artist}},
+					{ID: "test_track_id_2", Name: "test_track_name_2", Artists: []spotify.SimpleArtist{artist}},
+				},
+			},
+			nil,
+		)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		statsOps = origStatsOps
+		GlobalOps = origGlobalOps
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput: "followedartists:1,likedalbums:0,likedtracks:1" +
+				"artisttest_artist_name:0albums,1tracks" +
+				"year2000:0albums,1tracks" +
+				"decade2000s:0albums,1tracks" +
+				"genretest_genre:0albums,1tracks" +
+				"month2020-01:0albums,1tracks" +
+				"liked1/2tracksbytest_artist_name(50%)",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				statsOps.Format = "plain"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient, true)
+				expectCatalog(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (library is empty)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Nocontentsfoundinyourlibrary..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient, false)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (top option is invalid)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("⚡Invalidtopoption...(e.g:10)"),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller) {
+				statsOps.Top = 0
+			},
+		},
+		{
+			name: "negative testing (client manager is not initialized)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Red("❌Clientmanagerisnotinitialized..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (failed to get the library)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUsersFollowedArtists(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get the followed artists"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to create a formatter)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Red("❌Failedtocreateaformatter..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				statsOps.Format = "invalid"
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectLibrary(mockSpotifyClient, true)
+				expectCatalog(mockSpotifyClient)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			SetExitCode(ExitCodeOk)
+			GlobalOps.NoCache = true
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(context.Background())
+			if err := runStats(tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runStats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runStats() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runStats() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+		})
+	}
+}
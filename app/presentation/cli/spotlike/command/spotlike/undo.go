@@ -0,0 +1,303 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// UndoOptions provides the options for the undo command.
+type UndoOptions struct {
+	Last      int
+	Batch     string
+	NoConfirm bool
+	Format    string
+}
+
+var (
+	// undoOps is a variable to store the undo options with the default values for injecting the dependencies in testing.
+	undoOps = UndoOptions{
+		Last:      0,
+		Batch:     "",
+		NoConfirm: false,
+		Format:    "table",
+	}
+)
+
+// NewUndoCommand returns a new instance of the undo command.
+func NewUndoCommand(
+	exit func(int),
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("undo")
+	cmd.SetAliases([]string{"ud", "U"})
+	cmd.SetUsageTemplate(undoUsageTemplate)
+	cmd.SetHelpTemplate(undoHelpTemplate)
+	cmd.SetArgs(cobra.ExactArgs(0))
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().IntVarP(
+		&undoOps.Last,
+		"last",
+		"l",
+		0,
+		"🔢 number of the last operations to undo",
+	)
+	cmd.Flags().StringVarP(
+		&undoOps.Batch,
+		"batch",
+		"b",
+		"",
+		"📦 an ID of the batch to undo all operations in the batch",
+	)
+	cmd.Flags().BoolVarP(
+		&undoOps.NoConfirm,
+		"no-confirm",
+		"",
+		false,
+		"🚫 do not confirm before undoing the operations",
+	)
+	cmd.Flags().StringVarP(
+		&undoOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runUndo(exit, cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runUndo runs the undo command.
+func runUndo(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if undoOps.Last < 0 {
+		o := formatter.Yellow("⚡ Invalid undo number...")
+		*output = o
+		return nil
+	}
+
+	if undoOps.Last > 0 && undoOps.Batch != "" {
+		o := formatter.Yellow("⚡ Both last and batch flags can not be specified at the same time...")
+		*output = o
+		return nil
+	}
+
+	operationRepo := fileRepository.NewOperationRepository(GlobalOps.DataDir)
+	gouc := spotlikeApp.NewGetOperationsUseCase(operationRepo)
+	var goucoDtos []*spotlikeApp.GetOperationsUseCaseOutputDto
+	var err error
+	if undoOps.Last > 0 {
+		goucoDtos, err = gouc.Run(cmd.Context(), "", undoOps.Last)
+	} else if undoOps.Batch != "" {
+		goucoDtos, err = gouc.Run(cmd.Context(), undoOps.Batch, 0)
+	} else {
+		goucoDtos, err = gouc.Run(cmd.Context(), "", 1)
+		if err == nil && len(goucoDtos) != 0 {
+			goucoDtos, err = gouc.Run(cmd.Context(), goucoDtos[0].BatchID, 0)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(goucoDtos) == 0 {
+		o := formatter.Yellow("⚡ No operations found to undo...")
+		*output = o
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	userRepo := repository.NewUserRepository()
+	gcuuc := spotlikeApp.NewGetCurrentUserUseCase(userRepo)
+	gcuucoDto, err := gcuuc.Run(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	w := MessageWriter(undoOps.Format)
+	var undoTargets []*spotlikeApp.GetOperationsUseCaseOutputDto
+	for _, goucoDto := range goucoDtos {
+		// the operations recorded before the account was recorded have no account, so they are undone as before
+		if goucoDto.Account != "" && goucoDto.Account != gcuucoDto.ID {
+			if err := presenter.Print(w, formatter.Yellow("🚫 The "+goucoDto.Type+" "+goucoDto.Name+" ("+goucoDto.ID+") was "+goucoDto.Action+"d by another account ("+goucoDto.Account+"). skipping...")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		liked, err := checkLike(cmd.Context(), goucoDto.Type, goucoDto.ID)
+		if err != nil {
+			return err
+		}
+		if (goucoDto.Action == "like" && !liked) || (goucoDto.Action == "unlike" && liked) {
+			if err := presenter.Print(w, formatter.Blue("⏩ The "+goucoDto.Type+" "+goucoDto.Name+" ("+goucoDto.ID+") is already "+reverseAction(goucoDto.Action)+"d. skipping...")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		undoTargets = append(undoTargets, goucoDto)
+	}
+
+	if len(undoTargets) == 0 {
+		SetExitCode(ExitCodeNothingToDo)
+		return nil
+	}
+
+	if !GlobalOps.DryRun && !undoOps.NoConfirm {
+		if answer, err := presenter.RunPrompt(
+			"Proceed with undoing " + fmt.Sprint(len(undoTargets)) + " operations ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled undoing...")); err != nil {
+				return err
+			}
+			exit(ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled undoing...")
+			*output = o
+			SetExitCode(ExitCodeCanceled)
+			return nil
+		}
+	}
+
+	if !GlobalOps.DryRun {
+		rouc := spotlikeApp.NewRecordOperationUseCase(operationRepo, userRepo)
+		// the in-flight operation is finished even if interrupted, and the remaining operations are cancelled
+		ctx := context.WithoutCancel(cmd.Context())
+		for i, undoTarget := range undoTargets {
+			if cmd.Context().Err() != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled undoing the remaining operations...")); err != nil {
+					return err
+				}
+				undoTargets = undoTargets[:i]
+				SetExitCode(ExitCodeCanceled)
+				break
+			}
+			action := reverseAction(undoTarget.Action)
+			if err := ApplyAction(ctx, action, undoTarget.Type, undoTarget.ID); err != nil {
+				return err
+			}
+			if err := rouc.Run(
+				ctx,
+				&spotlikeApp.RecordOperationUseCaseInputDto{
+					Type:        undoTarget.Type,
+					ID:          undoTarget.ID,
+					Name:        undoTarget.Name,
+					Action:      action,
+					CommandLine: strings.Join(os.Args, " "),
+				},
+			); err != nil {
+				// the content is already undone on Spotify, so the remaining operations are undone anyway
+				if err := presenter.Print(w, formatter.Red("❌ Undone the "+undoTarget.Type+" "+undoTarget.Name+" ("+undoTarget.ID+") but failed to record it in the history : "+err.Error())); err != nil {
+					return err
+				}
+				SetExitCode(ExitCodePartialFailure)
+			}
+		}
+	}
+
+	f, err := formatter.NewFormatter(undoOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(undoTargets)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	message := formatter.Green("✅⏪ Successfully undone operations below!")
+	if GlobalOps.DryRun {
+		message = formatter.Yellow("🧪⏪ Operations below would be undone... (dry run)")
+	}
+	if err := presenter.Print(w, message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reverseAction returns the action to undo the specified action.
+func reverseAction(action string) string {
+	if action == "like" {
+		return "unlike"
+	}
+	return "like"
+}
+
+const (
+	// undoHelpTemplate is the help template of the undo command.
+	undoHelpTemplate = `⏪ Undo like and unlike operations.
+
+You can undo the operations recorded by the like and unlike commands.
+The liked contents would be unliked, and the unliked contents would be liked again.
+If you specify no flags, all operations in the latest batch would be undone.
+The operations executed to the library of another account are skipped.
+
+Also, you can undo the last operations by specifying the "-l" or "--last" option.
+Also, you can undo all operations in the batch by specifying the "-b" or "--batch" option.
+Both last and batch flags can not be specified at the same time.
+
+The undo operations are also recorded in the history, so you can undo the undo.
+
+` + undoUsageTemplate
+	// undoUsageTemplate is the usage template of the undo command.
+	undoUsageTemplate = `Usage:
+  spotlike undo [flags]
+  spotlike ud   [flags]
+  spotlike U    [flags]
+
+Flags:
+  -l, --last    🔢 number of the last operations to undo
+  -b, --batch   📦 an ID of the batch to undo all operations in the batch
+  --no-confirm  🚫 do not confirm before undoing the operations
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help    🤝 help for undo
+
+Global Flags:
+  --dry-run  🧪 show the plan of like and unlike without executing it
+`
+)
@@ -0,0 +1,535 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	operationDomain "github.com/yanosea/spotlike/app/domain/spotlike/operation"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewUndoCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		exit    func(int)
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				exit:  exit,
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewUndoCommand(tt.args.exit, tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewUndoCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the undo command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runUndo(t *testing.T) {
+	output := ""
+	exit := func(code int) {
+		SetExitCode(code)
+	}
+	origUndoOps := undoOps
+	origGlobalOps := GlobalOps
+	origPu := presenter.Pu
+	origNewFormatter := formatter.NewFormatter
+	su := utility.NewStringsUtil()
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	saveOperations := func() {
+		GlobalOps.DataDir = t.TempDir()
+		saveTestOperations(
+			t,
+			GlobalOps.DataDir,
+			operationDomain.NewOperation("test_batch_id_1", timestamp, "track", "test_track_id", "test_track_name", "like", "spotlike like track test_track_id", "test_user_id"),
+			operationDomain.NewOperation("test_batch_id_2", timestamp, "album", "test_album_id", "test_album_name", "like", "spotlike like album test_album_id", "test_user_id"),
+			operationDomain.NewOperation("test_batch_id_2", timestamp, "artist", "test_artist_id", "test_artist_name", "unlike", "spotlike unlike artist test_artist_id", "test_user_id"),
+		)
+	}
+	setPrompt := func(mockCtrl *gomock.Controller, label string, answer string, err error) {
+		mockPrompt := proxy.NewMockPrompt(mockCtrl)
+		mockPrompt.EXPECT().SetLabel(label)
+		mockPrompt.EXPECT().Run().Return(answer, err)
+		mockPromptui := proxy.NewMockPromptui(mockCtrl)
+		mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+		presenter.Pu = utility.NewPromptUtil(mockPromptui)
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		undoOps = origUndoOps
+		GlobalOps = origGlobalOps
+		presenter.Pu = origPu
+		formatter.NewFormatter = origNewFormatter
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		wantRecorded int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name: "positive testing (undo the latest batch)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "🕒TIME📦BATCH🔧ACTION📁TYPE🆔ID📛NAME👤ACCOUNT2000-01-0100:00:00test_batch_id_2unlikeartisttest_artist_idtest_artist_nametest_user_id2000-01-0100:00:00test_batch_id_2likealbumtest_album_idtest_album_nametest_user_idTOTAL:2operations!",
+			wantErr:      false,
+			wantRecorded: 5,
+			setup: func(mockCtrl *gomock.Controller) {
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(gomock.Any(), spotify.ID("test_album_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with undoing 2 operations ? [y/N]", "y", nil)
+			},
+		},
+		{
+			name: "positive testing (undo the last operation)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_batch_id_2]2000-01-0100:00:00unlikeartist:test_artist_name(test_artist_id)bytest_user_id",
+			wantErr:      false,
+			wantRecorded: 4,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Last = 1
+				undoOps.NoConfirm = true
+				undoOps.Format = "plain"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (undo the batch)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_batch_id_1]2000-01-0100:00:00liketrack:test_track_name(test_track_id)bytest_user_id",
+			wantErr:      false,
+			wantRecorded: 4,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				undoOps.NoConfirm = true
+				undoOps.Format = "plain"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_batch_id_1]2000-01-0100:00:00liketrack:test_track_name(test_track_id)bytest_user_id",
+			wantErr:      false,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				GlobalOps.DryRun = true
+				undoOps.Batch = "test_batch_id_1"
+				undoOps.Format = "plain"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (last is less than 0)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput: formatter.Yellow("⚡Invalidundonumber..."),
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				undoOps.Last = -1
+			},
+		},
+		{
+			name: "negative testing (both last and batch are specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput: formatter.Yellow("⚡Bothlastandbatchflagscannotbespecifiedatthesametime..."),
+			wantErr:    false,
+			setup: func(_ *gomock.Controller) {
+				undoOps.Last = 1
+				undoOps.Batch = "test_batch_id_1"
+			},
+		},
+		{
+			name: "negative testing (no operations found)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput: formatter.Yellow("⚡Nooperationsfoundtoundo..."),
+			wantErr:    false,
+			wantExitCode: ExitCodeNothingToDo,
+			setup: func(_ *gomock.Controller) {
+				GlobalOps.DataDir = t.TempDir()
+			},
+		},
+		{
+			name: "negative testing (already undone)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{false}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (operated by another account)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				undoOps.NoConfirm = true
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_other_user_id"}}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to get the current user)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(nil, errors.New("failed to get the current user"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to check if the content is liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return(nil, errors.New("failed to check"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (undo cancelled)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Yellow("🚫Cancelledundoing..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with undoing 1 operations ? [y/N]", "n", nil)
+			},
+		},
+		{
+			name: "negative testing (undo cancelled with ^C)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeCanceled,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				setPrompt(mockCtrl, "Proceed with undoing 1 operations ? [y/N]", "", proxy.ErrInterrupt)
+			},
+		},
+		{
+			name: "negative testing (failed to undo the operation)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "",
+			wantErr:      true,
+			wantRecorded: 3,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				undoOps.NoConfirm = true
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(errors.New("failed to unlike track"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (failed to record the operation, the remaining operations are undone)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   "[test_batch_id_2]2000-01-0100:00:00unlikeartist:test_artist_name(test_artist_id)bytest_user_id[test_batch_id_2]2000-01-0100:00:00likealbum:test_album_name(test_album_id)bytest_user_id",
+			wantErr:      false,
+			wantExitCode: ExitCodePartialFailure,
+			wantRecorded: 4,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.NoConfirm = true
+				undoOps.Format = "plain"
+				saveOperations()
+				journal := filepath.Join(GlobalOps.DataDir, "journal.jsonl")
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id")).Return([]bool{true}, nil)
+				// the journal can not be written while the artist is being followed
+				mockSpotifyClient.EXPECT().FollowArtist(gomock.Any(), spotify.ID("test_artist_id")).DoAndReturn(func(_ context.Context, _ ...spotify.ID) error {
+					if err := os.Rename(journal, journal+".bak"); err != nil {
+						t.Errorf("Failed to move the journal: %v", err)
+					}
+					return os.Mkdir(journal, 0700)
+				})
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(gomock.Any(), spotify.ID("test_album_id")).DoAndReturn(func(_ context.Context, _ ...spotify.ID) error {
+					if err := os.Remove(journal); err != nil {
+						t.Errorf("Failed to remove the directory: %v", err)
+					}
+					return os.Rename(journal+".bak", journal)
+				})
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (formatter.NewFormatter() failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			wantOutput:   formatter.Red("❌Failedtocreateaformatter..."),
+			wantErr:      true,
+			wantRecorded: 4,
+			setup: func(mockCtrl *gomock.Controller) {
+				undoOps.Batch = "test_batch_id_1"
+				undoOps.NoConfirm = true
+				saveOperations()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(gomock.Any(), spotify.ID("test_track_id")).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				formatter.NewFormatter = func(format string) (formatter.Formatter, error) {
+					return nil, errors.New("invalid format")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			SetExitCode(ExitCodeOk)
+			tt.args.cmd.SetContext(context.Background())
+			if err := runUndo(exit, tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runUndo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runUndo() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runUndo() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+			if GlobalOps.DataDir != "" {
+				operations, err := fileRepository.NewOperationRepository(GlobalOps.DataDir).FindAll(context.Background())
+				if err != nil {
+					t.Errorf("Failed to find the operations: %v", err)
+				}
+				if len(operations) != tt.wantRecorded {
+					t.Errorf("runUndo() recorded operations = %v, want %v", len(operations), tt.wantRecorded)
+				}
+			}
+		})
+	}
+}
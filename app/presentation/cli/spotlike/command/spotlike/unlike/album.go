@@ -1,13 +1,19 @@
 package unlike
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -62,7 +68,7 @@ func NewUnlikeAlbumCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -76,6 +82,24 @@ func NewUnlikeAlbumCommand(
 
 // runUnlikeAlbum executes the unlike album command.
 func runUnlikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "album", "unlike")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed albums found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		// retry only the failed albums ignoring the flags to specify the parent
+		unlikeAlbumOps.Artist = ""
+		args = ids
+	}
+
 	if unlikeAlbumOps.Artist == "" && len(args) == 0 {
 		o := formatter.Yellow("⚡ No ID arguments specified...")
 		*output = o
@@ -89,7 +113,7 @@ func runUnlikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -99,19 +123,23 @@ func runUnlikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		return err
 	}
 
+	w := spotlike.MessageWriter(unlikeAlbumOps.Format)
+	progress := spotlike.NewProgressReporter(unlikeAlbumOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gaucoDtos []*spotlikeApp.GetAlbumUseCaseOutputDto
-	albumRepo := repository.NewAlbumRepository()
+	albumRepo := spotlike.NewAlbumRepository()
 	if unlikeAlbumOps.Artist != "" {
-		artistRepo := repository.NewArtistRepository()
+		artistRepo := spotlike.NewArtistRepository()
 		gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 		gAucoDto, err := gAuc.Run(cmd.Context(), unlikeAlbumOps.Artist)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gAucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + unlikeAlbumOps.Artist + " is not found or it is not an artist...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
@@ -136,13 +164,19 @@ func runUnlikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		for _, id := range args {
 			gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
 			gaucoDto, err := gauc.Run(cmd.Context(), id)
-			if err != nil && err.Error() != "Resource not found" {
-				return err
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", id, "", "unlike", spotlikeApp.OperationResultStatusFailed, err))
+				continue
 			}
 
 			if gaucoDto == nil {
-				o := formatter.Yellow("⚡ The id " + id + " is not found or it is not an album...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not an album...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", id, "", "unlike", spotlikeApp.OperationResultStatusNotFound, nil))
 				continue
 			}
 
@@ -159,65 +193,185 @@ func runUnlikeAlbum(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 	}
 
 	clauc := spotlikeApp.NewCheckLikeAlbumUseCase(albumRepo)
-	uauc := spotlikeApp.NewUnlikeAlbumUseCase(albumRepo)
-	var unlikeExecutedAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
+	var unlikeTargetAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
 	for _, gaucoDto := range gaucoDtos {
 		alreadyLiked, err := clauc.Run(cmd.Context(), gaucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if !alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Album "+gaucoDto.Name+" ("+gaucoDto.ID+")"+" released by "+gaucoDto.Artists+" is not liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Album "+gaucoDto.Name+" ("+gaucoDto.ID+")"+" released by "+gaucoDto.Artists+" is not liked. skipping...")); err != nil {
 				return err
 			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusSkipped, nil))
 			continue
 		}
 
-		if !unlikeAlbumOps.NoConfirm {
+		unlikeTargetAlbums = append(unlikeTargetAlbums, gaucoDto)
+	}
+
+	noConfirm := unlikeAlbumOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(unlikeTargetAlbums) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(unlikeAlbumOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(unlikeTargetAlbums)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with unliking " + fmt.Sprint(len(unlikeTargetAlbums)) + " albums above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled unliking albums...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	uauc := spotlikeApp.NewUnlikeAlbumUseCase(albumRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var unlikeExecutedAlbums []*spotlikeApp.GetAlbumUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gaucoDto := range unlikeTargetAlbums {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled unliking the remaining albums...")); err != nil {
+				return err
+			}
+			for _, remaining := range unlikeTargetAlbums[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("album", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("albums unliked", i, len(unlikeTargetAlbums))
+		}
+		if spotlike.GlobalOps.DryRun {
+			unlikeExecutedAlbums = append(unlikeExecutedAlbums, gaucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with unliking " + gaucoDto.Name + " (" + gaucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with unliking " + gaucoDto.Name + " (" + gaucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking the remaining albums...")); err != nil {
+					return err
+				}
+				for _, remaining := range unlikeTargetAlbums[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("album", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled unliking album " + gaucoDto.Name + " (" + gaucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking album "+gaucoDto.Name+" ("+gaucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := uauc.Run(cmd.Context(), gaucoDto.ID); err != nil {
+		if err := uauc.Run(ctx, gaucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "album",
+				ID:          gaucoDto.ID,
+				Name:        gaucoDto.Name,
+				Action:      "unlike",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("album", gaucoDto.ID, gaucoDto.Name, "unlike", spotlikeApp.OperationResultStatusUnliked, nil))
 		unlikeExecutedAlbums = append(unlikeExecutedAlbums, gaucoDto)
 	}
+	progress.Done()
 
-	if len(unlikeExecutedAlbums) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(unlikeAlbumOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(unlikeExecutedAlbums)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅💔💿 Successfully unliked albums below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if unlikeAlbumOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(unlikeExecutedAlbums) != 0 {
+		message := formatter.Green("✅💔💿 Successfully unliked albums below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪💔💿 Albums below would be unliked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
@@ -233,6 +387,10 @@ you need to get the ID of the track you want to unlike by using the search comma
 Also, you can unlike all albums released by the artist with specifying the ID of the artist with artist flag.
 If you specify artist flag, the arguments would be ignored.
 
+Before unliking each album, you would be asked to confirm.
+You can answer "a" to unlike all the remaining albums without confirming, or "q" to quit.
+If more albums than the confirm threshold are affected, you would be asked only once with the summary.
+
 ` + unlikeAlbumUsageTemplate
 	// unlikeAlbumUsageTemplate is a usage message template for the unlike album command.
 	unlikeAlbumUsageTemplate = `Usage:
@@ -243,9 +401,16 @@ If you specify artist flag, the arguments would be ignored.
 Flags:
   -A, --artist  🆔 an ID of the artist to unlike all albums released by the artist
   --no-confirm  🚫 do not confirm before unliking the album
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for album
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the albums (e.g: "1dGzXXa8MeTCdi0oBbvB1J 6Xy481vVb9vPK4qbCuT9u1")
 `
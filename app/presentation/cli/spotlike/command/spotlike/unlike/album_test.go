@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	o "os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -150,11 +151,12 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅💔💿 Successfully unliked albums below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATEtest_album_idtest_album_nametest_artist_name2000-01-01TOTAL:1albums!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -164,7 +166,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -184,7 +186,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -211,7 +213,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -226,6 +228,470 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (all remaining items are confirmed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeAlbumOps.Artist = "test_artist_id"
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Green("✅💔💿 Successfully unliked albums below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("a", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (confirmed once with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeAlbumOps.Artist = "test_artist_id"
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATEtest_album_idtest_album_nametest_artist_name2000-01-01TOTAL:1albums!" + formatter.Green("✅💔💿 Successfully unliked albums below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 albums above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (quit the remaining items)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeAlbumOps.Artist = "test_artist_id"
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking the remaining albums..."),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikecanceledTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("q", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (cancelled with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeAlbumOps.Artist = "test_artist_id"
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATEtest_album_idtest_album_nametest_artist_name2000-01-01TOTAL:1albums!",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("🚫 Cancelled unliking albums..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 albums above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "positive testing (artist option is not set)",
 			fields: fields{
@@ -257,37 +723,432 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Green("✅💔💿 Successfully unliked albums below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🧪💔💿 Albums below would be unliked... (dry run)"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeplannedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.DryRun = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.DryRun = false
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (args is empty)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("⚡ No ID arguments specified..."),
+			wantErr:    false,
+			setup:      nil,
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (clientManager is nil)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Red("❌ Client manager is not initialized..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return nil
+				}
+			},
+			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (client not initialized and authCmd.RunE() failed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						if err.Error() != "failed to run prompt" {
+							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return mockClientManager
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to get client)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						if err.Error() != "failed to get client" {
+							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Red("❌ Failed to get client..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client")).AnyTimes()
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return mockClientManager
+				}
+			},
+			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to get artist)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeAlbumCmd := NewUnlikeAlbumCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					unlikeAlbumOps.Artist = "test_artist_id"
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get artist" {
+							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Green("✅💔💿 Successfully unliked albums below!"),
+			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATEtest_album_idtest_album_nametest_artist_name2000-01-01TOTAL:1albums!",
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
-					&spotify.FullAlbum{
-						SimpleAlbum: spotify.SimpleAlbum{
-							ID:   "test_album_id",
-							Name: "test_album_name",
-							Artists: []spotify.SimpleArtist{
-								{
-									ID:   "test_artist_id",
-									Name: "test_artist_name",
-								},
-							},
-							ReleaseDate:          "2000-01-01",
-							ReleaseDatePrecision: "day",
-						},
-					},
-					nil,
-				)
-				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -313,23 +1174,17 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
-				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("y", nil)
-				mockPromptui := proxy.NewMockPromptui(mockCtrl)
-				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
-				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Pu = origPu
+				unlikeAlbumOps = origUnikeAlbumOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (args is empty)",
+			name: "negative testing (gAucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -357,6 +1212,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						authCmd,
 						&output,
 					)
+					unlikeAlbumOps.Artist = "test_artist_id"
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
@@ -366,65 +1222,48 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ No ID arguments specified..."),
-			wantErr:    false,
-			setup:      nil,
-			cleanup: func() {
-				output = ""
-			},
-		},
-		{
-			name: "negative testing (clientManager is nil)",
-			fields: fields{
-				Os:        os,
-				StdBuffer: stdBuffer,
-				ErrBuffer: errBuffer,
-			},
-			args: args{
-				fnc: func() {
-					authCmd := spotlike.NewAuthCommand(
-						exit,
-						proxy.NewCobra(),
-						"v0.0.0",
-						&config.SpotlikeCliConfig{
-							SpotlikeConfig: baseconfig.SpotlikeConfig{
-								SpotifyID:           "test_client_id",
-								SpotifySecret:       "test_client_secret",
-								SpotifyRedirectUri:  "test_redirect_uri",
-								SpotifyRefreshToken: "test_refresh_token",
-							},
-						},
-						&output,
-					)
-					unlikeAlbumCmd := NewUnlikeAlbumCommand(
-						exit,
-						proxy.NewCobra(),
-						authCmd,
-						&output,
-					)
-					cmd := &c.Command{}
-					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
-					}
-				},
-			},
-			wantStdOut: "",
-			wantStdErr: "",
-			wantOutput: formatter.Red("❌ Client manager is not initialized..."),
+			wantOutput: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return nil
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (client not initialized and authCmd.RunE() failed)",
+			name: "negative testing (failed to get all albums by artist id)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -438,7 +1277,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						"v0.0.0",
 						&config.SpotlikeCliConfig{
 							SpotlikeConfig: baseconfig.SpotlikeConfig{
-								SpotifyID:           "",
+								SpotifyID:           "test_client_id",
 								SpotifySecret:       "test_client_secret",
 								SpotifyRedirectUri:  "test_redirect_uri",
 								SpotifyRefreshToken: "test_refresh_token",
@@ -452,10 +1291,11 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						authCmd,
 						&output,
 					)
+					unlikeAlbumOps.Artist = "test_artist_id"
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get all albums by artist id" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -466,25 +1306,54 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return mockClientManager
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to get all albums by artist id"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
-				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
-				mockPromptui := proxy.NewMockPromptui(mockCtrl)
-				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
-				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
-				presenter.Pu = origPu
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeAlbumOps = origUnikeAlbumOps
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get client)",
+			name: "negative testing (failed to get album)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -515,7 +1384,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to get client" {
+						if err.Error() != "failed to get album" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -523,22 +1392,47 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Red("❌ Failed to get client..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client")).AnyTimes()
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return mockClientManager
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get artist)",
+			name: "negative testing (gaucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -566,24 +1460,21 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						authCmd,
 						&output,
 					)
-					unlikeAlbumOps.Artist = "test_artist_id"
 					cmd := &c.Command{}
-					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get artist" {
-							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
-						}
+					cmd.SetContext(context.Background())
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumunlikenot_foundTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -614,11 +1505,11 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeAlbumOps = origUnikeAlbumOps
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (gAucoDto is nil)",
+			name: "negative testing (failed to check if album is already liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -646,22 +1537,40 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						authCmd,
 						&output,
 					)
-					unlikeAlbumOps.Artist = "test_artist_id"
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
-						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						if err.Error() != "failed to check if album is already liked" {
+							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						}
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, errors.New("failed to check if album is already liked"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -692,12 +1601,11 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeAlbumOps = origUnikeAlbumOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get all albums by artist id)",
+			name: "negative testing (album is not liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -725,33 +1633,38 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						authCmd,
 						&output,
 					)
-					unlikeAlbumOps.Artist = "test_artist_id"
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get all albums by artist id" {
-							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
-						}
+					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Blue("⏩ Album " + "test_album_name" + " (" + "test_album_id" + ")" + " released by " + "test_artist_name" + " is not liked. skipping..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeskippedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
-					&spotify.FullArtist{
-						SimpleArtist: spotify.SimpleArtist{
-							ID:   "test_artist_id",
-							Name: "test_artist_name",
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
 						},
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(nil, errors.New("failed to get all albums by artist id"))
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -782,12 +1695,11 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeAlbumOps = origUnikeAlbumOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get album)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Album \"+gaucoDto.Name+\" (\"+gaucoDto.ID+\")\"+\" released by \"+gaucoDto.Artists+\" is not liked. skipping...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -818,7 +1730,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to get album" {
+						if err.Error() != "Print() failed" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -831,7 +1743,24 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -857,15 +1786,23 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, formatter.Blue("⏩ Album test_album_name (test_album_id) released by test_artist_name is not liked. skipping...")) {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (gaucoDto is nil)",
+			name: "negative testing (unlike cancelled with ^C)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -873,6 +1810,9 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
+					exit = func(code int) {
+						// do nothing
+					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -900,14 +1840,31 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -933,16 +1890,24 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				exit = o.Exit
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to check if album is already liked)",
+			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -973,7 +1938,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to check if album is already liked" {
+						if err.Error() != "Print() failed" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -1003,7 +1968,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, errors.New("failed to check if album is already liked"))
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1029,16 +1994,30 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "\n") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (album is not liked)",
+			name: "negative testing (like cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1069,11 +2048,13 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						if err.Error() != "Print() failed" {
+							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Blue("⏩ Album " + "test_album_name" + " (" + "test_album_id" + ")" + " released by " + "test_artist_name" + " is not liked. skipping..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1097,7 +2078,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1123,16 +2104,30 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "🚫 Cancelled unliking...") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Album \"+gaucoDto.Name+\" (\"+gaucoDto.ID+\")\"+\" released by \"+gaucoDto.Artists+\" is not liked. skipping...\")) failed)",
+			name: "negative testing (presenter.Runprompt() failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1163,7 +2158,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "Print() failed" {
+						if err.Error() != "failed to run prompt" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -1193,7 +2188,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1219,22 +2214,23 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, formatter.Blue("⏩ Album test_album_name (test_album_id) released by test_artist_name is not liked. skipping...")) {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Print = origPrint
+				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C)",
+			name: "negative testing (skipped to unlike artist)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1242,9 +2238,6 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
-					exit = func(code int) {
-						// do nothing
-					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -1272,9 +2265,9 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					}
 				},
 			},
-			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking album test_album_name (test_album_id) ..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikecanceledTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
@@ -1323,8 +2316,8 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -1333,12 +2326,12 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				exit = o.Exit
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
+			name: "negative testing (like album failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1369,7 +2362,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "Print() failed" {
+						if err.Error() != "failed to like album" {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -1400,6 +2393,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(errors.New("failed to like album"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1426,28 +2420,22 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "\n") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (like cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
+			name: "negative testing (interrupted before unliking)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1476,22 +2464,22 @@ func Test_runUnlikeAlbum(t *testing.T) {
 						&output,
 					)
 					cmd := &c.Command{}
-					cmd.SetContext(context.Background())
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+					cmd.SetContext(ctx)
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "Print() failed" {
-							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("🚫 Interrupted. Cancelled unliking the remaining albums..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikecanceledTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+				mockSpotifyClient.EXPECT().GetAlbum(gomock.Any(), spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
 							ID:   "test_album_id",
@@ -1508,7 +2496,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1534,29 +2522,17 @@ func Test_runUnlikeAlbum(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
-				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
-				mockPromptui := proxy.NewMockPromptui(mockCtrl)
-				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
-				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "🚫 Cancelled unliking...") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Runprompt() failed)",
+			name: "positive testing (keep going after unlike album failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1587,17 +2563,16 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
-							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikefailedfailedtolikealbumTOTAL:1results!❌Failedtounlike1contentsbelow...albumtest_album_name(test_album_id):failedtolikealbum",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
@@ -1618,6 +2593,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(errors.New("failed to like album"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1644,21 +2620,23 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (skipped to unlike artist)",
+			name: "positive testing (retry the failed album in the report)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1688,16 +2666,22 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
+					if err := unlikeAlbumCmd.RunE(cmd, []string{}); err != nil {
 						t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("🚫 Cancelled unliking album test_album_name (test_album_id)..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikefailedfailedtolikealbumTOTAL:1results!❌Failedtounlike1contentsbelow...albumtest_album_name(test_album_id):failedtolikealbum",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				report := filepath.Join(t.TempDir(), "report.json")
+				if err := o.WriteFile(report, []byte(`[{"type":"album","id":"test_album_id","name":"test_album_name","action":"unlike","status":"failed","error":"failed to like album"}]`), 0600); err != nil {
+					t.Errorf("Failed to create a report: %v", err)
+				}
+				spotlike.GlobalOps.RetryFailed = report
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
@@ -1718,6 +2702,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(errors.New("failed to like album"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1744,13 +2729,15 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.RetryFailed = ""
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -1759,7 +2746,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (like album failed)",
+			name: "negative testing (failed to record the operation)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1790,7 +2777,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeAlbumCmd.RunE(cmd, []string{"test_album_id"}); err != nil {
-						if err.Error() != "failed to like album" {
+						if !strings.Contains(err.Error(), "not a directory") {
 							t.Errorf("Failed to run the unlikeAlbum command: %v", err)
 						}
 					}
@@ -1801,8 +2788,14 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				file := filepath.Join(t.TempDir(), "file")
+				if err := o.WriteFile(file, []byte{}, 0600); err != nil {
+					t.Errorf("Failed to create a file: %v", err)
+				}
+				spotlike.GlobalOps.DataDir = filepath.Join(file, "spotlike")
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -1821,7 +2814,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(errors.New("failed to like album"))
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1848,13 +2841,14 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.DataDir = ""
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -1908,6 +2902,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -1926,7 +2921,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1953,7 +2948,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2013,6 +3008,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -2031,7 +3027,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2058,7 +3054,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2119,11 +3115,12 @@ func Test_runUnlikeAlbum(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "🆔ID💿ALBUM🎤ARTISTS📅RELEASEDATEtest_album_idtest_album_nametest_artist_name2000-01-01TOTAL:1albums!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_album_idalbumtest_album_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -2142,7 +3139,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasAlbums(ctx, []spotify.ID{"test_album_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(ctx, []spotify.ID{"test_album_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_album_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2169,7 +3166,7 @@ func Test_runUnlikeAlbum(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_album_name (test_album_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -1,13 +1,20 @@
 package unlike
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -17,6 +24,7 @@ import (
 // UnlikeArtistOptions represents the options for the unlike artist command.
 type UnlikeArtistOptions struct {
 	NoConfirm bool
+	Purge     bool
 	Format    string
 }
 
@@ -24,6 +32,7 @@ var (
 	// unlikeArtistOps is a variable to store the unlike artist options with the default values for injecting the dependencies in testing.
 	unlikeArtistOps = UnlikeArtistOptions{
 		NoConfirm: false,
+		Purge:     false,
 		Format:    "table",
 	}
 )
@@ -48,12 +57,19 @@ func NewUnlikeArtistCommand(
 		false,
 		"🚫 do not confirm before unliking the artist",
 	)
+	cmd.Flags().BoolVarP(
+		&unlikeArtistOps.Purge,
+		"purge",
+		"",
+		false,
+		"🧹 also unlike all the albums and the tracks by the artist in your library",
+	)
 	cmd.Flags().StringVarP(
 		&unlikeArtistOps.Format,
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -67,6 +83,22 @@ func NewUnlikeArtistCommand(
 
 // runUnlikeArtist executes the unlike artist command.
 func runUnlikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "artist", "unlike")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed artists found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		args = ids
+	}
+
 	if len(args) == 0 {
 		o := formatter.Yellow("⚡ No ID arguments specified...")
 		*output = o
@@ -80,7 +112,7 @@ func runUnlikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, outp
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -90,87 +122,388 @@ func runUnlikeArtist(exit func(int), cmd *c.Command, authCmd proxy.Command, outp
 		return err
 	}
 
+	w := spotlike.MessageWriter(unlikeArtistOps.Format)
+	progress := spotlike.NewProgressReporter(unlikeArtistOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gAucoDtos []*spotlikeApp.GetArtistUseCaseOutputDto
-	artistRepo := repository.NewArtistRepository()
+	artistRepo := spotlike.NewArtistRepository()
 	gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 	for _, id := range args {
 		gAucoDto, err := gAuc.Run(cmd.Context(), id)
-		if err != nil && err.Error() != "Resource not found" {
-			return err
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", id, "", "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 
 		if gAucoDto == nil {
-			o := formatter.Yellow("⚡ The id " + id + " is not found or it is not an artist...")
-			*output = o
+			if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not an artist...")); err != nil {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", id, "", "unlike", spotlikeApp.OperationResultStatusNotFound, nil))
 			continue
 		}
 
 		gAucoDtos = append(gAucoDtos, gAucoDto)
 	}
 
+	if unlikeArtistOps.Purge {
+		return runUnlikeArtistPurge(exit, cmd, output, w, progress, gAucoDtos, results)
+	}
+
 	clAuc := spotlikeApp.NewCheckLikeArtistUseCase(artistRepo)
-	uAuc := spotlikeApp.NewUnlikeArtistUseCase(artistRepo)
-	var unlikeExecutedArtists []*spotlikeApp.GetArtistUseCaseOutputDto
+	var unlikeTargetArtists []*spotlikeApp.GetArtistUseCaseOutputDto
 	for _, gAucoDto := range gAucoDtos {
 		alreadyLiked, err := clAuc.Run(cmd.Context(), gAucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if !alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Artist "+gAucoDto.Name+" ("+gAucoDto.ID+") "+"is not liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Artist "+gAucoDto.Name+" ("+gAucoDto.ID+") "+"is not liked. skipping...")); err != nil {
 				return err
 			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusSkipped, nil))
 			continue
 		}
 
-		if !unlikeArtistOps.NoConfirm {
+		unlikeTargetArtists = append(unlikeTargetArtists, gAucoDto)
+	}
+
+	noConfirm := unlikeArtistOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(unlikeTargetArtists) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(unlikeArtistOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(unlikeTargetArtists)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with unliking " + fmt.Sprint(len(unlikeTargetArtists)) + " artists above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled unliking artists...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	uAuc := spotlikeApp.NewUnlikeArtistUseCase(artistRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var unlikeExecutedArtists []*spotlikeApp.GetArtistUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gAucoDto := range unlikeTargetArtists {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled unliking the remaining artists...")); err != nil {
+				return err
+			}
+			for _, remaining := range unlikeTargetArtists[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("artist", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("artists unliked", i, len(unlikeTargetArtists))
+		}
+		if spotlike.GlobalOps.DryRun {
+			unlikeExecutedArtists = append(unlikeExecutedArtists, gAucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with unliking " + gAucoDto.Name + " (" + gAucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with unliking " + gAucoDto.Name + " (" + gAucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking the remaining artists...")); err != nil {
+					return err
+				}
+				for _, remaining := range unlikeTargetArtists[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("artist", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled unliking artist " + gAucoDto.Name + " (" + gAucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking artist "+gAucoDto.Name+" ("+gAucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := uAuc.Run(cmd.Context(), gAucoDto.ID); err != nil {
+		if err := uAuc.Run(ctx, gAucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "artist",
+				ID:          gAucoDto.ID,
+				Name:        gAucoDto.Name,
+				Action:      "unlike",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("artist", gAucoDto.ID, gAucoDto.Name, "unlike", spotlikeApp.OperationResultStatusUnliked, nil))
 		unlikeExecutedArtists = append(unlikeExecutedArtists, gAucoDto)
 	}
+	progress.Done()
 
-	if len(unlikeExecutedArtists) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(unlikeArtistOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(unlikeExecutedArtists)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅💔🎤 Successfully unliked artists below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if unlikeArtistOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(unlikeExecutedArtists) != 0 {
+		message := formatter.Green("✅💔🎤 Successfully unliked artists below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪💔🎤 Artists below would be unliked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
+// runUnlikeArtistPurge unfollows the artists and unlikes all the albums and the tracks by them in the library.
+func runUnlikeArtistPurge(
+	exit func(int),
+	cmd *c.Command,
+	output *string,
+	w io.Writer,
+	progress *presenter.ProgressReporter,
+	gAucoDtos []*spotlikeApp.GetArtistUseCaseOutputDto,
+	results []*spotlikeApp.OperationResultDto,
+) error {
+	var changes []*spotlikeApp.PlanPurgeArtistsUseCaseOutputDto
+	if len(gAucoDtos) != 0 {
+		ppauc := spotlikeApp.NewPlanPurgeArtistsUseCase(spotlike.NewArtistRepository(), spotlike.NewAlbumRepository(), spotlike.NewTrackRepository())
+		ppaucoDtos, err := ppauc.Run(spotlike.WithProgress(cmd.Context(), progress), gAucoDtos)
+		progress.Done()
+		if err != nil {
+			return err
+		}
+		changes = ppaucoDtos
+	}
+	if len(changes) == 0 && len(results) == 0 {
+		o := formatter.Yellow("⚡ Nothing to unlike by the artists in your library...")
+		*output = o
+		spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+		return nil
+	}
+
+	if len(changes) != 0 {
+		if err := presenter.Print(w, "\n"+purgeDiff(changes)); err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "📋 "+purgeSummary(changes)+" to unlike."); err != nil {
+			return err
+		}
+	}
+
+	if len(changes) != 0 && !unlikeArtistOps.NoConfirm && !spotlike.GlobalOps.DryRun {
+		if answer, err := presenter.RunPrompt(
+			"Proceed with unliking " + fmt.Sprint(len(changes)) + " contents by the artists above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled purging artists...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+	}
+
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	purged := 0
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, change := range changes {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled unliking the remaining contents...")); err != nil {
+				return err
+			}
+			for _, remaining := range changes[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto(remaining.Type, remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		progress.Report("contents unliked", i, len(changes))
+		if spotlike.GlobalOps.DryRun {
+			purged++
+			results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, "unlike", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if err := spotlike.ApplyAction(ctx, "unlike", change.Type, change.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        change.Type,
+				ID:          change.ID,
+				Name:        change.Name,
+				Action:      "unlike",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
+			return err
+		}
+
+		purged++
+		results = append(results, spotlikeApp.NewOperationResultDto(change.Type, change.ID, change.Name, "unlike", spotlikeApp.OperationResultStatusUnliked, nil))
+	}
+	progress.Done()
+
+	f, err := formatter.NewFormatter(unlikeArtistOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+	o, err := f.Format(results)
+	if err != nil {
+		return err
+	}
+	*output = "\n" + o
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if unlikeArtistOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if purged != 0 {
+		message := formatter.Green("✅💔🧹 Successfully unliked the contents by the artists below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪💔🧹 Contents by the artists below would be unliked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
+	return nil
+}
+
+// purgeDiff returns the contents to be unliked to purge the artists in the diff style.
+func purgeDiff(changes []*spotlikeApp.PlanPurgeArtistsUseCaseOutputDto) string {
+	var lines []string
+	for _, change := range changes {
+		line := "- " + change.Type + " " + change.Name + " (" + change.ID + ")"
+		if change.Type != "artist" {
+			line += " by " + change.Artists
+		}
+		lines = append(lines, formatter.Red(line))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// purgeSummary returns the numbers of the contents of each type to be unliked to purge the artists.
+func purgeSummary(changes []*spotlikeApp.PlanPurgeArtistsUseCaseOutputDto) string {
+	counts := map[string]int{}
+	for _, change := range changes {
+		counts[change.Type]++
+	}
+
+	return fmt.Sprint(counts["artist"]) + " artists, " + fmt.Sprint(counts["album"]) + " albums and " + fmt.Sprint(counts["track"]) + " tracks"
+}
+
 const (
 	// unlikeArtistHelpTemplate is the help template of the unlike artist command.
 	unlikeArtistHelpTemplate = `💔🎤 Unlike artists on Spotify by ID.
@@ -180,6 +513,14 @@ You can unlike artists on Spotify by ID.
 Before using this command,
 you need to get the ID of the artist you want to like by using the search command.
 
+Before unliking each artist, you would be asked to confirm.
+You can answer "a" to unlike all the remaining artists without confirming, or "q" to quit.
+If more artists than the confirm threshold are affected, you would be asked only once with the summary.
+
+If you specify the "--purge" option, all the albums and the tracks by the artists in your library are also unliked.
+They are found from your library, so the tracks by the artists on the albums by the other artists (e.g. compilations) are also unliked.
+Before purging, the contents to be unliked are shown and you would be asked to confirm once.
+
 ` + unlikeArtistUsageTemplate
 	// unlikeArtistUsageTemplate is the usage template of the unlike artist command.
 	unlikeArtistUsageTemplate = `Usage:
@@ -189,9 +530,17 @@ you need to get the ID of the artist you want to like by using the search comman
 
 Flags:
   --no-confirm  🚫 do not confirm before unliking the artist
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  --purge       🧹 also unlike all the albums and the tracks by the artist in your library
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for artist
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the artists (e.g. : "00DuPiLri3mNomvvM3nZvU 3B9O5mYYw89fFXkwKh7jCS")
 `
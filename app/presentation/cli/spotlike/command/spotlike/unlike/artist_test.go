@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	o "os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -149,11 +150,12 @@ func Test_runUnlikeArtist(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅💔🎤 Successfully unliked artists below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🎤ARTISTtest_artist_idtest_artist_nameTOTAL:1artists!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -164,7 +166,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().UnfollowArtist(ctx, spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -191,7 +193,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -205,6 +207,475 @@ func Test_runUnlikeArtist(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (all remaining items are confirmed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Green("✅💔🎤 Successfully unliked artists below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("a", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (confirmed once with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🎤ARTISTtest_artist_idtest_artist_nameTOTAL:1artists!" + formatter.Green("✅💔🎤 Successfully unliked artists below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 artists above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (quit the remaining items)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking the remaining artists..."),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikecanceledTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("q", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (cancelled with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🎤ARTISTtest_artist_idtest_artist_nameTOTAL:1artists!",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("🚫 Cancelled unliking artists..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 artists above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (dry run)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🧪💔🎤 Artists below would be unliked... (dry run)"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeplannedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.DryRun = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.DryRun = false
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (args is empty)",
 			fields: fields{
@@ -344,7 +815,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
 				api.GetClientManagerFunc = func() api.ClientManager {
 					return mockClientManager
 				}
@@ -358,6 +829,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 			cleanup: func() {
 				api.GetClientManagerFunc = origGetClientManagerFunc
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
@@ -410,12 +882,256 @@ func Test_runUnlikeArtist(t *testing.T) {
 				}
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to get artist)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						if err.Error() != "failed to get artist" {
+							t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (gAucoDto is nil)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartistunlikenot_foundTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (failed to check if artist is already liked)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeArtistCmd := NewUnlikeArtistCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+						if err.Error() != "failed to check if artist is already liked" {
+							t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, errors.New("failed to check if artist is already liked"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get artist)",
+			name: "negative testing (artist is not liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -446,20 +1162,27 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "failed to get artist" {
-							t.Errorf("Failed to run the unlikeArtist command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Blue("⏩ Artist test_artist_name (test_artist_id) is not liked. skipping..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeskippedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -490,10 +1213,11 @@ func Test_runUnlikeArtist(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (gAucoDto is nil)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Artist \"+gAucoDto.Name+\" (\"+gAucoDto.ID+\") \"+\"is not liked. skipping...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -524,18 +1248,29 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						if err.Error() != "Print() failed" {
+							t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						}
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -544,6 +1279,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 				mockHttp := proxy.NewMockHttp(mockCtrl)
 				mockRandstr := proxy.NewMockRandstr(mockCtrl)
 				mockUrl := proxy.NewMockUrl(mockCtrl)
+
 				cm := api.NewClientManager(
 					mockSpotify,
 					mockHttp,
@@ -561,16 +1297,23 @@ func Test_runUnlikeArtist(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, formatter.Blue("⏩ Artist "+"test_artist_name"+" ("+"test_artist_id"+") "+"is not liked. skipping...")) {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to check if artist is already liked)",
+			name: "negative testing (unlike cancelled with ^C)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -578,6 +1321,9 @@ func Test_runUnlikeArtist(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
+					exit = func(code int) {
+						// do nothing
+					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -601,13 +1347,11 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "failed to check if artist is already liked" {
-							t.Errorf("Failed to run the unlikeArtist command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -623,7 +1367,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, errors.New("failed to check if artist is already liked"))
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -649,15 +1393,24 @@ func Test_runUnlikeArtist(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				exit = o.Exit
+				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (artist is not liked)",
+			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -688,11 +1441,13 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						if err.Error() != "Print() failed" {
+							t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Blue("⏩ Artist test_artist_name (test_artist_id) is not liked. skipping..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -708,7 +1463,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -734,15 +1489,30 @@ func Test_runUnlikeArtist(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "\n") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
+				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Artist \"+gAucoDto.Name+\" (\"+gAucoDto.ID+\") \"+\"is not liked. skipping...\")) failed)",
+			name: "negative testing (unlike cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -795,7 +1565,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -804,7 +1574,6 @@ func Test_runUnlikeArtist(t *testing.T) {
 				mockHttp := proxy.NewMockHttp(mockCtrl)
 				mockRandstr := proxy.NewMockRandstr(mockCtrl)
 				mockUrl := proxy.NewMockUrl(mockCtrl)
-
 				cm := api.NewClientManager(
 					mockSpotify,
 					mockHttp,
@@ -822,8 +1591,14 @@ func Test_runUnlikeArtist(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, formatter.Blue("⏩ Artist "+"test_artist_name"+" ("+"test_artist_id"+") "+"is not liked. skipping...")) {
+					if strings.Contains(output, formatter.Yellow("🚫 Cancelled unliking...")) {
 						return errors.New("Print() failed")
 					}
 					return origPrint(writer, output)
@@ -833,11 +1608,13 @@ func Test_runUnlikeArtist(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
 				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C)",
+			name: "negative testing (presenter.Runprompt() failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -845,9 +1622,6 @@ func Test_runUnlikeArtist(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
-					exit = func(code int) {
-						// do nothing
-					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -871,11 +1645,13 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						if err.Error() != "failed to run prompt" {
+							t.Errorf("Failed to run the unlikeArtist command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -918,8 +1694,8 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -928,12 +1704,12 @@ func Test_runUnlikeArtist(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				exit = o.Exit
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
+			name: "negative testing (skipped to unlike artist)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -964,15 +1740,13 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "Print() failed" {
-							t.Errorf("Failed to run the unlikeArtist command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking artist test_artist_name (test_artist_id) ..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikecanceledTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
@@ -1013,28 +1787,22 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "\n") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
+			name: "negative testing (unlike artist failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1065,7 +1833,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "Print() failed" {
+						if err.Error() != "failed to unlike artist" {
 							t.Errorf("Failed to run the unlikeArtist command: %v", err)
 						}
 					}
@@ -1088,6 +1856,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(errors.New("failed to unlike artist"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1114,28 +1883,22 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, formatter.Yellow("🚫 Cancelled unliking...")) {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Runprompt() failed)",
+			name: "positive testing (keep going after unlike artist failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1166,17 +1929,16 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
-							t.Errorf("Failed to run the unlikeArtist command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeArtist command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikefailedfailedtounlikeartistTOTAL:1results!❌Failedtounlike1contentsbelow...artisttest_artist_name(test_artist_id):failedtounlikeartist",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
@@ -1189,6 +1951,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(errors.New("failed to unlike artist"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1215,21 +1978,23 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (skipped to unlike artist)",
+			name: "positive testing (retry the failed artist in the report)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1259,16 +2024,22 @@ func Test_runUnlikeArtist(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
+					if err := unlikeArtistCmd.RunE(cmd, []string{}); err != nil {
 						t.Errorf("Failed to run the unlikeArtist command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("🚫 Cancelled unliking artist test_artist_name (test_artist_id)..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikefailedfailedtounlikeartistTOTAL:1results!❌Failedtounlike1contentsbelow...artisttest_artist_name(test_artist_id):failedtounlikeartist",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				report := filepath.Join(t.TempDir(), "report.json")
+				if err := o.WriteFile(report, []byte(`[{"type":"artist","id":"test_artist_id","name":"test_artist_name","action":"unlike","status":"failed","error":"failed to unlike artist"}]`), 0600); err != nil {
+					t.Errorf("Failed to create a report: %v", err)
+				}
+				spotlike.GlobalOps.RetryFailed = report
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
@@ -1281,6 +2052,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(errors.New("failed to unlike artist"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1307,13 +2079,15 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.RetryFailed = ""
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -1322,7 +2096,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (unlike artist failed)",
+			name: "negative testing (failed to record the operation)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1353,7 +2127,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); err != nil {
-						if err.Error() != "failed to unlike artist" {
+						if !strings.Contains(err.Error(), "not a directory") {
 							t.Errorf("Failed to run the unlikeArtist command: %v", err)
 						}
 					}
@@ -1364,8 +2138,14 @@ func Test_runUnlikeArtist(t *testing.T) {
 			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				file := filepath.Join(t.TempDir(), "file")
+				if err := o.WriteFile(file, []byte{}, 0600); err != nil {
+					t.Errorf("Failed to create a file: %v", err)
+				}
+				spotlike.GlobalOps.DataDir = filepath.Join(file, "spotlike")
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -1376,7 +2156,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().UnfollowArtist(ctx, spotify.ID("test_artist_id")).Return(errors.New("failed to unlike artist"))
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1403,17 +2183,19 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.DataDir = ""
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
@@ -1462,6 +2244,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -1472,7 +2255,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().UnfollowArtist(ctx, spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1499,7 +2282,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -1559,6 +2342,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -1569,7 +2353,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().UnfollowArtist(ctx, spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1596,7 +2380,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -1657,11 +2441,12 @@ func Test_runUnlikeArtist(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "🆔ID🎤ARTISTtest_artist_idtest_artist_nameTOTAL:1artists!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_artist_idartisttest_artist_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -1672,7 +2457,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().CurrentUserFollows(ctx, "artist", gomock.Any()).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().UnfollowArtist(ctx, spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().UnfollowArtist(context.WithoutCancel(ctx), spotify.ID("test_artist_id")).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1699,7 +2484,7 @@ func Test_runUnlikeArtist(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_artist_name (test_artist_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -1759,3 +2544,256 @@ func Test_runUnlikeArtist(t *testing.T) {
 		})
 	}
 }
+
+func Test_runUnlikeArtistPurge(t *testing.T) {
+	os := proxy.NewOs()
+	stdBuffer := proxy.NewBuffer()
+	errBuffer := proxy.NewBuffer()
+	output := ""
+	exit := o.Exit
+	origUnlikeArtistOps := unlikeArtistOps
+	origGlobalOps := spotlike.GlobalOps
+	su := utility.NewStringsUtil()
+	origPu := presenter.Pu
+	runPurge := func(wantErr bool) func() {
+		return func() {
+			authCmd := spotlike.NewAuthCommand(
+				exit,
+				proxy.NewCobra(),
+				"v0.0.0",
+				&config.SpotlikeCliConfig{
+					SpotlikeConfig: baseconfig.SpotlikeConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				},
+				&output,
+			)
+			unlikeArtistCmd := NewUnlikeArtistCommand(
+				exit,
+				proxy.NewCobra(),
+				authCmd,
+				&output,
+			)
+			unlikeArtistOps.Purge = true
+			cmd := &c.Command{}
+			cmd.SetContext(context.Background())
+			if err := unlikeArtistCmd.RunE(cmd, []string{"test_artist_id"}); (err != nil) != wantErr {
+				t.Errorf("Failed to run the unlikeArtist command: %v", err)
+			}
+		}
+	}
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	artist := spotify.SimpleArtist{
+		ID:   "test_artist_id",
+		Name: "test_artist_name",
+	}
+	otherArtist := spotify.SimpleArtist{
+		ID:   "test_other_artist_id",
+		Name: "test_other_artist_name",
+	}
+	expectPlan := func(mockSpotifyClient *proxy.MockClient, followed bool, liked bool) {
+		mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(&spotify.FullArtist{SimpleArtist: artist}, nil)
+		mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return([]bool{followed}, nil)
+		albums := &spotify.SavedAlbumPage{}
+		tracks := &spotify.SavedTrackPage{}
+		if liked {
+			albums.Albums = []spotify.SavedAlbum{
+				{FullAlbum: spotify.FullAlbum{SimpleAlbum: spotify.SimpleAlbum{ID: "test_album_id", Name: "test_album_name", Artists: []spotify.SimpleArtist{artist}}}},
+				{FullAlbum: spotify.FullAlbum{SimpleAlbum: spotify.SimpleAlbum{ID: "test_other_album_id", Name: "test_other_album_name", Artists: []spotify.SimpleArtist{otherArtist}}}},
+			}
+			tracks.Tracks = []spotify.SavedTrack{
+				{FullTrack: spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: "test_track_id", Name: "test_track_name", Artists: []spotify.SimpleArtist{otherArtist, artist}}}},
+			}
+		}
+		mockSpotifyClient.EXPECT().CurrentUsersAlbums(gomock.Any(), gomock.Any(), gomock.Any()).Return(albums, nil)
+		mockSpotifyClient.EXPECT().CurrentUsersTracks(gomock.Any(), gomock.Any(), gomock.Any()).Return(tracks, nil)
+	}
+	expectPrompt := func(mockCtrl *gomock.Controller, answer string) {
+		mockPrompt := proxy.NewMockPrompt(mockCtrl)
+		mockPrompt.EXPECT().SetLabel("Proceed with unliking 3 contents by the artists above ? [y/N]")
+		mockPrompt.EXPECT().Run().Return(answer, nil)
+		mockPromptui := proxy.NewMockPromptui(mockCtrl)
+		mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+		presenter.Pu = utility.NewPromptUtil(mockPromptui)
+	}
+	plan := formatter.Red("- artist test_artist_name (test_artist_id)") +
+		formatter.Red("- album test_album_name (test_album_id) by test_artist_name") +
+		formatter.Red("- track test_track_name (test_track_id) by test_other_artist_name, test_artist_name") +
+		"📋 1 artists, 1 albums and 1 tracks to unlike."
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		unlikeArtistOps = origUnlikeArtistOps
+		spotlike.GlobalOps = origGlobalOps
+		presenter.Pu = origPu
+		output = ""
+		spotlike.SetExitCode(spotlike.ExitCodeOk)
+	}
+
+	tests := []struct {
+		name         string
+		fnc          func()
+		wantStdOut   string
+		wantOutput   string
+		wantExitCode int
+		setup        func(mockCtrl *gomock.Controller)
+	}{
+		{
+			name:       "positive testing",
+			fnc:        runPurge(false),
+			wantStdOut: plan + formatter.Green("✅💔🧹 Successfully unliked the contents by the artists below!"),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERROR" +
+				"test_artist_idartisttest_artist_nameunlikeunliked" +
+				"test_album_idalbumtest_album_nameunlikeunliked" +
+				"test_track_idtracktest_track_nameunlikeunliked" +
+				"TOTAL:3results!",
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, true, true)
+				mockSpotifyClient.EXPECT().UnfollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(gomock.Any(), spotify.ID("test_album_id")).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				expectPrompt(mockCtrl, "y")
+			},
+		},
+		{
+			name:       "positive testing (dry run)",
+			fnc:        runPurge(false),
+			wantStdOut: plan + formatter.Yellow("🧪💔🧹 Contents by the artists below would be unliked... (dry run)"),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERROR" +
+				"test_artist_idartisttest_artist_nameunlikeplanned" +
+				"test_album_idalbumtest_album_nameunlikeplanned" +
+				"test_track_idtracktest_track_nameunlikeplanned" +
+				"TOTAL:3results!",
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.DryRun = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, true)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name:         "positive testing (nothing to unlike)",
+			fnc:          runPurge(false),
+			wantStdOut:   "",
+			wantOutput:   formatter.Yellow("⚡ Nothing to unlike by the artists in your library..."),
+			wantExitCode: spotlike.ExitCodeNothingToDo,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, false, false)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name:         "negative testing (cancelled with the summary)",
+			fnc:          runPurge(false),
+			wantStdOut:   plan,
+			wantOutput:   formatter.Yellow("🚫 Cancelled purging artists..."),
+			wantExitCode: spotlike.ExitCodeCanceled,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				expectPlan(mockSpotifyClient, true, true)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				expectPrompt(mockCtrl, "n")
+			},
+		},
+		{
+			name:         "negative testing (failed to plan)",
+			fnc:          runPurge(true),
+			wantStdOut:   "",
+			wantOutput:   "",
+			wantExitCode: spotlike.ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(&spotify.FullArtist{SimpleArtist: artist}, nil)
+				mockSpotifyClient.EXPECT().CurrentUserFollows(gomock.Any(), "artist", spotify.ID("test_artist_id")).Return(nil, errors.New("failed to check the artists"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name:       "positive testing (keep going after unlike album failed)",
+			fnc:        runPurge(false),
+			wantStdOut: plan + formatter.Green("✅💔🧹 Successfully unliked the contents by the artists below!"),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERROR" +
+				"test_artist_idartisttest_artist_nameunlikeunliked" +
+				"test_album_idalbumtest_album_nameunlikefailedfailedtounlike" +
+				"test_track_idtracktest_track_nameunlikeunliked" +
+				"TOTAL:3results!" +
+				"❌Failedtounlike1contentsbelow...albumtest_album_name(test_album_id):failedtounlike",
+			wantExitCode: spotlike.ExitCodePartialFailure,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.KeepGoing = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				expectPlan(mockSpotifyClient, true, true)
+				mockSpotifyClient.EXPECT().UnfollowArtist(gomock.Any(), spotify.ID("test_artist_id")).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveAlbumsFromLibrary(gomock.Any(), spotify.ID("test_album_id")).Return(errors.New("failed to unlike"))
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(gomock.Any(), spotify.ID("test_track_id")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+				expectPrompt(mockCtrl, "y")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			spotlike.SetExitCode(spotlike.ExitCodeOk)
+			spotlike.GlobalOps.NoCache = true
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer cleanup()
+			c := utility.NewCapturer(os, stdBuffer, errBuffer)
+			gotStdOut, _, err := c.CaptureOutput(tt.fnc)
+			if err != nil {
+				t.Errorf("Capturer.CaptureOutput() error = %v", err)
+				return
+			}
+			cleanGotStdOut := su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(gotStdOut)))
+			cleanWantStdOut := su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(tt.wantStdOut)))
+			if cleanGotStdOut != cleanWantStdOut {
+				t.Errorf("runUnlikeArtistPurge() gotStdOut = %v, want %v", cleanGotStdOut, cleanWantStdOut)
+			}
+			cleanOutput := su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(output)))
+			cleanWantOutput := su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(tt.wantOutput)))
+			if cleanOutput != cleanWantOutput {
+				t.Errorf("Output = %v, want %v", cleanOutput, cleanWantOutput)
+			}
+			if got := spotlike.GetExitCode(); got != tt.wantExitCode {
+				t.Errorf("runUnlikeArtistPurge() exit code = %v, want %v", got, tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -1,14 +1,19 @@
 package unlike
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	c "github.com/spf13/cobra"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
 	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command/spotlike"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
 
@@ -72,7 +77,7 @@ func NewUnlikeTrackCommand(
 		"format",
 		"f",
 		"table",
-		"📝 format of the output (default \"table\", e.g: \"plain\")",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
 	)
 
 	cmd.SetRunE(
@@ -86,6 +91,25 @@ func NewUnlikeTrackCommand(
 
 // runUnlikeTrack executes the unlike track command.
 func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if spotlike.GlobalOps.RetryFailed != "" {
+		ids, err := spotlike.LoadFailedIds(spotlike.GlobalOps.RetryFailed, "track", "unlike")
+		if err != nil {
+			o := formatter.Red("❌ Failed to load the report...")
+			*output = o
+			return err
+		}
+		if len(ids) == 0 {
+			o := formatter.Yellow("⚡ No failed tracks found in the report...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNothingToDo)
+			return nil
+		}
+		// retry only the failed tracks ignoring the flags to specify the parent
+		unlikeTrackOps.Artist = ""
+		unlikeTrackOps.Album = ""
+		args = ids
+	}
+
 	if unlikeTrackOps.Artist != "" && unlikeTrackOps.Album != "" {
 		o := formatter.Yellow("⚡ Both artist and album flags can not be specified at the same time...")
 		*output = o
@@ -105,7 +129,7 @@ func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		return nil
 	}
 	_, err := clientManager.GetClient()
-	if err != nil && err.Error() == "client not initialized" {
+	if err != nil && errors.Is(err, api.ErrNotAuthenticated) {
 		if err := authCmd.RunE(cmd, args); err != nil {
 			return err
 		}
@@ -115,24 +139,29 @@ func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		return err
 	}
 
+	w := spotlike.MessageWriter(unlikeTrackOps.Format)
+	progress := spotlike.NewProgressReporter(unlikeTrackOps.Format)
+	var results []*spotlikeApp.OperationResultDto
 	var gtucoDtos []*spotlikeApp.GetTrackUseCaseOutputDto
-	trackRepo := repository.NewTrackRepository()
+	trackRepo := spotlike.NewTrackRepository()
 	if unlikeTrackOps.Artist != "" {
-		artistRepo := repository.NewArtistRepository()
+		artistRepo := spotlike.NewArtistRepository()
 		gAuc := spotlikeApp.NewGetArtistUseCase(artistRepo)
 		gAucoDto, err := gAuc.Run(cmd.Context(), unlikeTrackOps.Artist)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gAucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + unlikeTrackOps.Artist + " is not found or it is not an artist...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
 		gatAuc := spotlikeApp.NewGetAllTracksByArtistIdUseCase(trackRepo)
-		gatAucoDtos, err := gatAuc.Run(cmd.Context(), unlikeTrackOps.Artist)
+		gatAucoDtos, err := gatAuc.Run(spotlike.WithProgress(cmd.Context(), progress), unlikeTrackOps.Artist)
+		progress.Done()
 		if err != nil {
 			return err
 		}
@@ -151,16 +180,17 @@ func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 			)
 		}
 	} else if unlikeTrackOps.Album != "" {
-		albumRepo := repository.NewAlbumRepository()
+		albumRepo := spotlike.NewAlbumRepository()
 		gauc := spotlikeApp.NewGetAlbumUseCase(albumRepo)
 		gaucoDto, err := gauc.Run(cmd.Context(), unlikeTrackOps.Album)
-		if err != nil && err.Error() != "Resource not found" {
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
 			return err
 		}
 
 		if gaucoDto == nil {
 			o := formatter.Yellow("⚡ The id " + unlikeTrackOps.Album + " is not found or it is not an album...")
 			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeNotFound)
 			return nil
 		}
 
@@ -187,13 +217,19 @@ func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 		for _, id := range args {
 			gtuc := spotlikeApp.NewGetTrackUseCase(trackRepo)
 			gtucoDto, err := gtuc.Run(cmd.Context(), id)
-			if err != nil && err.Error() != "Resource not found" {
-				return err
+			if err != nil && !errors.Is(err, api.ErrNotFound) {
+				if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", id, "", "unlike", spotlikeApp.OperationResultStatusFailed, err))
+				continue
 			}
 
 			if gtucoDto == nil {
-				o := formatter.Yellow("⚡ The id " + id + " is not found or it is not a track...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("⚡ The id "+id+" is not found or it is not a track...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", id, "", "unlike", spotlikeApp.OperationResultStatusNotFound, nil))
 				continue
 			}
 
@@ -202,65 +238,185 @@ func runUnlikeTrack(exit func(int), cmd *c.Command, authCmd proxy.Command, outpu
 	}
 
 	cltuc := spotlikeApp.NewCheckLikeTrackUseCase(trackRepo)
-	utuc := spotlikeApp.NewUnlikeTrackUseCase(trackRepo)
-	var likeExecutedTracks []*spotlikeApp.GetTrackUseCaseOutputDto
+	var unlikeTargetTracks []*spotlikeApp.GetTrackUseCaseOutputDto
 	for _, gtucoDto := range gtucoDtos {
 		alreadyLiked, err := cltuc.Run(cmd.Context(), gtucoDto.ID)
 		if err != nil {
-			return err
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
 		}
 		if !alreadyLiked {
-			if err := presenter.Print(os.Stdout, formatter.Blue("⏩ Track #"+fmt.Sprint(gtucoDto.TrackNumber)+" "+gtucoDto.Name+" ("+gtucoDto.ID+")"+" on "+gtucoDto.Album+" rereased by "+gtucoDto.Artists+" is not liked. skipping...")); err != nil {
+			if err := presenter.Print(w, formatter.Blue("⏩ Track #"+fmt.Sprint(gtucoDto.TrackNumber)+" "+gtucoDto.Name+" ("+gtucoDto.ID+")"+" on "+gtucoDto.Album+" rereased by "+gtucoDto.Artists+" is not liked. skipping...")); err != nil {
 				return err
 			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusSkipped, nil))
 			continue
 		}
 
-		if !unlikeTrackOps.NoConfirm {
+		unlikeTargetTracks = append(unlikeTargetTracks, gtucoDto)
+	}
+
+	noConfirm := unlikeTrackOps.NoConfirm || spotlike.GlobalOps.DryRun
+	if !noConfirm && len(unlikeTargetTracks) > spotlike.GlobalOps.ConfirmThreshold {
+		f, err := formatter.NewFormatter(unlikeTrackOps.Format)
+		if err != nil {
+			o := formatter.Red("❌ Failed to create a formatter...")
+			*output = o
+			return err
+		}
+		o, err := f.Format(unlikeTargetTracks)
+		if err != nil {
+			return err
+		}
+		if err := presenter.Print(w, "\n"+o); err != nil {
+			return err
+		}
+		if answer, err := presenter.RunPrompt(
+			"Proceed with unliking " + fmt.Sprint(len(unlikeTargetTracks)) + " tracks above ? [y/N]",
+		); err != nil && errors.Is(err, api.ErrCanceled) {
+			if err := presenter.Print(w, "\n"); err != nil {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				return err
+			}
+			exit(spotlike.ExitCodeCanceled)
+			return nil
+		} else if err != nil {
+			return err
+		} else if answer != "y" && answer != "Y" {
+			o := formatter.Yellow("🚫 Cancelled unliking tracks...")
+			*output = o
+			spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+			return nil
+		}
+		noConfirm = true
+	}
+
+	utuc := spotlikeApp.NewUnlikeTrackUseCase(trackRepo)
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(spotlike.GlobalOps.DataDir), repository.NewUserRepository())
+	var likeExecutedTracks []*spotlikeApp.GetTrackUseCaseOutputDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, gtucoDto := range unlikeTargetTracks {
+		if cmd.Context().Err() != nil {
+			progress.Done()
+			if err := presenter.Print(w, formatter.Yellow("🚫 Interrupted. Cancelled unliking the remaining tracks...")); err != nil {
+				return err
+			}
+			for _, remaining := range unlikeTargetTracks[i:] {
+				results = append(results, spotlikeApp.NewOperationResultDto("track", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+			}
+			break
+		}
+		if noConfirm {
+			progress.Report("tracks unliked", i, len(unlikeTargetTracks))
+		}
+		if spotlike.GlobalOps.DryRun {
+			likeExecutedTracks = append(likeExecutedTracks, gtucoDto)
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if !noConfirm {
 			if answer, err := presenter.RunPrompt(
-				"Proceed with unliking " + gtucoDto.Name + " (" + gtucoDto.ID + ") ? [y/N]",
-			); err != nil && err.Error() == "^C" {
-				if err := presenter.Print(os.Stdout, "\n"); err != nil {
+				"Proceed with unliking " + gtucoDto.Name + " (" + gtucoDto.ID + ") ? [y/N/a/q]",
+			); err != nil && errors.Is(err, api.ErrCanceled) {
+				if err := presenter.Print(w, "\n"); err != nil {
 					return err
 				}
-				if err := presenter.Print(os.Stdout, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking...")); err != nil {
 					return err
 				}
-				exit(130)
+				exit(spotlike.ExitCodeCanceled)
 				return nil
 			} else if err != nil {
 				return err
+			} else if answer == "q" || answer == "Q" {
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking the remaining tracks...")); err != nil {
+					return err
+				}
+				for _, remaining := range unlikeTargetTracks[i:] {
+					results = append(results, spotlikeApp.NewOperationResultDto("track", remaining.ID, remaining.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+				break
+			} else if answer == "a" || answer == "A" {
+				noConfirm = true
 			} else if answer != "y" && answer != "Y" {
-				o := formatter.Yellow("🚫 Cancelled unliking track" + gtucoDto.Name + " (" + gtucoDto.ID + ") ...")
-				*output = o
+				if err := presenter.Print(w, formatter.Yellow("🚫 Cancelled unliking track "+gtucoDto.Name+" ("+gtucoDto.ID+") ...")); err != nil {
+					return err
+				}
+				results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusCanceled, nil))
 				continue
 			}
 		}
 
-		if err := utuc.Run(cmd.Context(), gtucoDto.ID); err != nil {
+		if err := utuc.Run(ctx, gtucoDto.ID); err != nil {
+			if !spotlike.GlobalOps.KeepGoing || errors.Is(err, api.ErrCanceled) {
+				return err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        "track",
+				ID:          gtucoDto.ID,
+				Name:        gtucoDto.Name,
+				Action:      "unlike",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
 			return err
 		}
 
+		results = append(results, spotlikeApp.NewOperationResultDto("track", gtucoDto.ID, gtucoDto.Name, "unlike", spotlikeApp.OperationResultStatusUnliked, nil))
 		likeExecutedTracks = append(likeExecutedTracks, gtucoDto)
 	}
+	progress.Done()
 
-	if len(likeExecutedTracks) != 0 {
+	if len(results) != 0 {
 		f, err := formatter.NewFormatter(unlikeTrackOps.Format)
 		if err != nil {
 			o := formatter.Red("❌ Failed to create a formatter...")
 			*output = o
 			return err
 		}
-		o, err := f.Format(likeExecutedTracks)
+		o, err := f.Format(results)
 		if err != nil {
 			return err
 		}
 		*output = "\n" + o
-		if err := presenter.Print(os.Stdout, formatter.Green("✅💔🎵 Successfully unliked tracks below!")); err != nil {
+	}
+	if failed := spotlike.FailedResultsMessage(results); failed != "" {
+		if unlikeTrackOps.Format == "json" {
+			if err := presenter.Print(w, failed); err != nil {
+				return err
+			}
+		} else {
+			*output += "\n" + failed
+		}
+	}
+	if len(likeExecutedTracks) != 0 {
+		message := formatter.Green("✅💔🎵 Successfully unliked tracks below!")
+		if spotlike.GlobalOps.DryRun {
+			message = formatter.Yellow("🧪💔🎵 Tracks below would be unliked... (dry run)")
+		}
+		if err := presenter.Print(w, message); err != nil {
 			return err
 		}
 	}
 
+	if cmd.Context().Err() != nil {
+		spotlike.SetExitCode(spotlike.ExitCodeCanceled)
+	} else {
+		spotlike.SetExitCode(spotlike.ResultsExitCode(results))
+	}
+
 	return nil
 }
 
@@ -279,6 +435,10 @@ Also, you can unlike all tracks in the album with specifying the ID of the album
 If you specify album flag, the arguments would be ignored.
 Both artist and album flags can not be specified at the same time.
 
+Before unliking each track, you would be asked to confirm.
+You can answer "a" to unlike all the remaining tracks without confirming, or "q" to quit.
+If more tracks than the confirm threshold are affected, you would be asked only once with the summary.
+
 ` + unlikeTrackUsageTemplate
 	// unlikeTrackUsageTemplate is the usage template of the unlike track command.
 	unlikeTrackUsageTemplate = `Usage:
@@ -290,9 +450,16 @@ Flags:
   -A, --artist  🆔 an ID of the artist to unlike all albums released by the artist
   -a, --album   🆔 an ID of the album to unlike all tracks in the album
   --no-confirm  🚫 do not confirm before unliking the track
-  -f, --format  📝 format of the output (default "table", e.g: "plain")
+  -f, --format  📝 format of the output (default "table", e.g: "plain", "json")
   -h, --help    🤝 help for track
 
+Global Flags:
+  --dry-run            🧪 show the plan of like and unlike without executing it
+  --confirm-threshold  📋 confirm only once with the summary when more items than this are affected (default 10)
+  --keep-going         🏃 keep liking and unliking the remaining items even if some of them failed
+  --retry-failed       🔁 retry liking and unliking only the failed items in the report saved with the json format
+  -q, --quiet          🤫 do not show the progress of long running operations
+
 Arguments:
   ID  🆔 ID of the tracks (e.g: " ")
 `
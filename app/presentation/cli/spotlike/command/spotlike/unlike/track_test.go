@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	o "os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -147,11 +148,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅💔 🎵 Successfully unliked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
 					&spotify.FullArtist{
 						SimpleArtist: spotify.SimpleArtist{
@@ -161,7 +163,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
 					&spotify.SimpleAlbumPage{
 						Albums: []spotify.SimpleAlbum{
 							{
@@ -199,7 +201,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -226,7 +228,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -241,6 +243,542 @@ func Test_runUnlikeTrack(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (all remaining items are confirmed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unliketrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Green("✅💔 🎵 Successfully unliked tracks below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("a", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "positive testing (confirmed once with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unliketrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!" + formatter.Green("✅💔 🎵 Successfully unliked tracks below!"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 tracks above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (quit the remaining items)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unliketrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking the remaining tracks..."),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikecanceledTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("q", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (cancelled with the summary)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unliketrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("🚫 Cancelled unliking tracks..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.ConfirmThreshold = 0
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.SimpleTrackPage{
+						Tracks: []spotify.SimpleTrack{
+							{
+								ID:   "test_track_id",
+								Name: "test_track_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								TrackNumber: 1,
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking 1 tracks above ? [y/N]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.ConfirmThreshold = 10
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "positive testing (album option is set)",
 			fields: fields{
@@ -280,11 +818,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅💔🎵 Successfully unliked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
 					&spotify.FullAlbum{
 						SimpleAlbum: spotify.SimpleAlbum{
@@ -321,7 +860,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -348,7 +887,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -401,11 +940,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: formatter.Green("✅💔 🎵 Successfully unliked tracks below!"),
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -435,7 +975,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -462,7 +1002,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -476,6 +1016,114 @@ func Test_runUnlikeTrack(t *testing.T) {
 				output = ""
 			},
 		},
+		{
+			name: "positive testing (dry run)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: formatter.Yellow("🧪💔🎵 Tracks below would be unliked... (dry run)"),
+			wantStdErr: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeplannedTOTAL:1results!",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.DryRun = true
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
+				}
+			},
+			cleanup: func() {
+				spotlike.GlobalOps.DryRun = false
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				presenter.Pu = origPu
+				output = ""
+			},
+		},
 		{
 			name: "negative testing (both artist and album options are set)",
 			fields: fields{
@@ -514,17 +1162,173 @@ func Test_runUnlikeTrack(t *testing.T) {
 					}
 				},
 			},
-			wantStdOut: "",
-			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ Both artist and album flags can not be specified at the same time..."),
-			wantErr:    false,
-			setup:      nil,
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("⚡ Both artist and album flags can not be specified at the same time..."),
+			wantErr:    false,
+			setup:      nil,
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (both artist and album options are not set and args is empty)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Yellow("⚡ No ID arguments specified..."),
+			wantErr:    false,
+			setup:      nil,
+			cleanup: func() {
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (clientManager is nil)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "test_client_id",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: formatter.Red("❌ Client manager is not initialized..."),
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return nil
+				}
+			},
+			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				output = ""
+			},
+		},
+		{
+			name: "negative testing (client not initialized and authCmd.RunE() failed)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					authCmd := spotlike.NewAuthCommand(
+						exit,
+						proxy.NewCobra(),
+						"v0.0.0",
+						&config.SpotlikeCliConfig{
+							SpotlikeConfig: baseconfig.SpotlikeConfig{
+								SpotifyID:           "",
+								SpotifySecret:       "test_client_secret",
+								SpotifyRedirectUri:  "test_redirect_uri",
+								SpotifyRefreshToken: "test_refresh_token",
+							},
+						},
+						&output,
+					)
+					unlikeTrackCmd := NewUnlikeTrackCommand(
+						exit,
+						proxy.NewCobra(),
+						authCmd,
+						&output,
+					)
+					cmd := &c.Command{}
+					cmd.SetContext(context.Background())
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to run prompt" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
+					}
+				},
+			},
+			wantStdOut: "",
+			wantStdErr: "",
+			wantOutput: "",
+			wantErr:    false,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, api.ErrClientNotInitialized).AnyTimes()
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return mockClientManager
+				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+			},
 			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (both artist and album options are not set and args is empty)",
+			name: "negative testing (failed to get client)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -554,22 +1358,31 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to get client" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ No ID arguments specified..."),
+			wantOutput: formatter.Red("❌ Failed to get client..."),
 			wantErr:    false,
-			setup:      nil,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockClientManager := api.NewMockClientManager(mockCtrl)
+				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client")).AnyTimes()
+				api.GetClientManagerFunc = func() api.ClientManager {
+					return mockClientManager
+				}
+			},
 			cleanup: func() {
+				api.GetClientManagerFunc = origGetClientManagerFunc
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (clientManager is nil)",
+			name: "negative testing (failed to get artist)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -599,27 +1412,58 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get artist" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Red("❌ Client manager is not initialized..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return nil
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (client not initialized and authCmd.RunE() failed)",
+			name: "negative testing (gAucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -633,7 +1477,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 						"v0.0.0",
 						&config.SpotlikeCliConfig{
 							SpotlikeConfig: baseconfig.SpotlikeConfig{
-								SpotifyID:           "",
+								SpotifyID:           "test_client_id",
 								SpotifySecret:       "test_client_secret",
 								SpotifyRedirectUri:  "test_redirect_uri",
 								SpotifyRefreshToken: "test_refresh_token",
@@ -649,37 +1493,56 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
-							t.Errorf("Failed to run the unlikeTrack command: %v", err)
-						}
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("client not initialized")).AnyTimes()
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return mockClientManager
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
-				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("🆔 Input your Spotify Client ID")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
-				mockPromptui := proxy.NewMockPromptui(mockCtrl)
-				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
-				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
-				presenter.Pu = origPu
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get client)",
+			name: "negative testing (failed to get all tracks by artist id)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -709,8 +1572,9 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to get client" {
+					unlikeTrackOps.Artist = "test_artist_id"
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to get all tracks by artist id" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -718,22 +1582,76 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Red("❌ Failed to get client..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
-				mockClientManager := api.NewMockClientManager(mockCtrl)
-				mockClientManager.EXPECT().GetClient().Return(nil, errors.New("failed to get client")).AnyTimes()
-				api.GetClientManagerFunc = func() api.ClientManager {
-					return mockClientManager
+				ctx := context.Background()
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
+					&spotify.FullArtist{
+						SimpleArtist: spotify.SimpleArtist{
+							ID:   "test_artist_id",
+							Name: "test_artist_name",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(
+					&spotify.SimpleAlbumPage{
+						Albums: []spotify.SimpleAlbum{
+							{
+								ID:   "test_album_id",
+								Name: "test_album_name",
+								Artists: []spotify.SimpleArtist{
+									{
+										ID:   "test_artist_id",
+										Name: "test_artist_name",
+									},
+								},
+								ReleaseDate:          "2000-01-01",
+								ReleaseDatePrecision: "day",
+							},
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by artist id"))
+				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+				mockSpotify := proxy.NewMockSpotify(mockCtrl)
+				mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+				mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+				mockHttp := proxy.NewMockHttp(mockCtrl)
+				mockRandstr := proxy.NewMockRandstr(mockCtrl)
+				mockUrl := proxy.NewMockUrl(mockCtrl)
+				cm := api.NewClientManager(
+					mockSpotify,
+					mockHttp,
+					mockRandstr,
+					mockUrl,
+				)
+				if err := cm.InitializeClient(
+					ctx,
+					&api.ClientConfig{
+						SpotifyID:           "test_client_id",
+						SpotifySecret:       "test_client_secret",
+						SpotifyRedirectUri:  "test_redirect_uri",
+						SpotifyRefreshToken: "test_refresh_token",
+					},
+				); err != nil {
+					t.Errorf("Failed to initialize client: %v", err)
 				}
 			},
 			cleanup: func() {
-				api.GetClientManagerFunc = origGetClientManagerFunc
+				if err := api.ResetClientManager(); err != nil {
+					t.Errorf("Failed to reset client manager: %v", err)
+				}
+				unlikeTrackOps = origUnlikeTrackOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get artist)",
+			name: "negative testing (failed to get album)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -763,9 +1681,9 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Artist = "test_artist_id"
+					unlikeTrackOps.Album = "test_album_id"
 					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get artist" {
+						if err.Error() != "failed to get album" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -778,7 +1696,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("failed to get artist"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -814,7 +1732,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (gAucoDto is nil)",
+			name: "negative testing (gaucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -844,7 +1762,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Artist = "test_artist_id"
+					unlikeTrackOps.Album = "test_album_id"
 					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
 						t.Errorf("Failed to run the unlikeTrack command: %v", err)
 					}
@@ -852,12 +1770,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_artist_id is not found or it is not an artist..."),
+			wantOutput: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -893,7 +1811,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (failed to get all tracks by artist id)",
+			name: "negative testing (failed to get all tracks by album id)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -923,9 +1841,9 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Artist = "test_artist_id"
+					unlikeTrackOps.Album = "test_album_id"
 					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get all tracks by artist id" {
+						if err.Error() != "failed to get all tracks by album id" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -938,35 +1856,24 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetArtist(ctx, spotify.ID("test_artist_id")).Return(
-					&spotify.FullArtist{
-						SimpleArtist: spotify.SimpleArtist{
-							ID:   "test_artist_id",
-							Name: "test_artist_name",
-						},
-					},
-					nil,
-				)
-				mockSpotifyClient.EXPECT().GetArtistAlbums(ctx, spotify.ID("test_artist_id"), nil).Return(
-					&spotify.SimpleAlbumPage{
-						Albums: []spotify.SimpleAlbum{
-							{
-								ID:   "test_album_id",
-								Name: "test_album_name",
-								Artists: []spotify.SimpleArtist{
-									{
-										ID:   "test_artist_id",
-										Name: "test_artist_name",
-									},
+				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
+					&spotify.FullAlbum{
+						SimpleAlbum: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
 								},
-								ReleaseDate:          "2000-01-01",
-								ReleaseDatePrecision: "day",
 							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
 						},
 					},
 					nil,
-				)
-				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by artist id"))
+				).AnyTimes()
+				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by album id"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1002,7 +1909,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (failed to get album)",
+			name: "negative testing (failed to get track)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1032,9 +1939,8 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Album = "test_album_id"
-					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get album" {
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to get track" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -1047,7 +1953,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get album"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("failed to get track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1078,12 +1984,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (gaucoDto is nil)",
+			name: "negative testing (gtucoDto is nil)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1113,20 +2019,21 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Album = "test_album_id"
-					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to get track" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("⚡ The id test_track_id is not found or it is not a track..."),
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_album_id is not found or it is not an album..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtrackunlikenot_foundTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, spotify.Error{Message: "Resource not found", Status: http.StatusNotFound})
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1157,12 +2064,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeTrackOps = origUnlikeTrackOps
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get all tracks by album id)",
+			name: "negative testing (failed to check if track is already liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1192,9 +2099,8 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					unlikeTrackOps.Album = "test_album_id"
-					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
-						if err.Error() != "failed to get all tracks by album id" {
+					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
+						if err.Error() != "failed to check if track is already liked" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -1207,9 +2113,20 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetAlbum(ctx, spotify.ID("test_album_id")).Return(
-					&spotify.FullAlbum{
-						SimpleAlbum: spotify.SimpleAlbum{
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
 							ID:   "test_album_id",
 							Name: "test_album_name",
 							Artists: []spotify.SimpleArtist{
@@ -1223,8 +2140,8 @@ func Test_runUnlikeTrack(t *testing.T) {
 						},
 					},
 					nil,
-				).AnyTimes()
-				mockSpotifyClient.EXPECT().GetAlbumTracks(ctx, spotify.ID("test_album_id")).Return(nil, errors.New("failed to get all tracks by album id"))
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, errors.New("failed to check if track is already liked"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1255,12 +2172,11 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				unlikeTrackOps = origUnlikeTrackOps
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to get track)",
+			name: "negative testing (track is not liked)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1291,20 +2207,46 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to get track" {
-							t.Errorf("Failed to run the unlikeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Blue("⏩ Track #" + "1" + " " + "test_track_name" + " (" + "test_track_id" + ")" + " on " + "test_album_name" + " rereased by " + "test_artist_name" + " is not liked. skipping..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeskippedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("failed to get track"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1335,12 +2277,11 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (gtucoDto is nil)",
+			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Track #\"+fmt.Sprint(gtucoDto.TrackNumber)+\" \"+gtucoDto.Name+\" (\"+gtucoDto.ID+\")\"+\" on \"+gtucoDto.Album+\" rereased by \"+gtucoDto.Artists+\" is not liked. skipping...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1371,7 +2312,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to get track" {
+						if err.Error() != "Print() failed" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -1379,12 +2320,40 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("⚡ The id test_track_id is not found or it is not a track..."),
+			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
-				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(nil, errors.New("Resource not found"))
+				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
+					&spotify.FullTrack{
+						SimpleTrack: spotify.SimpleTrack{
+							ID:   "test_track_id",
+							Name: "test_track_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							TrackNumber: 1,
+						},
+						Album: spotify.SimpleAlbum{
+							ID:   "test_album_id",
+							Name: "test_album_name",
+							Artists: []spotify.SimpleArtist{
+								{
+									ID:   "test_artist_id",
+									Name: "test_artist_name",
+								},
+							},
+							ReleaseDate:          "2000-01-01",
+							ReleaseDatePrecision: "day",
+						},
+					},
+					nil,
+				)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1410,17 +2379,23 @@ func Test_runUnlikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, formatter.Blue("⏩ Track #"+"1"+" "+"test_track_name"+" ("+"test_track_id"+")"+" on "+"test_album_name"+" rereased by "+"test_artist_name"+" is not liked. skipping...")) {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (failed to check if track is already liked)",
+			name: "negative testing (unlike cancelled with ^C)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1428,6 +2403,9 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
+					exit = func(code int) {
+						// do nothing
+					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -1451,13 +2429,11 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to check if track is already liked" {
-							t.Errorf("Failed to run the unlikeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1492,7 +2468,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, errors.New("failed to check if track is already liked"))
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1518,16 +2494,24 @@ func Test_runUnlikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				exit = o.Exit
+				presenter.Pu = origPu
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (track is not liked)",
+			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1558,11 +2542,13 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						if err.Error() != "Print() failed" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Blue("⏩ Track #" + "1" + " " + "test_track_name" + " (" + "test_track_id" + ")" + " on " + "test_album_name" + " rereased by " + "test_artist_name" + " is not liked. skipping..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1597,7 +2583,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1623,16 +2609,30 @@ func Test_runUnlikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
+				presenter.Print = func(writer io.Writer, output string) error {
+					if strings.Contains(output, "\n") {
+						return errors.New("Print() failed")
+					}
+					return origPrint(writer, output)
+				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
+				presenter.Print = origPrint
 				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Print(os.Stdout, formatter.Blue(\"⏩ Track #\"+fmt.Sprint(gtucoDto.TrackNumber)+\" \"+gtucoDto.Name+\" (\"+gtucoDto.ID+\")\"+\" on \"+gtucoDto.Album+\" rereased by \"+gtucoDto.Artists+\" is not liked. skipping...\")) failed)",
+			name: "negative testing (unlike cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1704,7 +2704,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					},
 					nil,
 				)
-				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -1730,8 +2730,14 @@ func Test_runUnlikeTrack(t *testing.T) {
 				); err != nil {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
+				mockPrompt := proxy.NewMockPrompt(mockCtrl)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
+				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, formatter.Blue("⏩ Track #"+"1"+" "+"test_track_name"+" ("+"test_track_id"+")"+" on "+"test_album_name"+" rereased by "+"test_artist_name"+" is not liked. skipping...")) {
+					if strings.Contains(output, "🚫 Cancelled unliking...") {
 						return errors.New("Print() failed")
 					}
 					return origPrint(writer, output)
@@ -1741,11 +2747,13 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
+				presenter.Pu = origPu
 				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C)",
+			name: "negative testing (presenter.Runprompt() failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1753,9 +2761,6 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			args: args{
 				fnc: func() {
-					exit = func(code int) {
-						// do nothing
-					}
 					authCmd := spotlike.NewAuthCommand(
 						exit,
 						proxy.NewCobra(),
@@ -1779,11 +2784,13 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						if err.Error() != "failed to run prompt" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
-			wantStdOut: formatter.Yellow("\n🚫 Cancelled unliking..."),
+			wantStdOut: "",
 			wantStdErr: "",
 			wantOutput: "",
 			wantErr:    false,
@@ -1845,8 +2852,8 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
@@ -1855,12 +2862,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
-				exit = o.Exit
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C and presenter.Print(\"\n\") failed)",
+			name: "negative testing (skipped to like track)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -1891,15 +2898,13 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "Print() failed" {
-							t.Errorf("Failed to run the unlikeTrack command: %v", err)
-						}
+						t.Errorf("Failed to run the unlikeTrack command: %v", err)
 					}
 				},
 			},
-			wantStdOut: "",
+			wantStdOut: formatter.Yellow("🚫 Cancelled unliking track test_track_name (test_track_id) ..."),
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikecanceledTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
@@ -1959,28 +2964,22 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("n", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "\n") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (unlike cancelled with ^C and presenter.Print(os.Stdout, formatter.Yellow(\"🚫 Cancelled unliking...\")) failed)",
+			name: "negative testing (unlike track failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2011,7 +3010,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "Print() failed" {
+						if err.Error() != "failed to like track" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -2053,6 +3052,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2079,28 +3079,22 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("^C"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
-				presenter.Print = func(writer io.Writer, output string) error {
-					if strings.Contains(output, "🚫 Cancelled unliking...") {
-						return errors.New("Print() failed")
-					}
-					return origPrint(writer, output)
-				}
 			},
 			cleanup: func() {
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
-				presenter.Print = origPrint
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (presenter.Runprompt() failed)",
+			name: "positive testing (keep going after unlike track failed)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2131,7 +3125,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to run prompt" {
+						if err.Error() != "failed to like track" {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -2139,9 +3133,10 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikefailedfailedtoliketrackTOTAL:1results!❌Failedtounlike1contentsbelow...tracktest_track_name(test_track_id):failedtoliketrack",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
@@ -2173,6 +3168,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2199,21 +3195,23 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("", errors.New("failed to run prompt"))
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
 				presenter.Pu = origPu
+				output = ""
 			},
 		},
 		{
-			name: "negative testing (skipped to like track)",
+			name: "positive testing (retry the failed track in the report)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2243,16 +3241,24 @@ func Test_runUnlikeTrack(t *testing.T) {
 					)
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
-					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						t.Errorf("Failed to run the unlikeTrack command: %v", err)
+					if err := unlikeTrackCmd.RunE(cmd, []string{}); err != nil {
+						if err.Error() != "failed to like track" {
+							t.Errorf("Failed to run the unlikeTrack command: %v", err)
+						}
 					}
 				},
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: formatter.Yellow("🚫 Cancelled unliking track test_track_name (test_track_id)..."),
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikefailedfailedtoliketrackTOTAL:1results!❌Failedtounlike1contentsbelow...tracktest_track_name(test_track_id):failedtoliketrack",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				report := filepath.Join(t.TempDir(), "report.json")
+				if err := o.WriteFile(report, []byte(`[{"type":"track","id":"test_track_id","name":"test_track_name","action":"unlike","status":"failed","error":"failed to unlike track"}]`), 0600); err != nil {
+					t.Errorf("Failed to create a report: %v", err)
+				}
+				spotlike.GlobalOps.RetryFailed = report
+				spotlike.GlobalOps.KeepGoing = true
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
@@ -2284,6 +3290,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2310,13 +3317,15 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
-				mockPrompt.EXPECT().Run().Return("n", nil)
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
+				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.RetryFailed = ""
+				spotlike.GlobalOps.KeepGoing = false
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -2325,7 +3334,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 		},
 		{
-			name: "negative testing (unlike track failed)",
+			name: "negative testing (failed to record the operation)",
 			fields: fields{
 				Os:        os,
 				StdBuffer: stdBuffer,
@@ -2356,7 +3365,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					cmd := &c.Command{}
 					cmd.SetContext(context.Background())
 					if err := unlikeTrackCmd.RunE(cmd, []string{"test_track_id"}); err != nil {
-						if err.Error() != "failed to like track" {
+						if !strings.Contains(err.Error(), "not a directory") {
 							t.Errorf("Failed to run the unlikeTrack command: %v", err)
 						}
 					}
@@ -2367,8 +3376,14 @@ func Test_runUnlikeTrack(t *testing.T) {
 			wantOutput: "",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
+				file := filepath.Join(t.TempDir(), "file")
+				if err := o.WriteFile(file, []byte{}, 0600); err != nil {
+					t.Errorf("Failed to create a file: %v", err)
+				}
+				spotlike.GlobalOps.DataDir = filepath.Join(file, "spotlike")
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2398,7 +3413,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(errors.New("failed to like track"))
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2425,13 +3440,14 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
 				presenter.Pu = utility.NewPromptUtil(mockPromptui)
 			},
 			cleanup: func() {
+				spotlike.GlobalOps.DataDir = ""
 				if err := api.ResetClientManager(); err != nil {
 					t.Errorf("Failed to reset client manager: %v", err)
 				}
@@ -2485,6 +3501,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2514,7 +3531,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2541,7 +3558,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2601,6 +3618,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2630,7 +3648,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2657,7 +3675,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -2718,11 +3736,12 @@ func Test_runUnlikeTrack(t *testing.T) {
 			},
 			wantStdOut: "",
 			wantStdErr: "",
-			wantOutput: "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtest_track_id1test_track_nametest_album_nametest_artist_name2000-01-01TOTAL:1tracks!",
+			wantOutput: "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtest_track_idtracktest_track_nameunlikeunlikedTOTAL:1results!",
 			wantErr:    false,
 			setup: func(mockCtrl *gomock.Controller) {
 				ctx := context.Background()
 				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
 				mockSpotifyClient.EXPECT().GetTrack(ctx, spotify.ID("test_track_id")).Return(
 					&spotify.FullTrack{
 						SimpleTrack: spotify.SimpleTrack{
@@ -2752,7 +3771,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					nil,
 				)
 				mockSpotifyClient.EXPECT().UserHasTracks(ctx, []spotify.ID{"test_track_id"}).Return([]bool{true}, nil)
-				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(ctx, []spotify.ID{"test_track_id"}).Return(nil)
+				mockSpotifyClient.EXPECT().RemoveTracksFromLibrary(context.WithoutCancel(ctx), []spotify.ID{"test_track_id"}).Return(nil)
 				mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
 				mockSpotify := proxy.NewMockSpotify(mockCtrl)
@@ -2779,7 +3798,7 @@ func Test_runUnlikeTrack(t *testing.T) {
 					t.Errorf("Failed to initialize client: %v", err)
 				}
 				mockPrompt := proxy.NewMockPrompt(mockCtrl)
-				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N]")
+				mockPrompt.EXPECT().SetLabel("Proceed with unliking test_track_name (test_track_id) ? [y/N/a/q]")
 				mockPrompt.EXPECT().Run().Return("y", nil)
 				mockPromptui := proxy.NewMockPromptui(mockCtrl)
 				mockPromptui.EXPECT().NewPrompt().Return(mockPrompt)
@@ -0,0 +1,389 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	c "github.com/spf13/cobra"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+	fileRepository "github.com/yanosea/spotlike/app/infrastructure/file/repository"
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/presenter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+)
+
+// WatchOptions provides the options for the watch command.
+type WatchOptions struct {
+	Interval string
+	Once     bool
+	Format   string
+}
+
+var (
+	// watchOps is a variable to store the watch options with the default values for injecting the dependencies in testing.
+	watchOps = WatchOptions{
+		Interval: "6h",
+		Once:     false,
+		Format:   "table",
+	}
+	// watchNow is a function to get the current time for injecting the dependencies in testing.
+	watchNow = time.Now
+	// watchSleep is a function to wait for the duration or until the context is done for injecting the dependencies in testing.
+	watchSleep = func(ctx context.Context, d time.Duration) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		}
+	}
+	// watchYaml is a variable to store the yaml proxy for injecting the dependencies in testing.
+	watchYaml = proxy.NewYaml()
+)
+
+const (
+	// watchStateKeyPrefix is the prefix of the key of the last run of the watch command in the state followed by the path of the rules file.
+	watchStateKeyPrefix = "watch:"
+	// watchMinInterval is the minimum interval between the cycles not to send too many requests to Spotify.
+	watchMinInterval = time.Minute
+	// watchInitialBackoff is the first duration to wait before retrying the cycle rate limited by Spotify.
+	watchInitialBackoff = time.Minute
+	// watchTimeLayout is the layout of the time in the summary of the cycles.
+	watchTimeLayout = "2006-01-02 15:04:05"
+)
+
+// rulesPlanner is an interface that plans the contents to be liked by the rules.
+type rulesPlanner interface {
+	Run(ctx context.Context, since time.Time) ([]*spotlikeApp.PlanRulesUseCaseOutputDto, error)
+}
+
+// NewWatchCommand returns a new instance of the watch command.
+func NewWatchCommand(
+	exit func(int),
+	cobra proxy.Cobra,
+	authCmd proxy.Command,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetUse("watch")
+	cmd.SetAliases([]string{"wa", "w"})
+	cmd.SetUsageTemplate(watchUsageTemplate)
+	cmd.SetHelpTemplate(watchHelpTemplate)
+	cmd.SetSilenceErrors(true)
+	cmd.Flags().StringVarP(
+		&watchOps.Interval,
+		"interval",
+		"i",
+		"6h",
+		"⏰ interval between the cycles to like the new releases (e.g: \"6h\", \"30m\")",
+	)
+	cmd.Flags().BoolVarP(
+		&watchOps.Once,
+		"once",
+		"",
+		false,
+		"1️⃣ run only one cycle and exit",
+	)
+	cmd.Flags().StringVarP(
+		&watchOps.Format,
+		"format",
+		"f",
+		"table",
+		"📝 format of the output (default \"table\", e.g: \"plain\", \"json\")",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			return runWatch(exit, cmd, authCmd, output, args)
+		},
+	)
+
+	return cmd
+}
+
+// runWatch runs the watch command.
+func runWatch(exit func(int), cmd *c.Command, authCmd proxy.Command, output *string, args []string) error {
+	if len(args) == 0 {
+		o := formatter.Yellow("⚡ No rules file specified...")
+		*output = o
+		return nil
+	}
+
+	interval, err := time.ParseDuration(watchOps.Interval)
+	if err != nil || interval < watchMinInterval {
+		o := formatter.Yellow("⚡ Invalid interval... (at least \"1m\", e.g: \"6h\", \"30m\")")
+		*output = o
+		return nil
+	}
+
+	f, err := formatter.NewFormatter(watchOps.Format)
+	if err != nil {
+		o := formatter.Red("❌ Failed to create a formatter...")
+		*output = o
+		return err
+	}
+
+	ruleRepo := fileRepository.NewRuleRepository(watchYaml, args[0])
+	if _, err := ruleRepo.Find(cmd.Context()); err != nil {
+		o := formatter.Red("❌ Failed to load the rules file...")
+		*output = o
+		return err
+	}
+
+	clientManager := api.GetClientManager()
+	if clientManager == nil {
+		o := formatter.Red("❌ Client manager is not initialized...")
+		*output = o
+		return nil
+	}
+	if _, err := clientManager.GetClient(); err != nil && errors.Is(err, api.ErrNotAuthenticated) {
+		if err := authCmd.RunE(cmd, args); err != nil {
+			return err
+		}
+	} else if err != nil {
+		o := formatter.Red("❌ Failed to get client...")
+		*output = o
+		return err
+	}
+
+	key := watchStateKeyPrefix + args[0]
+	if path, err := filepath.Abs(args[0]); err == nil {
+		key = watchStateKeyPrefix + path
+	}
+	watchState := state.NewState(GlobalOps.DataDir)
+	w := MessageWriter(watchOps.Format)
+	// the discographies are looked up without the cache, so that the releases are found as soon as they come out
+	pruc := spotlikeApp.NewPlanRulesUseCase(ruleRepo, NewArtistRepository(), repository.NewAlbumRepository(), NewTrackRepository())
+	for cycle := 1; ; cycle++ {
+		startedAt := watchNow()
+		since, err := sinceLastRun(watchState, key, startedAt)
+		if err != nil {
+			return err
+		}
+
+		var results []*spotlikeApp.OperationResultDto
+		backoff := watchInitialBackoff
+		for {
+			results, err = runWatchCycle(cmd, w, pruc, since)
+			if err == nil || !errors.Is(err, api.ErrRateLimited) {
+				break
+			}
+			if err := presenter.Print(w, formatter.Yellow("⏳ Rate limited by Spotify. retrying the cycle "+fmt.Sprint(cycle)+" in "+backoff.String()+"...")); err != nil {
+				return err
+			}
+			if err := watchSleep(cmd.Context(), backoff); err != nil {
+				break
+			}
+			backoff = min(backoff*2, interval)
+		}
+		if err != nil && cmd.Context().Err() == nil {
+			if errors.Is(err, api.ErrNotAuthenticated) || errors.Is(err, api.ErrForbidden) {
+				return err
+			}
+			if err := presenter.Print(w, formatter.Red("❌ Failed the cycle "+fmt.Sprint(cycle)+" : "+err.Error())); err != nil {
+				return err
+			}
+		}
+
+		failed := FailedResultsMessage(results)
+		// the last run is not updated if some contents failed to be liked, so that they are retried in the next cycle
+		if err == nil && failed == "" && !GlobalOps.DryRun && cmd.Context().Err() == nil {
+			if err := watchState.SaveLastRun(key, startedAt); err != nil {
+				return err
+			}
+		}
+		if err == nil {
+			summary := watchSummary(cycle, startedAt, results)
+			if !watchOps.Once && cmd.Context().Err() == nil {
+				summary += " (next cycle at " + startedAt.Add(interval).Format(watchTimeLayout) + ")"
+			}
+			if err := presenter.Print(w, summary); err != nil {
+				return err
+			}
+			if failed != "" {
+				if err := presenter.Print(w, failed); err != nil {
+					return err
+				}
+			}
+			// the results of each cycle are written as a JSON document so that they can be read as a stream
+			if watchOps.Format == "json" {
+				if results == nil {
+					results = []*spotlikeApp.OperationResultDto{}
+				}
+				o, err := f.Format(results)
+				if err != nil {
+					return err
+				}
+				if err := presenter.Print(os.Stdout, o); err != nil {
+					return err
+				}
+			}
+		}
+
+		if watchOps.Once {
+			if err != nil {
+				return err
+			}
+			switch {
+			case cmd.Context().Err() != nil:
+				SetExitCode(ExitCodeCanceled)
+			case len(results) == 0:
+				SetExitCode(ExitCodeNothingToDo)
+			default:
+				SetExitCode(ResultsExitCode(results))
+			}
+			return nil
+		}
+		if cmd.Context().Err() != nil || watchSleep(cmd.Context(), time.Until(startedAt.Add(interval))) != nil {
+			o := formatter.Yellow("🛑 Stopped watching...")
+			*output = o
+			return nil
+		}
+	}
+}
+
+// runWatchCycle likes the contents matched by the rules and released since the date.
+// The contents failed to be liked are reported in the results, and the cycle is stopped only if rate limited or canceled.
+func runWatchCycle(cmd *c.Command, w io.Writer, pruc rulesPlanner, since time.Time) ([]*spotlikeApp.OperationResultDto, error) {
+	pruoDtos, err := pruc.Run(cmd.Context(), since)
+	if err != nil {
+		return nil, err
+	}
+
+	rouc := spotlikeApp.NewRecordOperationUseCase(fileRepository.NewOperationRepository(GlobalOps.DataDir), repository.NewUserRepository())
+	var results []*spotlikeApp.OperationResultDto
+	// the in-flight item is finished even if interrupted, and the remaining items are cancelled
+	ctx := context.WithoutCancel(cmd.Context())
+	for i, pruoDto := range pruoDtos {
+		if pruoDto.Liked {
+			results = append(results, spotlikeApp.NewOperationResultDto(pruoDto.Type, pruoDto.ID, pruoDto.Name, "like", spotlikeApp.OperationResultStatusSkipped, nil))
+			continue
+		}
+		if cmd.Context().Err() != nil {
+			for _, remaining := range pruoDtos[i:] {
+				if !remaining.Liked {
+					results = append(results, spotlikeApp.NewOperationResultDto(remaining.Type, remaining.ID, remaining.Name, "like", spotlikeApp.OperationResultStatusCanceled, nil))
+				}
+			}
+			break
+		}
+		label := pruoDto.Type + " " + pruoDto.Name + " (" + pruoDto.ID + ") released by " + pruoDto.Artists + " with the rule " + pruoDto.Rule
+		if pruoDto.Type == "artist" {
+			label = pruoDto.Type + " " + pruoDto.Name + " (" + pruoDto.ID + ") with the rule " + pruoDto.Rule
+		}
+		if GlobalOps.DryRun {
+			if err := presenter.Print(w, formatter.Yellow("🧪 The "+label+" would be liked... (dry run)")); err != nil {
+				return nil, err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(pruoDto.Type, pruoDto.ID, pruoDto.Name, "like", spotlikeApp.OperationResultStatusPlanned, nil))
+			continue
+		}
+
+		if err := ApplyAction(ctx, "like", pruoDto.Type, pruoDto.ID); err != nil {
+			if errors.Is(err, api.ErrRateLimited) || errors.Is(err, api.ErrNotAuthenticated) || errors.Is(err, api.ErrForbidden) {
+				return nil, err
+			}
+			results = append(results, spotlikeApp.NewOperationResultDto(pruoDto.Type, pruoDto.ID, pruoDto.Name, "like", spotlikeApp.OperationResultStatusFailed, err))
+			continue
+		}
+		if err := rouc.Run(
+			ctx,
+			&spotlikeApp.RecordOperationUseCaseInputDto{
+				Type:        pruoDto.Type,
+				ID:          pruoDto.ID,
+				Name:        pruoDto.Name,
+				Action:      "like",
+				CommandLine: strings.Join(os.Args, " "),
+			},
+		); err != nil {
+			return nil, err
+		}
+		if err := presenter.Print(w, formatter.Green("🤍 Liked the "+label)); err != nil {
+			return nil, err
+		}
+		results = append(results, spotlikeApp.NewOperationResultDto(pruoDto.Type, pruoDto.ID, pruoDto.Name, "like", spotlikeApp.OperationResultStatusLiked, nil))
+	}
+
+	return results, nil
+}
+
+// watchSummary returns the summary of the cycle.
+func watchSummary(cycle int, startedAt time.Time, results []*spotlikeApp.OperationResultDto) string {
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[result.Status]++
+	}
+	liked := fmt.Sprint(counts[spotlikeApp.OperationResultStatusLiked]) + " liked"
+	if GlobalOps.DryRun {
+		liked = fmt.Sprint(counts[spotlikeApp.OperationResultStatusPlanned]) + " planned"
+	}
+	summary := "🔁 [" + startedAt.Format(watchTimeLayout) + "] cycle " + fmt.Sprint(cycle) + " : " +
+		liked + ", " +
+		fmt.Sprint(counts[spotlikeApp.OperationResultStatusSkipped]) + " skipped, " +
+		fmt.Sprint(counts[spotlikeApp.OperationResultStatusFailed]) + " failed"
+	if counts[spotlikeApp.OperationResultStatusCanceled] != 0 {
+		summary += ", " + fmt.Sprint(counts[spotlikeApp.OperationResultStatusCanceled]) + " canceled"
+	}
+
+	return summary
+}
+
+const (
+	// watchHelpTemplate is the help template of the watch command.
+	watchHelpTemplate = `👀 Watch new releases and like them periodically with the rules.
+
+You can like the albums or the tracks released by the artists as they appear.
+The rules are read from the rules file in YAML in every cycle, so you can edit it without restarting.
+
+  follow:                      # IDs of the artists to follow
+    - 00DuPiLri3mNomvvM3nZvU
+  never: ["(?i)karaoke"]       # patterns of the names of the albums and tracks never to like
+  rules:
+    - name: studio albums        # name of the rule (default "rule N")
+      artists:                   # IDs of the artists
+        - 00DuPiLri3mNomvvM3nZvU
+      followed: false            # also the artists you follow
+      include_groups: [album]    # album, single, compilation, appears_on (default all)
+      released_after: 2015-01-01 # only the albums released on or after the date
+      exclude: ["(?i)\\blive\\b"] # patterns of the names of the albums and tracks not to like
+      like: albums               # albums or tracks (default albums)
+
+In each cycle, the contents released since the last cycle (or in the last 30 days at the first cycle) are liked.
+The last cycle is stored in the data directory, and it is not updated with the dry run or if some contents failed to be liked.
+The contents already liked are skipped, and the summary of the cycle is written to stdout.
+With the json format, the messages are written to stderr and the results of each cycle are written to stdout as a JSON document.
+If rate limited by Spotify, the cycle is retried with the exponential backoff.
+It keeps watching until interrupted, so you can run it as a service.
+
+` + watchUsageTemplate
+	// watchUsageTemplate is the usage template of the watch command.
+	watchUsageTemplate = `Usage:
+  spotlike watch [flags] [argument]
+  spotlike wa    [flags] [argument]
+  spotlike w     [flags] [argument]
+
+Flags:
+  -i, --interval  ⏰ interval between the cycles to like the new releases (e.g: "6h", "30m")
+  --once          1️⃣ run only one cycle and exit
+  -f, --format    📝 format of the output (default "table", e.g: "plain", "json")
+  -h, --help      🤝 help for watch
+
+Global Flags:
+  --dry-run  🧪 show the plan of like and unlike without executing it
+
+Argument:
+  RULES  📜 path of the rules file (e.g: "rules.yaml")
+`
+)
@@ -0,0 +1,552 @@
+package spotlike
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	c "github.com/spf13/cobra"
+	"github.com/zmb3/spotify/v2"
+
+	baseconfig "github.com/yanosea/spotlike/app/config"
+	albumDomain "github.com/yanosea/spotlike/app/domain/spotify/album"
+	"github.com/yanosea/spotlike/app/infrastructure/file/cache"
+	"github.com/yanosea/spotlike/app/infrastructure/file/state"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/repository"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/config"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/formatter"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewWatchCommand(t *testing.T) {
+	output := ""
+	exit := os.Exit
+
+	type args struct {
+		exit    func(int)
+		cobra   proxy.Cobra
+		authCmd proxy.Command
+		output  *string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				exit:  exit,
+				cobra: proxy.NewCobra(),
+				authCmd: NewAuthCommand(
+					exit,
+					proxy.NewCobra(),
+					"0.0.0",
+					&config.SpotlikeCliConfig{
+						SpotlikeConfig: baseconfig.SpotlikeConfig{
+							SpotifyID:           "test_client_id",
+							SpotifySecret:       "test_client_secret",
+							SpotifyRedirectUri:  "test_redirect_uri",
+							SpotifyRefreshToken: "test_refresh_token",
+						},
+					},
+					&output,
+				),
+				output: &output,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewWatchCommand(tt.args.exit, tt.args.cobra, tt.args.authCmd, tt.args.output)
+			if got == nil {
+				t.Errorf("NewWatchCommand() = %v, want not nil", got)
+			} else {
+				cmd := &c.Command{}
+				cmd.SetContext(context.Background())
+				if err := got.RunE(cmd, []string{}); err != nil {
+					t.Errorf("Failed to run the watch command : %v", err)
+				}
+			}
+		})
+	}
+}
+
+func Test_runWatch(t *testing.T) {
+	output := ""
+	exit := func(code int) {
+		SetExitCode(code)
+	}
+	origWatchOps := watchOps
+	origWatchNow := watchNow
+	origWatchSleep := watchSleep
+	origGlobalOps := GlobalOps
+	su := utility.NewStringsUtil()
+	now := time.Date(2000, 1, 20, 12, 0, 0, 0, time.UTC)
+	authCmd := NewAuthCommand(
+		exit,
+		proxy.NewCobra(),
+		"0.0.0",
+		&config.SpotlikeCliConfig{
+			SpotlikeConfig: baseconfig.SpotlikeConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		},
+		&output,
+	)
+	initializeClient := func(mockCtrl *gomock.Controller, mockSpotifyClient *proxy.MockClient) {
+		mockAuthenticator := proxy.NewMockAuthenticator(mockCtrl)
+		mockAuthenticator.EXPECT().Client(gomock.Any(), gomock.Any()).Return(&http.Client{})
+		mockSpotify := proxy.NewMockSpotify(mockCtrl)
+		mockSpotify.EXPECT().NewAuthenticator(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockAuthenticator)
+		mockSpotify.EXPECT().NewClient(gomock.Any()).Return(mockSpotifyClient)
+		cm := api.NewClientManager(
+			mockSpotify,
+			proxy.NewMockHttp(mockCtrl),
+			proxy.NewMockRandstr(mockCtrl),
+			proxy.NewMockUrl(mockCtrl),
+		)
+		if err := cm.InitializeClient(
+			context.Background(),
+			&api.ClientConfig{
+				SpotifyID:           "test_client_id",
+				SpotifySecret:       "test_client_secret",
+				SpotifyRedirectUri:  "test_redirect_uri",
+				SpotifyRefreshToken: "test_refresh_token",
+			},
+		); err != nil {
+			t.Errorf("Failed to initialize client: %v", err)
+		}
+	}
+	albums := &spotify.SimpleAlbumPage{
+		Albums: []spotify.SimpleAlbum{
+			{
+				ID:   "test_album_id_1",
+				Name: "test_album_name_1",
+				Artists: []spotify.SimpleArtist{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				AlbumGroup:           "album",
+				ReleaseDate:          "2000-01-10",
+				ReleaseDatePrecision: "day",
+			},
+			{
+				ID:   "test_album_id_2",
+				Name: "test_album_name_2",
+				Artists: []spotify.SimpleArtist{
+					{
+						ID:   "test_artist_id",
+						Name: "test_artist_name",
+					},
+				},
+				AlbumGroup:           "album",
+				ReleaseDate:          "1999-01-01",
+				ReleaseDatePrecision: "day",
+			},
+		},
+	}
+	firstPage := *albums
+	firstPage.Next = "test_next"
+	emptyPage := &spotify.SimpleAlbumPage{}
+	emptyPage.Next = "test_next"
+	writeRules := func(t *testing.T) string {
+		path := filepath.Join(t.TempDir(), "rules.yaml")
+		if err := os.WriteFile(path, []byte("rules:\n  - name: test_rule\n    artists: [test_artist_id]\n"), 0600); err != nil {
+			t.Errorf("Failed to write the rules file: %v", err)
+		}
+		return path
+	}
+	cleanup := func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+		watchOps = origWatchOps
+		watchNow = origWatchNow
+		watchSleep = origWatchSleep
+		GlobalOps = origGlobalOps
+		output = ""
+		SetExitCode(ExitCodeOk)
+	}
+
+	type args struct {
+		cmd    *c.Command
+		output *string
+		args   []string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		rules        bool
+		wantOutput   string
+		wantErr      bool
+		wantExitCode int
+		wantLastRun  time.Time
+		setup        func(mockCtrl *gomock.Controller, cancel context.CancelFunc)
+	}{
+		{
+			name: "positive testing (no rules file specified)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{},
+			},
+			rules:        false,
+			wantOutput:   formatter.Yellow("⚡Norulesfilespecified..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "positive testing (invalid interval)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Yellow("⚡Invalidinterval...(atleast\"1m\",e.g:\"6h\",\"30m\")"),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Interval = "30s"
+			},
+		},
+		{
+			name: "positive testing (like the new albums once)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (the discographies are not read from the cache)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				GlobalOps.CacheDir = t.TempDir()
+				GlobalOps.NoCache = false
+				GlobalOps.CacheTTL = 24
+				mockAlbumRepo := albumDomain.NewMockAlbumRepository(mockCtrl)
+				mockAlbumRepo.EXPECT().FindByArtistId(gomock.Any(), spotify.ID("test_artist_id")).Return([]*albumDomain.Album{}, nil)
+				cachedAlbumRepo := repository.NewCachedAlbumRepository(mockAlbumRepo, cache.NewCache(GlobalOps.CacheDir), 24*time.Hour)
+				if _, err := cachedAlbumRepo.FindByArtistId(context.Background(), "test_artist_id"); err != nil {
+					t.Errorf("Failed to seed the cache: %v", err)
+				}
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (the new albums are already liked)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeNothingToDo,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{true}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (dry run does not update the last run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				GlobalOps.DryRun = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (retry the cycle rate limited)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				gomock.InOrder(
+					mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, spotify.Error{Status: http.StatusTooManyRequests}),
+					mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil),
+				)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (retry the cycle when liking is rate limited)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil).Times(2)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil).Times(2)
+				gomock.InOrder(
+					mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(spotify.Error{Status: http.StatusTooManyRequests}),
+					mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil),
+				)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (failed contents do not update the last run)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      false,
+			wantExitCode: ExitCodePartialFailure,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (keep watching until interrupted)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Yellow("🛑Stoppedwatching..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			wantLastRun:  now,
+			setup: func(mockCtrl *gomock.Controller, cancel context.CancelFunc) {
+				watchSleep = func(ctx context.Context, _ time.Duration) error {
+					cancel()
+					return ctx.Err()
+				}
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().CurrentUser(gomock.Any()).Return(&spotify.PrivateUser{User: spotify.User{ID: "test_user_id"}}, nil)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(albums, nil)
+				mockSpotifyClient.EXPECT().UserHasAlbums(gomock.Any(), spotify.ID("test_album_id_1")).Return([]bool{false}, nil)
+				mockSpotifyClient.EXPECT().AddAlbumsToLibrary(gomock.Any(), spotify.ID("test_album_id_1")).Return(nil)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "positive testing (keep watching after the cycle failed)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Yellow("🛑Stoppedwatching..."),
+			wantErr:      false,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller, cancel context.CancelFunc) {
+				watchSleep = func(ctx context.Context, _ time.Duration) error {
+					cancel()
+					return ctx.Err()
+				}
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (invalid format)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   formatter.Red("❌Failedtocreateaformatter..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(_ *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Format = "invalid"
+			},
+		},
+		{
+			name: "negative testing (failed to load the rules file)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+				args:   []string{filepath.Join(t.TempDir(), "not_exist.yaml")},
+			},
+			rules:        false,
+			wantOutput:   formatter.Red("❌Failedtoloadtherulesfile..."),
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup:        nil,
+		},
+		{
+			name: "negative testing (not authenticated in the cycle)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, spotify.Error{Status: http.StatusUnauthorized})
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (the cycle failed once)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(nil, errors.New("test error"))
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+		{
+			name: "negative testing (the albums were listed incompletely, the last run is not updated)",
+			args: args{
+				cmd:    &c.Command{},
+				output: &output,
+			},
+			rules:        true,
+			wantOutput:   "",
+			wantErr:      true,
+			wantExitCode: ExitCodeOk,
+			setup: func(mockCtrl *gomock.Controller, _ context.CancelFunc) {
+				watchOps.Once = true
+				mockSpotifyClient := proxy.NewMockClient(mockCtrl)
+				gomock.InOrder(
+					mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(&firstPage, nil),
+					mockSpotifyClient.EXPECT().GetArtistAlbums(gomock.Any(), spotify.ID("test_artist_id"), nil, gomock.Any(), gomock.Any()).Return(emptyPage, nil),
+				)
+				initializeClient(mockCtrl, mockSpotifyClient)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			SetExitCode(ExitCodeOk)
+			GlobalOps.DataDir = t.TempDir()
+			watchNow = func() time.Time { return now }
+			watchSleep = func(_ context.Context, _ time.Duration) error { return nil }
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.rules {
+				tt.args.args = []string{writeRules(t)}
+			}
+			if tt.setup != nil {
+				tt.setup(mockCtrl, cancel)
+			}
+			defer cleanup()
+			tt.args.cmd.SetContext(ctx)
+			if err := runWatch(exit, tt.args.cmd, authCmd, tt.args.output, tt.args.args); (err != nil) != tt.wantErr {
+				t.Errorf("runWatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			*tt.args.output = su.RemoveNewLines(su.RemoveSpaces(su.RemoveTabs(*tt.args.output)))
+			if *tt.args.output != tt.wantOutput {
+				t.Errorf("runWatch() got = %v, want %v", *tt.args.output, tt.wantOutput)
+			}
+			if exitCode != tt.wantExitCode {
+				t.Errorf("runWatch() exit code = %v, want %v", exitCode, tt.wantExitCode)
+			}
+			if len(tt.args.args) == 0 {
+				return
+			}
+			path, err := filepath.Abs(tt.args.args[0])
+			if err != nil {
+				t.Errorf("Failed to get the absolute path: %v", err)
+			}
+			lastRun, err := state.NewState(GlobalOps.DataDir).LastRun(watchStateKeyPrefix + path)
+			if err != nil {
+				t.Errorf("Failed to get the last run: %v", err)
+			}
+			if !lastRun.Equal(tt.wantLastRun) {
+				t.Errorf("runWatch() last run = %v, want %v", lastRun, tt.wantLastRun)
+			}
+		})
+	}
+}
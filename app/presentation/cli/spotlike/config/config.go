@@ -1,6 +1,8 @@
 package config
 
 import (
+	"path/filepath"
+
 	baseConfig "github.com/yanosea/spotlike/app/config"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
@@ -42,6 +44,16 @@ type envConfig struct {
 	SpotifyRedirectUri string `envconfig:"SPOTIFY_REDIRECT_URI"`
 	// SpotifyRefreshToken is the Spotify refresh token.
 	SpotifyRefreshToken string `envconfig:"SPOTIFY_REFRESH_TOKEN"`
+	// SpotifyApiBaseUrl is the base URL of the Spotify Web API.
+	SpotifyApiBaseUrl string `envconfig:"SPOTIFY_API_BASE_URL"`
+	// SpotifyAccountsBaseUrl is the base URL of the Spotify accounts service.
+	SpotifyAccountsBaseUrl string `envconfig:"SPOTIFY_ACCOUNTS_BASE_URL"`
+	// XdgDataHome is the base directory to store user-specific data files.
+	XdgDataHome string `envconfig:"XDG_DATA_HOME"`
+	// XdgCacheHome is the base directory to store user-specific non-essential data files.
+	XdgCacheHome string `envconfig:"XDG_CACHE_HOME"`
+	// Home is the home directory of the user.
+	Home string `envconfig:"HOME"`
 }
 
 // GetConfig gets the configuration of the spotlike cli application.
@@ -51,12 +63,34 @@ func (c *cliConfigurator) GetConfig() (*SpotlikeCliConfig, error) {
 		return nil, err
 	}
 
+	dataHome := env.XdgDataHome
+	if dataHome == "" && env.Home != "" {
+		dataHome = filepath.Join(env.Home, ".local", "share")
+	}
+	var dataDir string
+	if dataHome != "" {
+		dataDir = filepath.Join(dataHome, "spotlike")
+	}
+
+	cacheHome := env.XdgCacheHome
+	if cacheHome == "" && env.Home != "" {
+		cacheHome = filepath.Join(env.Home, ".cache")
+	}
+	var cacheDir string
+	if cacheHome != "" {
+		cacheDir = filepath.Join(cacheHome, "spotlike")
+	}
+
 	config := &SpotlikeCliConfig{
 		SpotlikeConfig: baseConfig.SpotlikeConfig{
-			SpotifyID:           env.SpotifyID,
-			SpotifySecret:       env.SpotifySecret,
-			SpotifyRedirectUri:  env.SpotifyRedirectUri,
-			SpotifyRefreshToken: env.SpotifyRefreshToken,
+			SpotifyID:              env.SpotifyID,
+			SpotifySecret:          env.SpotifySecret,
+			SpotifyRedirectUri:     env.SpotifyRedirectUri,
+			SpotifyRefreshToken:    env.SpotifyRefreshToken,
+			SpotifyApiBaseUrl:      env.SpotifyApiBaseUrl,
+			SpotifyAccountsBaseUrl: env.SpotifyAccountsBaseUrl,
+			DataDir:                dataDir,
+			CacheDir:               cacheDir,
 		},
 	}
 
@@ -57,6 +57,76 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 	}{
 		{
 			name: "positive testing",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+				}},
+			want: &SpotlikeCliConfig{
+				SpotlikeConfig: baseConfig.SpotlikeConfig{
+					SpotifyID:              "test_id",
+					SpotifySecret:          "test_secret",
+					SpotifyRedirectUri:     "test_redirect_uri",
+					SpotifyRefreshToken:    "test_refresh_token",
+					SpotifyApiBaseUrl:      "test_api_base_url",
+					SpotifyAccountsBaseUrl: "test_accounts_base_url",
+					DataDir:                "/test/xdg/data/spotlike",
+					CacheDir:               "/test/xdg/cache/spotlike",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.SpotifyID = "test_id"
+						cfg.SpotifySecret = "test_secret"
+						cfg.SpotifyRedirectUri = "test_redirect_uri"
+						cfg.SpotifyRefreshToken = "test_refresh_token"
+						cfg.SpotifyApiBaseUrl = "test_api_base_url"
+						cfg.SpotifyAccountsBaseUrl = "test_accounts_base_url"
+						cfg.XdgDataHome = "/test/xdg/data"
+						cfg.XdgCacheHome = "/test/xdg/cache"
+						cfg.Home = "/test/home"
+						return nil
+					},
+				)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+			},
+		},
+		{
+			name: "positive testing (XDG_DATA_HOME and XDG_CACHE_HOME are not set)",
+			fields: fields{
+				BaseConfigurator: &baseConfig.BaseConfigurator{
+					Envconfig: nil,
+				}},
+			want: &SpotlikeCliConfig{
+				SpotlikeConfig: baseConfig.SpotlikeConfig{
+					SpotifyID:           "test_id",
+					SpotifySecret:       "test_secret",
+					SpotifyRedirectUri:  "test_redirect_uri",
+					SpotifyRefreshToken: "test_refresh_token",
+					DataDir:             "/test/home/.local/share/spotlike",
+					CacheDir:            "/test/home/.cache/spotlike",
+				},
+			},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) {
+				mockEnvconfig := proxy.NewMockEnvconfig(mockCtrl)
+				mockEnvconfig.EXPECT().Process("", gomock.Any()).DoAndReturn(
+					func(_ string, cfg *envConfig) error {
+						cfg.SpotifyID = "test_id"
+						cfg.SpotifySecret = "test_secret"
+						cfg.SpotifyRedirectUri = "test_redirect_uri"
+						cfg.SpotifyRefreshToken = "test_refresh_token"
+						cfg.Home = "/test/home"
+						return nil
+					},
+				)
+				tt.BaseConfigurator.Envconfig = mockEnvconfig
+			},
+		},
+		{
+			name: "positive testing (neither XDG_DATA_HOME, XDG_CACHE_HOME nor HOME is set)",
 			fields: fields{
 				BaseConfigurator: &baseConfig.BaseConfigurator{
 					Envconfig: nil,
@@ -67,6 +137,8 @@ func Test_cliConfigurator_GetConfig(t *testing.T) {
 					SpotifySecret:       "test_secret",
 					SpotifyRedirectUri:  "test_redirect_uri",
 					SpotifyRefreshToken: "test_refresh_token",
+					DataDir:             "",
+					CacheDir:            "",
 				},
 			},
 			wantErr: false,
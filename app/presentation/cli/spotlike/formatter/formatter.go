@@ -21,6 +21,8 @@ var NewFormatter NewFormatterFunc = func(format string) (Formatter, error) {
 		f = NewPlainFormatter()
 	case "table":
 		f = NewTableFormatter()
+	case "json":
+		f = NewJsonFormatter()
 	default:
 		return nil, errors.New("invalid format")
 	}
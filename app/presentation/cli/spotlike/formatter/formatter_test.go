@@ -32,6 +32,14 @@ func TestNewFormatter(t *testing.T) {
 			want:    &TableFormatter{},
 			wantErr: false,
 		},
+		{
+			name: "positive testing (format is json)",
+			args: args{
+				format: "json",
+			},
+			want:    &JsonFormatter{},
+			wantErr: false,
+		},
 		{
 			name: "negative testing (format is invalid)",
 			args: args{
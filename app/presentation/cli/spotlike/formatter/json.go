@@ -0,0 +1,27 @@
+package formatter
+
+import (
+	"encoding/json"
+)
+
+// JsonFormatter is a struct that formats the output of spotlike cli.
+type JsonFormatter struct{}
+
+// NewJsonFormatter returns a new instance of the JsonFormatter struct.
+func NewJsonFormatter() *JsonFormatter {
+	return &JsonFormatter{}
+}
+
+// Format formats the output of spotlike cli.
+func (f *JsonFormatter) Format(result any) (string, error) {
+	if result == nil {
+		return "", nil
+	}
+
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(formatted), nil
+}
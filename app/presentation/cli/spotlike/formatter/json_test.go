@@ -0,0 +1,164 @@
+package formatter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
+)
+
+func TestNewJsonFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+		want *JsonFormatter
+	}{
+		{
+			name: "positive testing",
+			want: &JsonFormatter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewJsonFormatter(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewJsonFormatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJsonFormatter_Format(t *testing.T) {
+	type args struct {
+		result any
+	}
+	tests := []struct {
+		name    string
+		f       *JsonFormatter
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "positive testing (result is OperationResultDto)",
+			f:    &JsonFormatter{},
+			args: args{
+				result: []*spotlikeApp.OperationResultDto{
+					{
+						Type:   "track",
+						ID:     "track_id_1",
+						Name:   "track_name_1",
+						Action: "like",
+						Status: "failed",
+						Error:  "test error",
+					},
+				},
+			},
+			want: `[
+  {
+    "type": "track",
+    "id": "track_id_1",
+    "name": "track_name_1",
+    "action": "like",
+    "status": "failed",
+    "error": "test error"
+  }
+]`,
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is SearchUseCaseOutputDto)",
+			f:    &JsonFormatter{},
+			args: args{
+				result: &spotlikeApp.SearchUseCaseOutputDto{
+					Artists: []*spotlikeApp.SearchArtistUseCaseOutputDto{},
+					Tracks: []*spotlikeApp.SearchTrackUseCaseOutputDto{
+						{
+							ID:          "track_id_1",
+							Artists:     "artist_name_1",
+							Album:       "album_name_1",
+							Name:        "track_name_1",
+							TrackNumber: 1,
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			want: `{
+  "artists": [],
+  "tracks": [
+    {
+      "id": "track_id_1",
+      "artists": "artist_name_1",
+      "album": "album_name_1",
+      "name": "track_name_1",
+      "track_number": 1,
+      "release_date": "2000-01-01T00:00:00Z"
+    }
+  ]
+}`,
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is GetOperationsUseCaseOutputDto)",
+			f:    &JsonFormatter{},
+			args: args{
+				result: []*spotlikeApp.GetOperationsUseCaseOutputDto{
+					{
+						BatchID:     "batch_id_1",
+						Timestamp:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Type:        "album",
+						ID:          "album_id_1",
+						Name:        "album_name_1",
+						Action:      "like",
+						CommandLine: "spotlike like album album_id_1",
+						Account:     "user_id_1",
+					},
+				},
+			},
+			want: `[
+  {
+    "batch_id": "batch_id_1",
+    "timestamp": "2000-01-01T00:00:00Z",
+    "type": "album",
+    "id": "album_id_1",
+    "name": "album_name_1",
+    "action": "like",
+    "command_line": "spotlike like album album_id_1",
+    "account": "user_id_1"
+  }
+]`,
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is nil)",
+			f:    &JsonFormatter{},
+			args: args{
+				result: nil,
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "negative testing (result can not be marshaled)",
+			f:    &JsonFormatter{},
+			args: args{
+				result: make(chan int),
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &JsonFormatter{}
+			got, err := f.Format(tt.args.result)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JsonFormatter.Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("JsonFormatter.Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
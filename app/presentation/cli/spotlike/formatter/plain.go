@@ -2,6 +2,7 @@ package formatter
 
 import (
 	"fmt"
+	"strings"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
 )
@@ -22,7 +23,7 @@ func (f *PlainFormatter) Format(result any) (string, error) {
 		formatted = fmt.Sprintf("spotlike version %s", v.Version)
 	case []*spotlikeApp.SearchArtistUseCaseOutputDto:
 		for i, item := range v {
-			formatted += "[" + item.ID + "]" + " Artist : " + item.Name
+			formatted += "[" + item.ID + "]" + " Artist : " + item.Name + likedState("artist", item.Liked)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
@@ -36,34 +37,138 @@ func (f *PlainFormatter) Format(result any) (string, error) {
 		}
 	case []*spotlikeApp.SearchAlbumUseCaseOutputDto:
 		for i, item := range v {
-			formatted += "[" + item.ID + "]" + " Album : " + item.Name + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists
+			formatted += "[" + item.ID + "]" + " Album : " + item.Name + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists + likedState("album", item.Liked)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
 	case []*spotlikeApp.GetAlbumUseCaseOutputDto:
 		for i, item := range v {
-			formatted += "[" + item.ID + "]" + " Album : " + item.Name + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists
+			formatted += "[" + item.ID + "]" + " Album : " + item.Name + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists + likedState("album", item.Liked)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
 	case []*spotlikeApp.SearchTrackUseCaseOutputDto:
 		for i, item := range v {
-			formatted += "[" + item.ID + "]" + " Track : #" + fmt.Sprint(item.TrackNumber) + " " + item.Name + " on " + item.Album + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists
+			formatted += "[" + item.ID + "]" + " Track : #" + fmt.Sprint(item.TrackNumber) + " " + item.Name + " on " + item.Album + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists + likedState("track", item.Liked)
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
 	case []*spotlikeApp.GetTrackUseCaseOutputDto:
 		for i, item := range v {
-			formatted += "[" + item.ID + "]" + " Track : #" + fmt.Sprint(item.TrackNumber) + " " + item.Name + " on " + item.Album + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists
+			formatted += "[" + item.ID + "]" + " Track : #" + fmt.Sprint(item.TrackNumber) + " " + item.Name + " on " + item.Album + " released at " + item.ReleaseDate.Format("2006-01-02") + " by " + item.Artists + likedState("track", item.Liked)
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*spotlikeApp.GetOperationsUseCaseOutputDto:
+		for i, item := range v {
+			formatted += "[" + item.BatchID + "] " + item.Timestamp.Format("2006-01-02 15:04:05") + " " + item.Action + " " + item.Type + " : " + item.Name + " (" + item.ID + ")"
+			if item.Account != "" {
+				formatted += " by " + item.Account
+			}
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*spotlikeApp.OperationResultDto:
+		for i, item := range v {
+			formatted += "[" + item.ID + "] " + item.Action + " " + item.Type + " : " + item.Name + " => " + item.Status
+			if item.Error != "" {
+				formatted += " (" + item.Error + ")"
+			}
 			if i < len(v)-1 {
 				formatted += "\n"
 			}
 		}
+	case []*spotlikeApp.DiagnosisDto:
+		for i, item := range v {
+			formatted += "[" + item.Status + "] " + item.Check + " : " + item.Detail
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*spotlikeApp.DiffLibrariesUseCaseOutputDto:
+		for i, item := range v {
+			sign := "+"
+			if item.Change == spotlikeApp.LibraryChangeRemoved {
+				sign = "-"
+			}
+			formatted += sign + " [" + item.ID + "] " + item.Type + " : " + item.Name
+			if !item.ReleaseDate.IsZero() {
+				formatted += " released at " + item.ReleaseDate.Format("2006-01-02")
+			}
+			if item.Artists != "" {
+				formatted += " by " + item.Artists
+			}
+			if !item.LikedAt.IsZero() {
+				formatted += " (liked at " + item.LikedAt.Format("2006-01-02") + ")"
+			}
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case []*spotlikeApp.LikeStateDto:
+		for i, item := range v {
+			formatted += "[" + item.ID + "] " + item.Type + " : " + item.Name + " => " + item.State
+			if i < len(v)-1 {
+				formatted += "\n"
+			}
+		}
+	case *spotlikeApp.GetLibraryStatsUseCaseOutputDto:
+		lines := []string{
+			fmt.Sprintf("followed artists : %d, liked albums : %d, liked tracks : %d", v.FollowedArtists, v.LikedAlbums, v.LikedTracks),
+		}
+		for _, section := range []struct {
+			key   string
+			items []*spotlikeApp.LibraryStatDto
+		}{
+			{key: "artist", items: v.Artists},
+			{key: "year", items: v.Years},
+			{key: "decade", items: v.Decades},
+			{key: "genre", items: v.Genres},
+			{key: "month", items: v.Months},
+		} {
+			for _, item := range section.items {
+				lines = append(lines, fmt.Sprintf("%s %s : %d albums, %d tracks", section.key, item.Name, item.Albums, item.Tracks))
+			}
+		}
+		for _, item := range v.Coverages {
+			lines = append(lines, fmt.Sprintf("liked %d/%d tracks by %s (%.0f%%)", item.Liked, item.Total, item.Name, item.Ratio*100))
+		}
+		formatted = strings.Join(lines, "\n")
+	case *spotlikeApp.SearchUseCaseOutputDto:
+		var sections []string
+		for _, items := range []any{v.Artists, v.Albums, v.Tracks} {
+			section, err := f.Format(items)
+			if err != nil {
+				return "", err
+			}
+			if section != "" {
+				sections = append(sections, section)
+			}
+		}
+		formatted = strings.Join(sections, "\n\n")
 	default:
 		formatted = ""
 	}
 	return formatted, nil
 }
+
+// likedState returns the state of whether the content is liked to be appended to the line if it is checked.
+func likedState(contentType string, liked *bool) string {
+	switch {
+	case liked == nil:
+		return ""
+	case contentType == "artist" && *liked:
+		return " => " + spotlikeApp.LikeStateFollowed
+	case contentType == "artist":
+		return " => " + spotlikeApp.LikeStateNotFollowed
+	case *liked:
+		return " => " + spotlikeApp.LikeStateLiked
+	default:
+		return " => " + spotlikeApp.LikeStateNotLiked
+	}
+}
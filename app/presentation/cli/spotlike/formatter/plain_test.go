@@ -28,6 +28,8 @@ func TestNewPlainFormatter(t *testing.T) {
 }
 
 func TestPlainFormatter_Format(t *testing.T) {
+	liked, notLiked := true, false
+
 	type args struct {
 		result any
 	}
@@ -181,6 +183,246 @@ func TestPlainFormatter_Format(t *testing.T) {
 			want:    "[track_id_1] Track : #1 track_name_1 on album_name_1 released at 2000-01-01 by artist_name_1\n[track_id_2] Track : #2 track_name_2 on album_name_2 released at 2001-02-02 by artist_name_2",
 			wantErr: false,
 		},
+		{
+			name: "positive testing (result is GetOperationsUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.GetOperationsUseCaseOutputDto{
+					{
+						BatchID:     "batch_id_1",
+						Timestamp:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Type:        "track",
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Action:      "like",
+						CommandLine: "spotlike like track track_id_1",
+						Account:     "user_id_1",
+					},
+					{
+						BatchID:     "batch_id_2",
+						Timestamp:   time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+						Type:        "artist",
+						ID:          "artist_id_1",
+						Name:        "artist_name_1",
+						Action:      "unlike",
+						CommandLine: "spotlike unlike artist artist_id_1",
+					},
+				},
+			},
+			want:    "[batch_id_1] 2000-01-01 00:00:00 like track : track_name_1 (track_id_1) by user_id_1\n[batch_id_2] 2000-01-02 00:00:00 unlike artist : artist_name_1 (artist_id_1)",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is OperationResultDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.OperationResultDto{
+					{
+						Type:   "track",
+						ID:     "track_id_1",
+						Name:   "track_name_1",
+						Action: "like",
+						Status: "liked",
+					},
+					{
+						Type:   "track",
+						ID:     "track_id_2",
+						Name:   "",
+						Action: "like",
+						Status: "failed",
+						Error:  "test error",
+					},
+				},
+			},
+			want:    "[track_id_1] like track : track_name_1 => liked\n[track_id_2] like track :  => failed (test error)",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is DiagnosisDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.DiagnosisDto{
+					{
+						Check:  "version",
+						Status: "pass",
+						Detail: "1.0.0",
+					},
+					{
+						Check:  "redirect uri",
+						Status: "fail",
+						Detail: "failed to get the port",
+					},
+				},
+			},
+			want:    "[pass] version : 1.0.0\n[fail] redirect uri : failed to get the port",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is DiffLibrariesUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.DiffLibrariesUseCaseOutputDto{
+					{
+						Change: spotlikeApp.LibraryChangeRemoved,
+						Type:   "artist",
+						ID:     "artist_id_1",
+						Name:   "artist_name_1",
+					},
+					{
+						Change:      spotlikeApp.LibraryChangeAdded,
+						Type:        "track",
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Artists:     "artist_name_1",
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						LikedAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			want:    "- [artist_id_1] artist : artist_name_1\n+ [track_id_1] track : track_name_1 released at 2000-01-01 by artist_name_1 (liked at 2020-01-01)",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is LikeStateDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.LikeStateDto{
+					{
+						Type:  "artist",
+						ID:    "artist_id_1",
+						Name:  "artist_name_1",
+						State: spotlikeApp.LikeStateFollowed,
+					},
+					{
+						Type:  "track",
+						ID:    "track_id_1",
+						Name:  "track_name_1",
+						State: spotlikeApp.LikeStateNotLiked,
+					},
+				},
+			},
+			want:    "[artist_id_1] artist : artist_name_1 => followed\n[track_id_1] track : track_name_1 => not_liked",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is GetLibraryStatsUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: &spotlikeApp.GetLibraryStatsUseCaseOutputDto{
+					FollowedArtists: 1,
+					LikedAlbums:     1,
+					LikedTracks:     2,
+					Artists:         []*spotlikeApp.LibraryStatDto{{Name: "artist_name_1", Albums: 1, Tracks: 2}},
+					Years:           []*spotlikeApp.LibraryStatDto{{Name: "2000", Albums: 1, Tracks: 2}},
+					Decades:         []*spotlikeApp.LibraryStatDto{{Name: "2000s", Albums: 1, Tracks: 2}},
+					Genres:          []*spotlikeApp.LibraryStatDto{{Name: "genre_1", Albums: 1, Tracks: 2}},
+					Months:          []*spotlikeApp.LibraryStatDto{{Name: "2020-01", Albums: 1, Tracks: 2}},
+					Coverages:       []*spotlikeApp.LibraryCoverageDto{{ID: "artist_id_1", Name: "artist_name_1", Liked: 2, Total: 8, Ratio: 0.25}},
+				},
+			},
+			want:    "followed artists : 1, liked albums : 1, liked tracks : 2\nartist artist_name_1 : 1 albums, 2 tracks\nyear 2000 : 1 albums, 2 tracks\ndecade 2000s : 1 albums, 2 tracks\ngenre genre_1 : 1 albums, 2 tracks\nmonth 2020-01 : 1 albums, 2 tracks\nliked 2/8 tracks by artist_name_1 (25%)",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is SearchUseCaseOutputDto)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: &spotlikeApp.SearchUseCaseOutputDto{
+					Artists: []*spotlikeApp.SearchArtistUseCaseOutputDto{
+						{
+							ID:   "artist_id_1",
+							Name: "artist_name_1",
+						},
+					},
+					Albums: nil,
+					Tracks: []*spotlikeApp.SearchTrackUseCaseOutputDto{
+						{
+							ID:          "track_id_1",
+							Artists:     "artist_name_1",
+							Album:       "album_name_1",
+							Name:        "track_name_1",
+							TrackNumber: 1,
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			want:    "[artist_id_1] Artist : artist_name_1\n\n[track_id_1] Track : #1 track_name_1 on album_name_1 released at 2000-01-01 by artist_name_1",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is SearchUseCaseOutputDto with the liked states)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: &spotlikeApp.SearchUseCaseOutputDto{
+					Artists: []*spotlikeApp.SearchArtistUseCaseOutputDto{
+						{
+							ID:    "artist_id_1",
+							Name:  "artist_name_1",
+							Liked: &liked,
+						},
+					},
+					Albums: []*spotlikeApp.SearchAlbumUseCaseOutputDto{
+						{
+							ID:          "album_id_1",
+							Artists:     "artist_name_1",
+							Name:        "album_name_1",
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+							Liked:       &notLiked,
+						},
+					},
+					Tracks: []*spotlikeApp.SearchTrackUseCaseOutputDto{
+						{
+							ID:          "track_id_1",
+							Artists:     "artist_name_1",
+							Album:       "album_name_1",
+							Name:        "track_name_1",
+							TrackNumber: 1,
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+							Liked:       &liked,
+						},
+					},
+				},
+			},
+			want:    "[artist_id_1] Artist : artist_name_1 => followed\n\n[album_id_1] Album : album_name_1 released at 2000-01-01 by artist_name_1 => not_liked\n\n[track_id_1] Track : #1 track_name_1 on album_name_1 released at 2000-01-01 by artist_name_1 => liked",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is GetAlbumUseCaseOutputDto with the liked states)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.GetAlbumUseCaseOutputDto{
+					{
+						ID:          "album_id_1",
+						Name:        "album_name_1",
+						Artists:     "artist_name_1",
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Liked:       &liked,
+					},
+				},
+			},
+			want:    "[album_id_1] Album : album_name_1 released at 2000-01-01 by artist_name_1 => liked",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is GetTrackUseCaseOutputDto with the liked states)",
+			f:    &PlainFormatter{},
+			args: args{
+				result: []*spotlikeApp.GetTrackUseCaseOutputDto{
+					{
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Artists:     "artist_name_1",
+						Album:       "album_name_1",
+						TrackNumber: 1,
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Liked:       &notLiked,
+					},
+				},
+			},
+			want:    "[track_id_1] Track : #1 track_name_1 on album_name_1 released at 2000-01-01 by artist_name_1 => not_liked",
+			wantErr: false,
+		},
 		{
 			name: "negative testing (result is invalid)",
 			f:    &PlainFormatter{},
@@ -205,3 +447,50 @@ func TestPlainFormatter_Format(t *testing.T) {
 		})
 	}
 }
+
+func Test_likedState(t *testing.T) {
+	liked, notLiked := true, false
+
+	type args struct {
+		contentType string
+		liked       *bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "positive testing (not checked)",
+			args: args{contentType: "track", liked: nil},
+			want: "",
+		},
+		{
+			name: "positive testing (artist is followed)",
+			args: args{contentType: "artist", liked: &liked},
+			want: " => followed",
+		},
+		{
+			name: "positive testing (artist is not followed)",
+			args: args{contentType: "artist", liked: &notLiked},
+			want: " => not_followed",
+		},
+		{
+			name: "positive testing (track is liked)",
+			args: args{contentType: "track", liked: &liked},
+			want: " => liked",
+		},
+		{
+			name: "positive testing (album is not liked)",
+			args: args{contentType: "album", liked: &notLiked},
+			want: " => not_liked",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := likedState(tt.args.contentType, tt.args.liked); got != tt.want {
+				t.Errorf("likedState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
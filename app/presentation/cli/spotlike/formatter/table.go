@@ -3,6 +3,7 @@ package formatter
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	spotlikeApp "github.com/yanosea/spotlike/app/application/spotlike"
 
@@ -45,6 +46,20 @@ func (f *TableFormatter) Format(result any) (string, error) {
 		data = f.formatSearchTracks(v)
 	case []*spotlikeApp.GetTrackUseCaseOutputDto:
 		data = f.formatGetTracks(v)
+	case []*spotlikeApp.GetOperationsUseCaseOutputDto:
+		data = f.formatGetOperations(v)
+	case []*spotlikeApp.OperationResultDto:
+		data = f.formatOperationResults(v)
+	case []*spotlikeApp.DiagnosisDto:
+		data = f.formatDiagnoses(v)
+	case []*spotlikeApp.DiffLibrariesUseCaseOutputDto:
+		data = f.formatDiffLibraries(v)
+	case []*spotlikeApp.LikeStateDto:
+		data = f.formatLikeStates(v)
+	case *spotlikeApp.SearchUseCaseOutputDto:
+		return f.formatSearch(v)
+	case *spotlikeApp.GetLibraryStatsUseCaseOutputDto:
+		return f.formatLibraryStats(v)
 	default:
 		return "", nil
 	}
@@ -56,12 +71,15 @@ func (f *TableFormatter) Format(result any) (string, error) {
 func (f *TableFormatter) formatSearchArtists(items []*spotlikeApp.SearchArtistUseCaseOutputDto) tableData {
 	header := []string{"🆔 ID", "🎤 Artist"}
 	var rows [][]string
+	var liked []*bool
 	for _, artist := range items {
 		rows = append(rows, []string{
 			artist.ID,
 			artist.Name,
 		})
+		liked = append(liked, artist.Liked)
 	}
+	header, rows = f.addLikedColumn(header, rows, liked)
 	rows = f.addTotalRow(rows, "artists")
 
 	return tableData{header: header, rows: rows}
@@ -87,6 +105,7 @@ func (f *TableFormatter) formatGetArtists(items []*spotlikeApp.GetArtistUseCaseO
 func (f *TableFormatter) formatSearchAlbums(items []*spotlikeApp.SearchAlbumUseCaseOutputDto) tableData {
 	header := []string{"🆔 ID", "💿 Album", "🎤 Artists", "📅 Release Date"}
 	var rows [][]string
+	var liked []*bool
 	for _, album := range items {
 		rows = append(rows, []string{
 			album.ID,
@@ -94,7 +113,9 @@ func (f *TableFormatter) formatSearchAlbums(items []*spotlikeApp.SearchAlbumUseC
 			album.Artists,
 			album.ReleaseDate.Format("2006-01-02"),
 		})
+		liked = append(liked, album.Liked)
 	}
+	header, rows = f.addLikedColumn(header, rows, liked)
 	rows = f.addTotalRow(rows, "albums")
 
 	return tableData{header: header, rows: rows}
@@ -104,6 +125,7 @@ func (f *TableFormatter) formatSearchAlbums(items []*spotlikeApp.SearchAlbumUseC
 func (f *TableFormatter) formatGetAlbums(items []*spotlikeApp.GetAlbumUseCaseOutputDto) tableData {
 	var header []string
 	var rows [][]string
+	var liked []*bool
 	header = []string{"🆔 ID", "💿 Album", "🎤 Artists", "📅 Release Date"}
 	for _, item := range items {
 		rows = append(rows, []string{
@@ -112,7 +134,9 @@ func (f *TableFormatter) formatGetAlbums(items []*spotlikeApp.GetAlbumUseCaseOut
 			item.Artists,
 			item.ReleaseDate.Format("2006-01-02"),
 		})
+		liked = append(liked, item.Liked)
 	}
+	header, rows = f.addLikedColumn(header, rows, liked)
 	rows = f.addTotalRow(rows, "albums")
 
 	return tableData{header: header, rows: rows}
@@ -122,6 +146,7 @@ func (f *TableFormatter) formatGetAlbums(items []*spotlikeApp.GetAlbumUseCaseOut
 func (f *TableFormatter) formatSearchTracks(items []*spotlikeApp.SearchTrackUseCaseOutputDto) tableData {
 	header := []string{"🆔 ID", "🔢 Number", "🎵 Track", "💿 Album", "🎤 Artists", "📅 Release Date"}
 	var rows [][]string
+	var liked []*bool
 	for _, track := range items {
 		rows = append(rows, []string{
 			track.ID,
@@ -131,7 +156,9 @@ func (f *TableFormatter) formatSearchTracks(items []*spotlikeApp.SearchTrackUseC
 			track.Artists,
 			track.ReleaseDate.Format("2006-01-02"),
 		})
+		liked = append(liked, track.Liked)
 	}
+	header, rows = f.addLikedColumn(header, rows, liked)
 	rows = f.addTotalRow(rows, "tracks")
 
 	return tableData{header: header, rows: rows}
@@ -141,6 +168,7 @@ func (f *TableFormatter) formatSearchTracks(items []*spotlikeApp.SearchTrackUseC
 func (f *TableFormatter) formatGetTracks(items []*spotlikeApp.GetTrackUseCaseOutputDto) tableData {
 	var header []string
 	var rows [][]string
+	var liked []*bool
 	header = []string{"🆔 ID", "🔢 Number", "🎵 Track", "💿 Album", "🎤 Artists", "📅 Release Date"}
 	for _, item := range items {
 		rows = append(rows, []string{
@@ -151,12 +179,220 @@ func (f *TableFormatter) formatGetTracks(items []*spotlikeApp.GetTrackUseCaseOut
 			item.Artists,
 			item.ReleaseDate.Format("2006-01-02"),
 		})
+		liked = append(liked, item.Liked)
 	}
+	header, rows = f.addLikedColumn(header, rows, liked)
 	rows = f.addTotalRow(rows, "tracks")
 
 	return tableData{header: header, rows: rows}
 }
 
+// formatGetOperations formats the output of the get operations use case.
+func (f *TableFormatter) formatGetOperations(items []*spotlikeApp.GetOperationsUseCaseOutputDto) tableData {
+	header := []string{"🕒 Time", "📦 Batch", "🔧 Action", "📁 Type", "🆔 ID", "📛 Name", "👤 Account"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.Timestamp.Format("2006-01-02 15:04:05"),
+			item.BatchID,
+			item.Action,
+			item.Type,
+			item.ID,
+			item.Name,
+			item.Account,
+		})
+	}
+	rows = f.addTotalRow(rows, "operations")
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatOperationResults formats the results of liking or unliking the contents.
+func (f *TableFormatter) formatOperationResults(items []*spotlikeApp.OperationResultDto) tableData {
+	header := []string{"🆔 ID", "📁 Type", "📛 Name", "🔧 Action", "🚦 Status", "❌ Error"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.ID,
+			item.Type,
+			item.Name,
+			item.Action,
+			item.Status,
+			item.Error,
+		})
+	}
+	rows = f.addTotalRow(rows, "results")
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatDiagnoses formats the results of the checks of the setup of spotlike.
+func (f *TableFormatter) formatDiagnoses(items []*spotlikeApp.DiagnosisDto) tableData {
+	header := []string{"🩺 Check", "🚦 Status", "📝 Detail"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.Check,
+			item.Status,
+			item.Detail,
+		})
+	}
+	rows = f.addTotalRow(rows, "checks")
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatDiffLibraries formats the output of the diff libraries use case.
+func (f *TableFormatter) formatDiffLibraries(items []*spotlikeApp.DiffLibrariesUseCaseOutputDto) tableData {
+	header := []string{"🔀 Change", "🆔 ID", "📁 Type", "📛 Name", "🎤 Artists", "📅 Release Date", "🤍 Liked At"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.Change,
+			item.ID,
+			item.Type,
+			item.Name,
+			item.Artists,
+			formatDate(item.ReleaseDate),
+			formatDate(item.LikedAt),
+		})
+	}
+	rows = f.addTotalRow(rows, "changes")
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatLikeStates formats the states of the contents liked or not.
+func (f *TableFormatter) formatLikeStates(items []*spotlikeApp.LikeStateDto) tableData {
+	header := []string{"🆔 ID", "📁 Type", "📛 Name", "🤍 State"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.ID,
+			item.Type,
+			item.Name,
+			item.State,
+		})
+	}
+	rows = f.addTotalRow(rows, "contents")
+
+	return tableData{header: header, rows: rows}
+}
+
+// formatDate formats the date in the table, which is empty if the date is unknown.
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006-01-02")
+}
+
+// formatSearch formats the output of the search use case into the sections of each type.
+func (f *TableFormatter) formatSearch(result *spotlikeApp.SearchUseCaseOutputDto) (string, error) {
+	sections := []struct {
+		title string
+		data  tableData
+	}{
+		{title: "🎤 Artists", data: f.formatSearchArtists(result.Artists)},
+		{title: "💿 Albums", data: f.formatSearchAlbums(result.Albums)},
+		{title: "🎵 Tracks", data: f.formatSearchTracks(result.Tracks)},
+	}
+
+	var formatted []string
+	for _, section := range sections {
+		table, err := f.getTableString(section.data)
+		if err != nil {
+			return "", err
+		}
+		if table == "" {
+			continue
+		}
+		formatted = append(formatted, section.title+"\n"+table)
+	}
+
+	return strings.Join(formatted, "\n\n"), nil
+}
+
+// formatLibraryStats formats the output of the get library stats use case into the sections of each statistic.
+func (f *TableFormatter) formatLibraryStats(result *spotlikeApp.GetLibraryStatsUseCaseOutputDto) (string, error) {
+	var coverageRows [][]string
+	for _, item := range result.Coverages {
+		coverageRows = append(coverageRows, []string{
+			item.Name,
+			fmt.Sprintf("%d/%d", item.Liked, item.Total),
+			fmt.Sprintf("%.0f%%", item.Ratio*100),
+		})
+	}
+	sections := []struct {
+		title string
+		data  tableData
+	}{
+		{title: "🎤 Artists", data: f.formatLibraryStat("🎤 Artist", result.Artists)},
+		{title: "📅 Years", data: f.formatLibraryStat("📅 Year", result.Years)},
+		{title: "📅 Decades", data: f.formatLibraryStat("📅 Decade", result.Decades)},
+		{title: "🏷️ Genres", data: f.formatLibraryStat("🏷️ Genre", result.Genres)},
+		{title: "🗓️ Added per month", data: f.formatLibraryStat("🗓️ Month", result.Months)},
+		{title: "📈 Coverages", data: tableData{header: []string{"🎤 Artist", "🤍 Liked Tracks", "📈 Coverage"}, rows: coverageRows}},
+	}
+
+	formatted := []string{
+		fmt.Sprintf("👥 %d followed artists, 💿 %d liked albums, 🎵 %d liked tracks", result.FollowedArtists, result.LikedAlbums, result.LikedTracks),
+	}
+	for _, section := range sections {
+		table, err := f.getTableString(section.data)
+		if err != nil {
+			return "", err
+		}
+		if table == "" {
+			continue
+		}
+		formatted = append(formatted, section.title+"\n"+table)
+	}
+
+	return strings.Join(formatted, "\n\n"), nil
+}
+
+// formatLibraryStat formats the numbers of the albums and the tracks for each key of the statistic.
+func (f *TableFormatter) formatLibraryStat(key string, items []*spotlikeApp.LibraryStatDto) tableData {
+	header := []string{key, "💿 Albums", "🎵 Tracks"}
+	var rows [][]string
+	for _, item := range items {
+		rows = append(rows, []string{
+			item.Name,
+			fmt.Sprint(item.Albums),
+			fmt.Sprint(item.Tracks),
+		})
+	}
+
+	return tableData{header: header, rows: rows}
+}
+
+// addLikedColumn adds the column of whether each content is liked to the table if it is checked.
+func (f *TableFormatter) addLikedColumn(header []string, rows [][]string, liked []*bool) ([]string, [][]string) {
+	checked := false
+	for _, l := range liked {
+		if l != nil {
+			checked = true
+			break
+		}
+	}
+	if !checked {
+		return header, rows
+	}
+
+	header = append(header, "🤍 Liked")
+	for i := range rows {
+		mark := ""
+		if liked[i] != nil && *liked[i] {
+			mark = "💚"
+		}
+		rows[i] = append(rows[i], mark)
+	}
+
+	return header, rows
+}
+
 // addTotalRow adds a total row to the table.
 func (f *TableFormatter) addTotalRow(rows [][]string, contentType string) [][]string {
 	if len(rows) == 0 {
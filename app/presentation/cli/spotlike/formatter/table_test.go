@@ -44,6 +44,7 @@ func TestNewTableFormatter(t *testing.T) {
 
 func TestTableFormatter_Format(t *testing.T) {
 	su := utility.NewStringsUtil()
+	liked, notLiked := true, false
 
 	type args struct {
 		result any
@@ -161,6 +162,34 @@ func TestTableFormatter_Format(t *testing.T) {
 			want:    "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtrack_id_11track_name_1album_name_1artist_name_12000-01-01track_id_22track_name_2album_name_2artist_name_22000-01-01TOTAL:2tracks!",
 			wantErr: false,
 		},
+		{
+			name: "positive testing (result is SearchTrackUseCaseOutputDto with the liked states)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.SearchTrackUseCaseOutputDto{
+					{
+						ID:          "track_id_1",
+						Artists:     "artist_name_1",
+						Album:       "album_name_1",
+						Name:        "track_name_1",
+						TrackNumber: 1,
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Liked:       &liked,
+					},
+					{
+						ID:          "track_id_2",
+						Artists:     "artist_name_2",
+						Album:       "album_name_2",
+						Name:        "track_name_2",
+						TrackNumber: 2,
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Liked:       &notLiked,
+					},
+				},
+			},
+			want:    "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATE🤍LIKEDtrack_id_11track_name_1album_name_1artist_name_12000-01-01💚track_id_22track_name_2album_name_2artist_name_22000-01-01TOTAL:2tracks!",
+			wantErr: false,
+		},
 		{
 			name: "positive testing (result is GetTrackUseCaseOutputDto)",
 			f:    &TableFormatter{},
@@ -187,6 +216,173 @@ func TestTableFormatter_Format(t *testing.T) {
 			want:    "🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtrack_id_11track_name_1album_name_1artist_name_12000-01-01track_id_22track_name_2album_name_2artist_name_22000-01-01TOTAL:2tracks!",
 			wantErr: false,
 		},
+		{
+			name: "positive testing (result is GetOperationsUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.GetOperationsUseCaseOutputDto{
+					{
+						BatchID:     "batch_id_1",
+						Timestamp:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Type:        "track",
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Action:      "like",
+						CommandLine: "spotlike like track track_id_1",
+						Account:     "user_id_1",
+					},
+					{
+						BatchID:     "batch_id_2",
+						Timestamp:   time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+						Type:        "artist",
+						ID:          "artist_id_1",
+						Name:        "artist_name_1",
+						Action:      "unlike",
+						CommandLine: "spotlike unlike artist artist_id_1",
+					},
+				},
+			},
+			want:    "🕒TIME📦BATCH🔧ACTION📁TYPE🆔ID📛NAME👤ACCOUNT2000-01-0100:00:00batch_id_1liketracktrack_id_1track_name_1user_id_12000-01-0200:00:00batch_id_2unlikeartistartist_id_1artist_name_1TOTAL:2operations!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is OperationResultDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.OperationResultDto{
+					{
+						Type:   "track",
+						ID:     "track_id_1",
+						Name:   "track_name_1",
+						Action: "like",
+						Status: "liked",
+					},
+					{
+						Type:   "track",
+						ID:     "track_id_2",
+						Name:   "",
+						Action: "like",
+						Status: "failed",
+						Error:  "test error",
+					},
+				},
+			},
+			want:    "🆔ID📁TYPE📛NAME🔧ACTION🚦STATUS❌ERRORtrack_id_1tracktrack_name_1likelikedtrack_id_2tracklikefailedtesterrorTOTAL:2results!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is DiagnosisDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.DiagnosisDto{
+					{
+						Check:  "version",
+						Status: "pass",
+						Detail: "1.0.0",
+					},
+					{
+						Check:  "redirect uri",
+						Status: "fail",
+						Detail: "failed to get the port",
+					},
+				},
+			},
+			want:    "🩺CHECK🚦STATUS📝DETAILversionpass1.0.0redirecturifailfailedtogettheportTOTAL:2checks!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is DiffLibrariesUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.DiffLibrariesUseCaseOutputDto{
+					{
+						Change: spotlikeApp.LibraryChangeRemoved,
+						Type:   "artist",
+						ID:     "artist_id_1",
+						Name:   "artist_name_1",
+					},
+					{
+						Change:      spotlikeApp.LibraryChangeAdded,
+						Type:        "track",
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Artists:     "artist_name_1",
+						ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						LikedAt:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			want:    "🔀CHANGE🆔ID📁TYPE📛NAME🎤ARTISTS📅RELEASEDATE🤍LIKEDATremovedartist_id_1artistartist_name_1addedtrack_id_1tracktrack_name_1artist_name_12000-01-012020-01-01TOTAL:2changes!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is LikeStateDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: []*spotlikeApp.LikeStateDto{
+					{
+						Type:  "artist",
+						ID:    "artist_id_1",
+						Name:  "artist_name_1",
+						State: spotlikeApp.LikeStateFollowed,
+					},
+					{
+						Type:  "track",
+						ID:    "track_id_1",
+						Name:  "track_name_1",
+						State: spotlikeApp.LikeStateNotLiked,
+					},
+				},
+			},
+			want:    "🆔ID📁TYPE📛NAME🤍STATEartist_id_1artistartist_name_1followedtrack_id_1tracktrack_name_1not_likedTOTAL:2contents!",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is GetLibraryStatsUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: &spotlikeApp.GetLibraryStatsUseCaseOutputDto{
+					FollowedArtists: 1,
+					LikedAlbums:     1,
+					LikedTracks:     2,
+					Artists:         []*spotlikeApp.LibraryStatDto{{Name: "artist_name_1", Albums: 1, Tracks: 2}},
+					Years:           []*spotlikeApp.LibraryStatDto{{Name: "2000", Albums: 1, Tracks: 2}},
+					Decades:         []*spotlikeApp.LibraryStatDto{{Name: "2000s", Albums: 1, Tracks: 2}},
+					Genres:          []*spotlikeApp.LibraryStatDto{{Name: "genre_1", Albums: 1, Tracks: 2}},
+					Months:          []*spotlikeApp.LibraryStatDto{{Name: "2020-01", Albums: 1, Tracks: 2}},
+					Coverages:       []*spotlikeApp.LibraryCoverageDto{{ID: "artist_id_1", Name: "artist_name_1", Liked: 2, Total: 8, Ratio: 0.25}},
+				},
+			},
+			want:    "👥1followedartists,💿1likedalbums,🎵2likedtracks🎤Artists🎤ARTIST💿ALBUMS🎵TRACKSartist_name_112📅Years📅YEAR💿ALBUMS🎵TRACKS200012📅Decades📅DECADE💿ALBUMS🎵TRACKS2000s12🏷️Genres🏷️GENRE💿ALBUMS🎵TRACKSgenre_112🗓️Addedpermonth🗓️MONTH💿ALBUMS🎵TRACKS2020-0112📈Coverages🎤ARTIST🤍LIKEDTRACKS📈COVERAGEartist_name_12/825%",
+			wantErr: false,
+		},
+		{
+			name: "positive testing (result is SearchUseCaseOutputDto)",
+			f:    &TableFormatter{},
+			args: args{
+				result: &spotlikeApp.SearchUseCaseOutputDto{
+					Artists: []*spotlikeApp.SearchArtistUseCaseOutputDto{
+						{
+							ID:   "artist_id_1",
+							Name: "artist_name_1",
+						},
+					},
+					Albums: nil,
+					Tracks: []*spotlikeApp.SearchTrackUseCaseOutputDto{
+						{
+							ID:          "track_id_1",
+							Artists:     "artist_name_1",
+							Album:       "album_name_1",
+							Name:        "track_name_1",
+							TrackNumber: 1,
+							ReleaseDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			want:    "🎤Artists🆔ID🎤ARTISTartist_id_1artist_name_1TOTAL:1artists!🎵Tracks🆔ID🔢NUMBER🎵TRACK💿ALBUM🎤ARTISTS📅RELEASEDATEtrack_id_11track_name_1album_name_1artist_name_12000-01-01TOTAL:1tracks!",
+			wantErr: false,
+		},
 		{
 			name: "negative testing (result is invalid)",
 			f:    &TableFormatter{},
@@ -578,6 +774,123 @@ func TestTableFormatter_formatGetTracks(t *testing.T) {
 	}
 }
 
+func TestTableFormatter_formatGetOperations(t *testing.T) {
+	type args struct {
+		items []*spotlikeApp.GetOperationsUseCaseOutputDto
+	}
+	tests := []struct {
+		name string
+		f    *TableFormatter
+		args args
+		want tableData
+	}{
+		{
+			name: "positive testing (items is empty)",
+			f:    &TableFormatter{},
+			args: args{
+				items: []*spotlikeApp.GetOperationsUseCaseOutputDto{},
+			},
+			want: tableData{
+				header: []string{"🕒 Time", "📦 Batch", "🔧 Action", "📁 Type", "🆔 ID", "📛 Name", "👤 Account"},
+				rows:   [][]string{},
+			},
+		},
+		{
+			name: "positive testing (items is not empty)",
+			f:    &TableFormatter{},
+			args: args{
+				items: []*spotlikeApp.GetOperationsUseCaseOutputDto{
+					{
+						BatchID:     "batch_id_1",
+						Timestamp:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Type:        "track",
+						ID:          "track_id_1",
+						Name:        "track_name_1",
+						Action:      "like",
+						CommandLine: "spotlike like track track_id_1",
+						Account:     "user_id_1",
+					},
+					{
+						BatchID:     "batch_id_2",
+						Timestamp:   time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+						Type:        "artist",
+						ID:          "artist_id_1",
+						Name:        "artist_name_1",
+						Action:      "unlike",
+						CommandLine: "spotlike unlike artist artist_id_1",
+					},
+				},
+			},
+			want: tableData{
+				header: []string{"🕒 Time", "📦 Batch", "🔧 Action", "📁 Type", "🆔 ID", "📛 Name", "👤 Account"},
+				rows: [][]string{
+					{"2000-01-01 00:00:00", "batch_id_1", "like", "track", "track_id_1", "track_name_1", "user_id_1"},
+					{"2000-01-02 00:00:00", "batch_id_2", "unlike", "artist", "artist_id_1", "artist_name_1", ""},
+					{"", "", "", "", "", "", ""},
+					{"TOTAL : 2 operations!", "", "", "", "", "", ""},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &TableFormatter{}
+			if got := f.formatGetOperations(tt.args.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TableFormatter.formatGetOperations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableFormatter_addLikedColumn(t *testing.T) {
+	liked, notLiked := true, false
+
+	type args struct {
+		header []string
+		rows   [][]string
+		liked  []*bool
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantHeader []string
+		wantRows   [][]string
+	}{
+		{
+			name: "positive testing (not checked)",
+			args: args{
+				header: []string{"🆔 ID", "🎤 Artist"},
+				rows:   [][]string{{"id_1", "name_1"}},
+				liked:  []*bool{nil},
+			},
+			wantHeader: []string{"🆔 ID", "🎤 Artist"},
+			wantRows:   [][]string{{"id_1", "name_1"}},
+		},
+		{
+			name: "positive testing (checked)",
+			args: args{
+				header: []string{"🆔 ID", "🎤 Artist"},
+				rows:   [][]string{{"id_1", "name_1"}, {"id_2", "name_2"}},
+				liked:  []*bool{&liked, &notLiked},
+			},
+			wantHeader: []string{"🆔 ID", "🎤 Artist", "🤍 Liked"},
+			wantRows:   [][]string{{"id_1", "name_1", "💚"}, {"id_2", "name_2", ""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &TableFormatter{}
+			gotHeader, gotRows := f.addLikedColumn(tt.args.header, tt.args.rows, tt.args.liked)
+			if !reflect.DeepEqual(gotHeader, tt.wantHeader) {
+				t.Errorf("TableFormatter.addLikedColumn() header = %v, want %v", gotHeader, tt.wantHeader)
+			}
+			if !reflect.DeepEqual(gotRows, tt.wantRows) {
+				t.Errorf("TableFormatter.addLikedColumn() rows = %v, want %v", gotRows, tt.wantRows)
+			}
+		})
+	}
+}
+
 func TestTableFormatter_addTotalRow(t *testing.T) {
 	type args struct {
 		rows        [][]string
@@ -780,3 +1093,37 @@ func TestTableFormatter_getTableString_renderError(t *testing.T) {
 		t.Errorf("TableFormatter.getTableString() = %v, want empty", got)
 	}
 }
+
+func TestTableFormatter_formatSearch_renderError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	origTu := getDefaultTableWriterUtil()
+	defer setTableWriterUtil(origTu)
+
+	mockTable := proxy.NewMockTable(mockCtrl)
+	mockTable.EXPECT().Header(gomock.Any())
+	mockTable.EXPECT().Bulk(gomock.Any()).Return(nil)
+	mockTable.EXPECT().Render().Return(errors.New("render error"))
+
+	mockTwu := utility.NewMockTableWriterUtil(mockCtrl)
+	mockTwu.EXPECT().GetNewDefaultTable(gomock.Any()).Return(mockTable)
+
+	setTableWriterUtil(mockTwu)
+
+	f := &TableFormatter{}
+	got, err := f.formatSearch(&spotlikeApp.SearchUseCaseOutputDto{
+		Artists: []*spotlikeApp.SearchArtistUseCaseOutputDto{
+			{
+				ID:   "artist_id_1",
+				Name: "artist_name_1",
+			},
+		},
+	})
+	if err == nil {
+		t.Errorf("TableFormatter.formatSearch() error = nil, wantErr true")
+	}
+	if got != "" {
+		t.Errorf("TableFormatter.formatSearch() = %v, want empty", got)
+	}
+}
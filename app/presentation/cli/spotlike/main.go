@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command"
 
@@ -53,10 +55,18 @@ var (
 
 // main is the entry point of spotlike cli.
 func main() {
+	ctx, stop := signal.NotifyContext(spotlikeCliParams.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		// restore the default behavior after the first signal so that the second one terminates spotlike immediately
+		<-ctx.Done()
+		stop()
+	}()
+
 	cli := command.NewCli(
 		exit,
 		spotlikeCliParams.Cobra,
-		spotlikeCliParams.Context,
+		ctx,
 	)
 
 	if exitCode := cli.Init(
@@ -71,6 +81,5 @@ func main() {
 		exit(exitCode)
 	}
 
-	defer spotlikeCliParams.Context.Done()
 	exit(cli.Run())
 }
@@ -77,7 +77,17 @@ func Test_main(t *testing.T) {
 				"- 📚 get,        ge,   g - Get the information of the content on Spotify by ID.\n" +
 				"- 🤍 like,       li,   l - Like content on Spotify by ID.\n" +
 				"- 💔 unlike,     un,   u - Unlike content on Spotify by ID.\n" +
+				"- 🔎 check,      ch,   k - Check whether content on Spotify is liked by ID.\n" +
 				"- 🔍 search,     se,   s - Search for the ID of content in Spotify.\n" +
+				"- 🕒 history,    hi,   h - Show the history of like and unlike operations.\n" +
+				"- ⏪ undo,       ud,   U - Undo like and unlike operations.\n" +
+				"- 🆕 releases,   re,   r - Browse new releases from the followed artists and like them.\n" +
+				"- 👀 watch,      wa,   w - Watch new releases and like them periodically with the rules.\n" +
+				"- 📜 apply,      ap,   A - Apply the rules file to your library.\n" +
+				"- 🔀 diff,       df,   D - Compare the snapshots of your library.\n" +
+				"- 📊 stats,      st,   S - Show the statistics of your library.\n" +
+				"- 🗄️ cache,      ca,   C - Manage the cache of the catalog lookups.\n" +
+				"- 🩺 doctor,     dr,   d - Diagnose the setup of spotlike.\n" +
 				"- 🔧 completion, comp, c - Generate the autocompletion script for the specified shell.\n" +
 				"- 🔖 version,    ver,  v - Show the version of spotlike.\n" +
 				"- 🤝 help                - Help for spotlike.\n\n" +
@@ -108,6 +118,27 @@ func Test_main(t *testing.T) {
 			setup:      nil,
 			cleanup:    nil,
 		},
+		{
+			name: "version execution (spotlike -v)",
+			fields: fields{
+				Os:        os,
+				StdBuffer: stdBuffer,
+				ErrBuffer: errBuffer,
+			},
+			args: args{
+				fnc: func() {
+					o.Args = []string{"/path/to/spotlike", "-v"}
+					defer func() {
+						o.Args = origArgs
+					}()
+					main()
+				},
+			},
+			wantStdOut: "spotlike version (devel)\n",
+			wantStdErr: "",
+			setup:      nil,
+			cleanup:    nil,
+		},
 		{
 			name: "help execution (spotlike --help)",
 			fields: fields{
@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ProgressReporter is a struct that reports the progress of long running operations on a single line.
+type ProgressReporter struct {
+	writer    io.Writer
+	enabled   bool
+	label     string
+	startDone int
+	startTime time.Time
+	now       func() time.Time
+}
+
+// NewProgressReporter returns a new instance of the ProgressReporter struct.
+func NewProgressReporter(writer io.Writer, enabled bool) *ProgressReporter {
+	return &ProgressReporter{
+		writer:  writer,
+		enabled: enabled,
+		now:     time.Now,
+	}
+}
+
+// IsTerminal returns whether the file is a terminal.
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Enabled returns whether the progress is reported.
+func (p *ProgressReporter) Enabled() bool {
+	return p.enabled
+}
+
+// Report overwrites the line with the progress and the estimated time remaining.
+// The estimation restarts when the label changes.
+func (p *ProgressReporter) Report(label string, done int, total int) {
+	if !p.enabled {
+		return
+	}
+
+	now := p.now()
+	if label != p.label {
+		p.label = label
+		p.startDone = done
+		p.startTime = now
+	}
+
+	line := fmt.Sprintf("⏳ %s %d/%d", label, done, total)
+	if done > p.startDone && done < total {
+		elapsed := now.Sub(p.startTime)
+		eta := elapsed / time.Duration(done-p.startDone) * time.Duration(total-done)
+		line += " (ETA " + eta.Round(time.Second).String() + ")"
+	}
+	_, _ = fmt.Fprint(p.writer, "\r\033[K"+line)
+}
+
+// Done clears the line of the progress.
+func (p *ProgressReporter) Done() {
+	if !p.enabled || p.label == "" {
+		return
+	}
+
+	p.label = ""
+	_, _ = fmt.Fprint(p.writer, "\r\033[K")
+}
@@ -0,0 +1,88 @@
+package presenter
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestIsTerminal(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "file")
+	if err != nil {
+		t.Fatalf("Failed to create a file: %v", err)
+	}
+	defer file.Close()
+
+	if IsTerminal(file) {
+		t.Errorf("IsTerminal() = true, want false for a regular file")
+	}
+}
+
+func TestProgressReporter_Report(t *testing.T) {
+	start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	type report struct {
+		label   string
+		done    int
+		total   int
+		elapsed time.Duration
+	}
+	tests := []struct {
+		name    string
+		enabled bool
+		reports []report
+		done    bool
+		want    string
+	}{
+		{
+			name:    "positive testing",
+			enabled: true,
+			reports: []report{
+				{label: "albums fetched", done: 0, total: 4, elapsed: 0},
+				{label: "albums fetched", done: 1, total: 4, elapsed: 2 * time.Second},
+			},
+			done: false,
+			want: "\r\033[K⏳ albums fetched 0/4" +
+				"\r\033[K⏳ albums fetched 1/4 (ETA 6s)",
+		},
+		{
+			name:    "positive testing (label changed)",
+			enabled: true,
+			reports: []report{
+				{label: "albums fetched", done: 4, total: 4, elapsed: 0},
+				{label: "tracks liked", done: 0, total: 2, elapsed: 10 * time.Second},
+				{label: "tracks liked", done: 1, total: 2, elapsed: 11 * time.Second},
+			},
+			done: true,
+			want: "\r\033[K⏳ albums fetched 4/4" +
+				"\r\033[K⏳ tracks liked 0/2" +
+				"\r\033[K⏳ tracks liked 1/2 (ETA 1s)" +
+				"\r\033[K",
+		},
+		{
+			name:    "positive testing (disabled)",
+			enabled: false,
+			reports: []report{
+				{label: "albums fetched", done: 1, total: 4, elapsed: 0},
+			},
+			done: true,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			p := NewProgressReporter(buffer, tt.enabled)
+			for _, r := range tt.reports {
+				p.now = func() time.Time { return start.Add(r.elapsed) }
+				p.Report(r.label, r.done, r.total)
+			}
+			if tt.done {
+				p.Done()
+			}
+			if got := buffer.String(); got != tt.want {
+				t.Errorf("ProgressReporter output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package presenter
 
 import (
+	"errors"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+
 	"github.com/yanosea/spotlike/pkg/proxy"
 	"github.com/yanosea/spotlike/pkg/utility"
 )
@@ -13,12 +17,64 @@ var (
 // RunPrompt runs the prompt.
 func RunPrompt(label string) (string, error) {
 	prompt := Pu.GetPrompt(label)
-	return prompt.Run()
+	answer, err := prompt.Run()
+	return answer, wrapInterrupt(err)
 }
 
 // RunPromptWithMask runs the prompt with mask.
 func RunPromptWithMask(label string, mask rune) (string, error) {
 	prompt := Pu.GetPrompt(label)
 	prompt.SetMask(mask)
-	return prompt.Run()
+	answer, err := prompt.Run()
+	return answer, wrapInterrupt(err)
+}
+
+// RunMultiSelect runs the select repeatedly to toggle the items and returns the indexes of the selected items.
+// The selection is finished when the last "done" item is chosen.
+func RunMultiSelect(label string, items []string) ([]int, error) {
+	selected := make([]bool, len(items))
+	cursorPos := 0
+	for {
+		var options []string
+		for i, item := range items {
+			if selected[i] {
+				options = append(options, "[x] "+item)
+			} else {
+				options = append(options, "[ ] "+item)
+			}
+		}
+		options = append(options, "✅ Done")
+
+		s := Pu.GetSelect(label, options)
+		s.SetSize(len(options))
+		s.SetCursorPos(cursorPos)
+		index, _, err := s.Run()
+		if err != nil {
+			return nil, wrapInterrupt(err)
+		}
+		if index >= len(items) {
+			break
+		}
+
+		selected[index] = !selected[index]
+		cursorPos = index
+	}
+
+	var indexes []int
+	for i, s := range selected {
+		if s {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes, nil
+}
+
+// wrapInterrupt classifies the interruption of the prompt with ctrl+c as the cancellation by the user.
+func wrapInterrupt(err error) error {
+	if errors.Is(err, proxy.ErrInterrupt) {
+		return api.NewError(api.ErrCanceled, err)
+	}
+
+	return err
 }
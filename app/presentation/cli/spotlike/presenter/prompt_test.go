@@ -1,6 +1,7 @@
 package presenter
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/yanosea/spotlike/pkg/proxy"
@@ -127,3 +128,98 @@ func TestRunPromptWithMask(t *testing.T) {
 		})
 	}
 }
+
+func TestRunMultiSelect(t *testing.T) {
+	origPu := Pu
+
+	type args struct {
+		label string
+		items []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []int
+		wantErr bool
+		setup   func(mockCtrl *gomock.Controller)
+		cleanup func()
+	}{
+		{
+			name: "positive testing",
+			args: args{
+				label: "test label",
+				items: []string{"test item 1", "test item 2"},
+			},
+			want:    []int{1},
+			wantErr: false,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSelect := proxy.NewMockSelect(mockCtrl)
+				mockSelect.EXPECT().SetLabel("test label").Times(4)
+				mockSelect.EXPECT().SetItems([]string{"[ ] test item 1", "[ ] test item 2", "✅ Done"})
+				mockSelect.EXPECT().SetItems([]string{"[x] test item 1", "[ ] test item 2", "✅ Done"})
+				mockSelect.EXPECT().SetItems([]string{"[x] test item 1", "[x] test item 2", "✅ Done"})
+				mockSelect.EXPECT().SetItems([]string{"[ ] test item 1", "[x] test item 2", "✅ Done"})
+				mockSelect.EXPECT().SetSize(3).Times(4)
+				mockSelect.EXPECT().SetCursorPos(0)
+				mockSelect.EXPECT().SetCursorPos(0)
+				mockSelect.EXPECT().SetCursorPos(1)
+				mockSelect.EXPECT().SetCursorPos(0)
+				mockSelect.EXPECT().Run().Return(0, "[ ] test item 1", nil)
+				mockSelect.EXPECT().Run().Return(1, "[ ] test item 2", nil)
+				mockSelect.EXPECT().Run().Return(0, "[x] test item 1", nil)
+				mockSelect.EXPECT().Run().Return(2, "✅ Done", nil)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewSelect().Return(mockSelect).Times(4)
+				Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				Pu = origPu
+			},
+		},
+		{
+			name: "negative testing (s.Run() failed)",
+			args: args{
+				label: "test label",
+				items: []string{"test item 1"},
+			},
+			want:    nil,
+			wantErr: true,
+			setup: func(mockCtrl *gomock.Controller) {
+				mockSelect := proxy.NewMockSelect(mockCtrl)
+				mockSelect.EXPECT().SetLabel("test label")
+				mockSelect.EXPECT().SetItems([]string{"[ ] test item 1", "✅ Done"})
+				mockSelect.EXPECT().SetSize(2)
+				mockSelect.EXPECT().SetCursorPos(0)
+				mockSelect.EXPECT().Run().Return(0, "", proxy.ErrInterrupt)
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockPromptui.EXPECT().NewSelect().Return(mockSelect)
+				Pu = utility.NewPromptUtil(mockPromptui)
+			},
+			cleanup: func() {
+				Pu = origPu
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.setup(mockCtrl)
+			}
+			defer func() {
+				if tt.cleanup != nil {
+					tt.cleanup()
+				}
+			}()
+			got, err := RunMultiSelect(tt.args.label, tt.args.items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunMultiSelect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunMultiSelect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
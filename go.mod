@@ -13,6 +13,7 @@ require (
 	github.com/zmb3/spotify/v2 v2.4.3
 	go.uber.org/mock v0.6.0
 	golang.org/x/oauth2 v0.36.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
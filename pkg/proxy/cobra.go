@@ -41,6 +41,7 @@ type Command interface {
 	SetAliases(s []string)
 	SetArgs(f cobra.PositionalArgs)
 	SetHelpTemplate(s string)
+	SetPersistentPreRunE(f func(*cobra.Command, []string) error)
 	SetRunE(f func(*cobra.Command, []string) error)
 	SetSilenceErrors(b bool)
 	SetUse(s string)
@@ -99,6 +100,11 @@ func (c *commandProxy) SetHelpTemplate(s string) {
 	c.command.SetHelpTemplate(s)
 }
 
+// SetPersistentPreRunE is a proxy method that sets the PersistentPreRunE field of the cobra.Command.
+func (c *commandProxy) SetPersistentPreRunE(f func(*cobra.Command, []string) error) {
+	c.command.PersistentPreRunE = f
+}
+
 // SetRunE is a proxy method that calls the SetRunE method of the cobra.Command.
 func (c *commandProxy) SetRunE(f func(*cobra.Command, []string) error) {
 	c.command.RunE = f
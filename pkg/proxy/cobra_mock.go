@@ -215,6 +215,18 @@ func (mr *MockCommandMockRecorder) SetHelpTemplate(s any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHelpTemplate", reflect.TypeOf((*MockCommand)(nil).SetHelpTemplate), s)
 }
 
+// SetPersistentPreRunE mocks base method.
+func (m *MockCommand) SetPersistentPreRunE(f func(*cobra.Command, []string) error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPersistentPreRunE", f)
+}
+
+// SetPersistentPreRunE indicates an expected call of SetPersistentPreRunE.
+func (mr *MockCommandMockRecorder) SetPersistentPreRunE(f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPersistentPreRunE", reflect.TypeOf((*MockCommand)(nil).SetPersistentPreRunE), f)
+}
+
 // SetRunE mocks base method.
 func (m *MockCommand) SetRunE(f func(*cobra.Command, []string) error) {
 	m.ctrl.T.Helper()
@@ -4,9 +4,15 @@ import (
 	"github.com/manifoldco/promptui"
 )
 
+var (
+	// ErrInterrupt is the error returned when the prompt is interrupted with ctrl+c.
+	ErrInterrupt = promptui.ErrInterrupt
+)
+
 // Promptui is an interface that provides a proxy of the methods of promptui.
 type Promptui interface {
 	NewPrompt() Prompt
+	NewSelect() Select
 }
 
 // promptuiProxy is a proxy struct that implements the Promptui interface.
@@ -22,6 +28,11 @@ func (p *promptuiProxy) NewPrompt() Prompt {
 	return &promptProxy{prompt: &promptui.Prompt{}}
 }
 
+// NewSelect returns a new instance of the promptui.Select.
+func (p *promptuiProxy) NewSelect() Select {
+	return &selectProxy{selectPrompt: &promptui.Select{HideSelected: true}}
+}
+
 // Prompt is an interface that provides a proxy of the methods of promptui.Prompt.
 type Prompt interface {
 	Run() (string, error)
@@ -48,3 +59,42 @@ func (p *promptProxy) SetLabel(label string) {
 func (p *promptProxy) SetMask(mask rune) {
 	p.prompt.Mask = mask
 }
+
+// Select is an interface that provides a proxy of the methods of promptui.Select.
+type Select interface {
+	Run() (int, string, error)
+	SetCursorPos(cursorPos int)
+	SetItems(items []string)
+	SetLabel(label string)
+	SetSize(size int)
+}
+
+// selectProxy is a proxy struct that implements the Select interface.
+type selectProxy struct {
+	selectPrompt *promptui.Select
+}
+
+// Run runs the select.
+func (s *selectProxy) Run() (int, string, error) {
+	return s.selectPrompt.Run()
+}
+
+// SetCursorPos sets the initial position of the cursor of the select.
+func (s *selectProxy) SetCursorPos(cursorPos int) {
+	s.selectPrompt.CursorPos = cursorPos
+}
+
+// SetItems sets the items of the select.
+func (s *selectProxy) SetItems(items []string) {
+	s.selectPrompt.Items = items
+}
+
+// SetLabel sets the label of the select.
+func (s *selectProxy) SetLabel(label string) {
+	s.selectPrompt.Label = label
+}
+
+// SetSize sets the number of the items displayed at once in the select.
+func (s *selectProxy) SetSize(size int) {
+	s.selectPrompt.Size = size
+}
@@ -53,6 +53,20 @@ func (mr *MockPromptuiMockRecorder) NewPrompt() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPrompt", reflect.TypeOf((*MockPromptui)(nil).NewPrompt))
 }
 
+// NewSelect mocks base method.
+func (m *MockPromptui) NewSelect() Select {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSelect")
+	ret0, _ := ret[0].(Select)
+	return ret0
+}
+
+// NewSelect indicates an expected call of NewSelect.
+func (mr *MockPromptuiMockRecorder) NewSelect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSelect", reflect.TypeOf((*MockPromptui)(nil).NewSelect))
+}
+
 // MockPrompt is a mock of Prompt interface.
 type MockPrompt struct {
 	ctrl     *gomock.Controller
@@ -115,3 +129,91 @@ func (mr *MockPromptMockRecorder) SetMask(mask any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMask", reflect.TypeOf((*MockPrompt)(nil).SetMask), mask)
 }
+
+// MockSelect is a mock of Select interface.
+type MockSelect struct {
+	ctrl     *gomock.Controller
+	recorder *MockSelectMockRecorder
+	isgomock struct{}
+}
+
+// MockSelectMockRecorder is the mock recorder for MockSelect.
+type MockSelectMockRecorder struct {
+	mock *MockSelect
+}
+
+// NewMockSelect creates a new mock instance.
+func NewMockSelect(ctrl *gomock.Controller) *MockSelect {
+	mock := &MockSelect{ctrl: ctrl}
+	mock.recorder = &MockSelectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSelect) EXPECT() *MockSelectMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockSelect) Run() (int, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockSelectMockRecorder) Run() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockSelect)(nil).Run))
+}
+
+// SetCursorPos mocks base method.
+func (m *MockSelect) SetCursorPos(cursorPos int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCursorPos", cursorPos)
+}
+
+// SetCursorPos indicates an expected call of SetCursorPos.
+func (mr *MockSelectMockRecorder) SetCursorPos(cursorPos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCursorPos", reflect.TypeOf((*MockSelect)(nil).SetCursorPos), cursorPos)
+}
+
+// SetItems mocks base method.
+func (m *MockSelect) SetItems(items []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetItems", items)
+}
+
+// SetItems indicates an expected call of SetItems.
+func (mr *MockSelectMockRecorder) SetItems(items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItems", reflect.TypeOf((*MockSelect)(nil).SetItems), items)
+}
+
+// SetLabel mocks base method.
+func (m *MockSelect) SetLabel(label string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLabel", label)
+}
+
+// SetLabel indicates an expected call of SetLabel.
+func (mr *MockSelectMockRecorder) SetLabel(label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabel", reflect.TypeOf((*MockSelect)(nil).SetLabel), label)
+}
+
+// SetSize mocks base method.
+func (m *MockSelect) SetSize(size int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSize", size)
+}
+
+// SetSize indicates an expected call of SetSize.
+func (mr *MockSelectMockRecorder) SetSize(size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSize", reflect.TypeOf((*MockSelect)(nil).SetSize), size)
+}
@@ -65,7 +65,11 @@ func (a *authenticatorProxy) Token(ctx context.Context, state string, r *http.Re
 type Client interface {
 	AddAlbumsToLibrary(ctx context.Context, ids ...spotify.ID) error
 	AddTracksToLibrary(ctx context.Context, ids ...spotify.ID) error
+	CurrentUser(ctx context.Context) (*spotify.PrivateUser, error)
 	CurrentUserFollows(ctx context.Context, t string, ids ...spotify.ID) ([]bool, error)
+	CurrentUsersAlbums(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedAlbumPage, error)
+	CurrentUsersFollowedArtists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.FullArtistCursorPage, error)
+	CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error)
 	FollowArtist(ctx context.Context, id spotify.ID) error
 	GetAlbum(ctx context.Context, id spotify.ID, opts ...spotify.RequestOption) (*spotify.FullAlbum, error)
 	GetAlbumTracks(ctx context.Context, id spotify.ID, opts ...spotify.RequestOption) (*spotify.SimpleTrackPage, error)
@@ -95,11 +99,31 @@ func (c *clientProxy) AddTracksToLibrary(ctx context.Context, ids ...spotify.ID)
 	return c.client.AddTracksToLibrary(ctx, ids...)
 }
 
+// CurrentUser is a proxy method that calls the CurrentUser method of the spotify.Client.
+func (c *clientProxy) CurrentUser(ctx context.Context) (*spotify.PrivateUser, error) {
+	return c.client.CurrentUser(ctx)
+}
+
 // CurrentUserFollows is a proxy method that calls the CurrentUserFollows method of the spotify.Client.
 func (c *clientProxy) CurrentUserFollows(ctx context.Context, t string, ids ...spotify.ID) ([]bool, error) {
 	return c.client.CurrentUserFollows(ctx, t, ids...)
 }
 
+// CurrentUsersAlbums is a proxy method that calls the CurrentUsersAlbums method of the spotify.Client.
+func (c *clientProxy) CurrentUsersAlbums(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedAlbumPage, error) {
+	return c.client.CurrentUsersAlbums(ctx, opts...)
+}
+
+// CurrentUsersFollowedArtists is a proxy method that calls the CurrentUsersFollowedArtists method of the spotify.Client.
+func (c *clientProxy) CurrentUsersFollowedArtists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.FullArtistCursorPage, error) {
+	return c.client.CurrentUsersFollowedArtists(ctx, opts...)
+}
+
+// CurrentUsersTracks is a proxy method that calls the CurrentUsersTracks method of the spotify.Client.
+func (c *clientProxy) CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error) {
+	return c.client.CurrentUsersTracks(ctx, opts...)
+}
+
 // FollowArtist is a proxy method that calls the FollowArtist method of the spotify.Client.
 func (c *clientProxy) FollowArtist(ctx context.Context, id spotify.ID) error {
 	return c.client.FollowArtist(ctx, id)
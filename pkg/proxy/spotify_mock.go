@@ -210,6 +210,21 @@ func (mr *MockClientMockRecorder) AddTracksToLibrary(ctx any, ids ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTracksToLibrary", reflect.TypeOf((*MockClient)(nil).AddTracksToLibrary), varargs...)
 }
 
+// CurrentUser mocks base method.
+func (m *MockClient) CurrentUser(ctx context.Context) (*spotify.PrivateUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentUser", ctx)
+	ret0, _ := ret[0].(*spotify.PrivateUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentUser indicates an expected call of CurrentUser.
+func (mr *MockClientMockRecorder) CurrentUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUser", reflect.TypeOf((*MockClient)(nil).CurrentUser), ctx)
+}
+
 // CurrentUserFollows mocks base method.
 func (m *MockClient) CurrentUserFollows(ctx context.Context, t string, ids ...spotify.ID) ([]bool, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +245,66 @@ func (mr *MockClientMockRecorder) CurrentUserFollows(ctx, t any, ids ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUserFollows", reflect.TypeOf((*MockClient)(nil).CurrentUserFollows), varargs...)
 }
 
+// CurrentUsersAlbums mocks base method.
+func (m *MockClient) CurrentUsersAlbums(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedAlbumPage, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CurrentUsersAlbums", varargs...)
+	ret0, _ := ret[0].(*spotify.SavedAlbumPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentUsersAlbums indicates an expected call of CurrentUsersAlbums.
+func (mr *MockClientMockRecorder) CurrentUsersAlbums(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUsersAlbums", reflect.TypeOf((*MockClient)(nil).CurrentUsersAlbums), varargs...)
+}
+
+// CurrentUsersFollowedArtists mocks base method.
+func (m *MockClient) CurrentUsersFollowedArtists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.FullArtistCursorPage, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CurrentUsersFollowedArtists", varargs...)
+	ret0, _ := ret[0].(*spotify.FullArtistCursorPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentUsersFollowedArtists indicates an expected call of CurrentUsersFollowedArtists.
+func (mr *MockClientMockRecorder) CurrentUsersFollowedArtists(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUsersFollowedArtists", reflect.TypeOf((*MockClient)(nil).CurrentUsersFollowedArtists), varargs...)
+}
+
+// CurrentUsersTracks mocks base method.
+func (m *MockClient) CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CurrentUsersTracks", varargs...)
+	ret0, _ := ret[0].(*spotify.SavedTrackPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentUsersTracks indicates an expected call of CurrentUsersTracks.
+func (mr *MockClientMockRecorder) CurrentUsersTracks(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentUsersTracks", reflect.TypeOf((*MockClient)(nil).CurrentUsersTracks), varargs...)
+}
+
 // FollowArtist mocks base method.
 func (m *MockClient) FollowArtist(ctx context.Context, id spotify.ID) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,23 @@
+package proxy
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Yaml is an interface that provides a proxy of the methods of yaml.
+type Yaml interface {
+	Unmarshal(in []byte, out any) error
+}
+
+// yamlProxy is a proxy struct that implements the Yaml interface.
+type yamlProxy struct{}
+
+// NewYaml returns a new instance of the Yaml interface.
+func NewYaml() Yaml {
+	return &yamlProxy{}
+}
+
+// Unmarshal decodes the YAML document in the bytes into the value.
+func (yamlProxy) Unmarshal(in []byte, out any) error {
+	return yaml.Unmarshal(in, out)
+}
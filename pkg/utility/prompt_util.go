@@ -6,6 +6,7 @@ import (
 
 type PromptUtil interface {
 	GetPrompt(label string) proxy.Prompt
+	GetSelect(label string, items []string) proxy.Select
 }
 
 // promptUtil is a struct that implements the PromptUtil interface.
@@ -29,3 +30,12 @@ func (p *promptUtil) GetPrompt(label string) proxy.Prompt {
 
 	return prompt
 }
+
+// GetSelect returns a new instance of the promptui.Select.
+func (p *promptUtil) GetSelect(label string, items []string) proxy.Select {
+	s := p.promptui.NewSelect()
+	s.SetLabel(label)
+	s.SetItems(items)
+
+	return s
+}
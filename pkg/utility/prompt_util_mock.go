@@ -53,3 +53,17 @@ func (mr *MockPromptUtilMockRecorder) GetPrompt(label any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrompt", reflect.TypeOf((*MockPromptUtil)(nil).GetPrompt), label)
 }
+
+// GetSelect mocks base method.
+func (m *MockPromptUtil) GetSelect(label string, items []string) proxy.Select {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelect", label, items)
+	ret0, _ := ret[0].(proxy.Select)
+	return ret0
+}
+
+// GetSelect indicates an expected call of GetSelect.
+func (mr *MockPromptUtilMockRecorder) GetSelect(label, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelect", reflect.TypeOf((*MockPromptUtil)(nil).GetSelect), label, items)
+}
@@ -88,3 +88,56 @@ func Test_promptUtil_GetPrompt(t *testing.T) {
 		})
 	}
 }
+
+func Test_promptUtil_GetSelect(t *testing.T) {
+	type fields struct {
+		promptui proxy.Promptui
+	}
+	type args struct {
+		label string
+		items []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   proxy.Select
+		setup  func(mockCtrl *gomock.Controller, tt *fields) proxy.Select
+	}{
+		{
+			name: "positive testing",
+			fields: fields{
+				promptui: nil,
+			},
+			args: args{
+				label: "test",
+				items: []string{"test item 1", "test item 2"},
+			},
+			want: nil,
+			setup: func(mockCtrl *gomock.Controller, tt *fields) proxy.Select {
+				mockPromptui := proxy.NewMockPromptui(mockCtrl)
+				mockSelect := proxy.NewMockSelect(mockCtrl)
+				mockPromptui.EXPECT().NewSelect().Return(mockSelect)
+				mockSelect.EXPECT().SetLabel("test")
+				mockSelect.EXPECT().SetItems([]string{"test item 1", "test item 2"})
+				tt.promptui = mockPromptui
+				return mockSelect
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			if tt.setup != nil {
+				tt.want = tt.setup(mockCtrl, &tt.fields)
+			}
+			p := &promptUtil{
+				promptui: tt.fields.promptui,
+			}
+			if got := p.GetSelect(tt.args.label, tt.args.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("promptUtil.GetSelect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
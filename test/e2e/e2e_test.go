@@ -0,0 +1,778 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yanosea/spotlike/app/infrastructure/spotify/api"
+	"github.com/yanosea/spotlike/app/presentation/cli/spotlike/command"
+	"github.com/yanosea/spotlike/test/fakespotify"
+
+	"github.com/yanosea/spotlike/pkg/proxy"
+	"github.com/yanosea/spotlike/pkg/utility"
+)
+
+// result is the result of running spotlike cli.
+type result struct {
+	stdout   string
+	stderr   string
+	exitCode int
+}
+
+// newServer returns a new fake Spotify server with the test catalog.
+func newServer(t *testing.T) *fakespotify.Server {
+	t.Helper()
+	s := fakespotify.NewServer()
+	t.Cleanup(func() {
+		s.Close()
+		for _, err := range s.Errors() {
+			t.Errorf("The server failed : %v", err)
+		}
+	})
+	s.AddArtist("e2e_artist_id", "e2e artist")
+	s.AddAlbum("e2e_album_id_1", "e2e album one", []string{"e2e_artist_id"}, "2000-01-01")
+	s.AddAlbum("e2e_album_id_2", "e2e album two", []string{"e2e_artist_id"}, "2001-01-01")
+	s.AddTrack("e2e_track_id_1", "e2e track one", "e2e_album_id_1", 1)
+	s.AddTrack("e2e_track_id_2", "e2e track two", "e2e_album_id_1", 2)
+	s.AddTrack("e2e_track_id_3", "e2e track three", "e2e_album_id_2", 1)
+	return s
+}
+
+// run runs spotlike cli with the arguments against the server.
+func run(t *testing.T, s *fakespotify.Server, dataHome string, args ...string) result {
+	t.Helper()
+	t.Setenv("SPOTIFY_ID", "e2e_client_id")
+	t.Setenv("SPOTIFY_SECRET", "e2e_client_secret")
+	t.Setenv("SPOTIFY_REDIRECT_URI", "http://localhost:8080/callback")
+	t.Setenv("SPOTIFY_REFRESH_TOKEN", fakespotify.RefreshToken)
+	t.Setenv("SPOTIFY_API_BASE_URL", s.ApiBaseUrl())
+	t.Setenv("SPOTIFY_ACCOUNTS_BASE_URL", s.AccountsBaseUrl())
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(dataHome, "cache"))
+
+	if err := api.ResetClientManager(); err != nil {
+		t.Fatalf("Failed to reset client manager: %v", err)
+	}
+	defer func() {
+		if err := api.ResetClientManager(); err != nil {
+			t.Errorf("Failed to reset client manager: %v", err)
+		}
+	}()
+	ctx := context.Background()
+
+	origArgs := os.Args
+	os.Args = append([]string{"spotlike"}, args...)
+	defer func() {
+		os.Args = origArgs
+	}()
+
+	var r result
+	exit := func(code int) {
+		r.exitCode = code
+	}
+	capturer := utility.NewCapturer(proxy.NewOs(), proxy.NewBuffer(), proxy.NewBuffer())
+	stdout, stderr, err := capturer.CaptureOutput(func() {
+		cli := command.NewCli(exit, proxy.NewCobra(), ctx)
+		if exitCode := cli.Init(
+			proxy.NewEnvconfig(),
+			proxy.NewSpotify(),
+			proxy.NewHttp(),
+			proxy.NewRandstr(),
+			proxy.NewUrl(),
+			"e2e",
+			utility.NewVersionUtil(proxy.NewDebug()),
+		); exitCode != 0 {
+			r.exitCode = exitCode
+			return
+		}
+		if exitCode := cli.Run(); exitCode != 0 {
+			r.exitCode = exitCode
+		}
+	})
+	if err != nil {
+		t.Fatalf("Failed to capture the output: %v", err)
+	}
+	r.stdout = stdout
+	r.stderr = stderr
+
+	return r
+}
+
+// assertContains asserts that the output contains all of the substrings.
+func assertContains(t *testing.T, name string, output string, substrings ...string) {
+	t.Helper()
+	for _, substring := range substrings {
+		if !strings.Contains(output, substring) {
+			t.Errorf("%s does not contain %q:\n%s", name, substring, output)
+		}
+	}
+}
+
+// assertNotContains asserts that the output contains none of the substrings.
+func assertNotContains(t *testing.T, name string, output string, substrings ...string) {
+	t.Helper()
+	for _, substring := range substrings {
+		if strings.Contains(output, substring) {
+			t.Errorf("%s contains %q:\n%s", name, substring, output)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantStdout   []string
+		wantExitCode int
+	}{
+		{
+			name:         "positive testing (search for artists)",
+			args:         []string{"search", "--artist", "e2e"},
+			wantStdout:   []string{"e2e_artist_id", "e2e artist", "TOTAL : 1 artists!"},
+			wantExitCode: 0,
+		},
+		{
+			name:         "positive testing (search for multiple types)",
+			args:         []string{"search", "-A", "-a", "-t", "--format", "plain", "e2e", "one"},
+			wantStdout:   []string{"[e2e_album_id_1] Album : e2e album one", "[e2e_track_id_1] Track : #1 e2e track one on e2e album one"},
+			wantExitCode: 0,
+		},
+		{
+			name:         "positive testing (no results found)",
+			args:         []string{"search", "--track", "nothing"},
+			wantStdout:   []string{"No tracks found"},
+			wantExitCode: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := run(t, s, dataHome, tt.args...)
+			if got.exitCode != tt.wantExitCode {
+				t.Errorf("exit code = %v, want %v (stderr: %s)", got.exitCode, tt.wantExitCode, got.stderr)
+			}
+			assertContains(t, "stdout", got.stdout, tt.wantStdout...)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "get", "albums", "e2e_artist_id")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "e2e album one", "e2e album two", "2001-01-01")
+
+	got = run(t, s, dataHome, "get", "tracks", "e2e_album_id_1")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "e2e track one", "e2e track two")
+}
+
+func TestShowLiked(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	s.SetLiked("album", "e2e_album_id_1", true)
+	s.SetLiked("track", "e2e_track_id_2", true)
+
+	got := run(t, s, dataHome, "search", "--album", "--show-liked", "--format", "plain", "e2e", "one")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_album_id_1] Album : e2e album one", "=> liked")
+
+	got = run(t, s, dataHome, "get", "tracks", "e2e_album_id_1", "--show-liked", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "e2e track one", "=> not_liked", "e2e track two", "=> liked")
+	if !s.IsLiked("album", "e2e_album_id_1") || s.IsLiked("track", "e2e_track_id_1") {
+		t.Errorf("the library is changed by showing liked")
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	cassette := filepath.Join(t.TempDir(), "cassette")
+
+	recorded := run(t, s, dataHome, "get", "tracks", "e2e_artist_id", "--record", cassette)
+	if recorded.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", recorded.exitCode, recorded.stderr)
+	}
+	assertContains(t, "stdout", recorded.stdout, "e2e track one", "e2e track three")
+
+	files, err := filepath.Glob(filepath.Join(cassette, "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no interaction is recorded in the cassette: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read the cassette: %v", err)
+		}
+		assertNotContains(t, file, string(data), fakespotify.RefreshToken, "e2e_client_secret", "Bearer")
+	}
+
+	// the server is closed, so the replay never reaches the network
+	s.Close()
+	replayed := run(t, s, dataHome, "get", "tracks", "e2e_artist_id", "--replay", cassette)
+	if replayed.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", replayed.exitCode, replayed.stderr)
+	}
+	if replayed.stdout != recorded.stdout {
+		t.Errorf("replayed stdout = %s, want %s", replayed.stdout, recorded.stdout)
+	}
+
+	got := run(t, s, dataHome, "get", "tracks", "e2e_album_id_2", "--replay", cassette)
+	if got.exitCode == 0 {
+		t.Errorf("exit code = %v, want not 0", got.exitCode)
+	}
+	assertContains(t, "stderr", got.stderr, "no interaction recorded in the cassette")
+}
+
+func TestLikeAndUndo(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "like", "track", "--album", "e2e_album_id_1", "--no-confirm")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if !s.IsLiked("track", "e2e_track_id_1") || !s.IsLiked("track", "e2e_track_id_2") {
+		t.Errorf("the tracks on the album are not liked")
+	}
+	if s.IsLiked("track", "e2e_track_id_3") {
+		t.Errorf("the track on the other album is liked")
+	}
+
+	got = run(t, s, dataHome, "like", "track", "e2e_track_id_1", "--no-confirm")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "already liked")
+
+	got = run(t, s, dataHome, "history", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "like track : e2e track one (e2e_track_id_1) by "+fakespotify.UserID, "like track : e2e track two (e2e_track_id_2) by "+fakespotify.UserID)
+
+	s.SetUser("e2e_other_user_id")
+	got = run(t, s, dataHome, "undo", "--no-confirm")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "by another account ("+fakespotify.UserID+")")
+	if !s.IsLiked("track", "e2e_track_id_1") || !s.IsLiked("track", "e2e_track_id_2") {
+		t.Errorf("the tracks liked by another account are unliked by undo")
+	}
+
+	s.SetUser(fakespotify.UserID)
+	got = run(t, s, dataHome, "undo", "--no-confirm")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if s.IsLiked("track", "e2e_track_id_1") || s.IsLiked("track", "e2e_track_id_2") {
+		t.Errorf("the tracks are not unliked by undo")
+	}
+}
+
+func TestCache(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "get", "tracks", "e2e_artist_id")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	requests := len(s.Requests())
+
+	got = run(t, s, dataHome, "get", "tracks", "e2e_artist_id")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "e2e track one", "e2e track three")
+	// only the lookup of the artist id as an album is sent again since the not found result is not cached
+	if sent := s.Requests()[requests:]; len(sent) != 1 || !strings.Contains(sent[0], "/albums/e2e_artist_id") {
+		t.Errorf("requests = %v, want only the album lookup since the catalog is cached", sent)
+	}
+
+	got = run(t, s, dataHome, "like", "track", "e2e_track_id_1", "--no-confirm")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	got = run(t, s, dataHome, "like", "track", "e2e_track_id_1", "--no-confirm")
+	assertContains(t, "stdout", got.stdout, "already liked")
+
+	got = run(t, s, dataHome, "get", "tracks", "e2e_artist_id", "--no-cache")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	before := len(s.Requests())
+	got = run(t, s, dataHome, "get", "tracks", "e2e_artist_id", "--no-cache")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if after := len(s.Requests()); after == before {
+		t.Errorf("requests = %v, want more than %v since the cache is disabled", after, before)
+	}
+
+	got = run(t, s, dataHome, "cache", "stats")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, filepath.Join(dataHome, "cache", "spotlike"), "Entries")
+	assertNotContains(t, "stdout", got.stdout, "Entries   : 0 ")
+
+	got = run(t, s, dataHome, "cache", "clear")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	got = run(t, s, dataHome, "cache", "stats")
+	assertContains(t, "stdout", got.stdout, "Entries   : 0 (0 expired)")
+}
+
+func TestDryRun(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "like", "artist", "e2e_artist_id", "--dry-run")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if s.IsLiked("artist", "e2e_artist_id") {
+		t.Errorf("the artist is liked in the dry run")
+	}
+	for _, request := range s.Requests() {
+		if !strings.HasPrefix(request, http.MethodGet) {
+			t.Errorf("the dry run sent the request %q", request)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	dataHome := t.TempDir()
+
+	t.Run("negative testing (server error)", func(t *testing.T) {
+		s := newServer(t)
+		s.InjectFault(http.StatusInternalServerError, 1)
+		got := run(t, s, dataHome, "search", "--artist", "e2e")
+		if got.exitCode != 1 {
+			t.Errorf("exit code = %v, want 1", got.exitCode)
+		}
+		assertContains(t, "stderr", got.stderr, "Internal Server Error")
+	})
+
+	t.Run("negative testing (rate limited)", func(t *testing.T) {
+		s := newServer(t)
+		s.InjectFault(http.StatusTooManyRequests, 1)
+		got := run(t, s, dataHome, "like", "album", "e2e_album_id_1", "--no-confirm")
+		if got.exitCode != 5 {
+			t.Errorf("exit code = %v, want 5", got.exitCode)
+		}
+		assertContains(t, "stderr", got.stderr, "Too Many Requests")
+		if s.IsLiked("album", "e2e_album_id_1") {
+			t.Errorf("the album is liked although the request is rate limited")
+		}
+	})
+
+	t.Run("negative testing (invalid refresh token)", func(t *testing.T) {
+		s := newServer(t)
+		s.SetRefreshToken("another_refresh_token")
+		got := run(t, s, dataHome, "search", "--artist", "e2e")
+		if got.exitCode != 3 {
+			t.Errorf("exit code = %v, want 3", got.exitCode)
+		}
+		assertContains(t, "stderr", got.stderr, "invalid_grant")
+	})
+}
+
+func TestExitCodes(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "like", "album", "e2e_album_id_1", "e2e_unknown_id", "--no-confirm")
+	if got.exitCode != 6 {
+		t.Errorf("exit code = %v, want 6 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if !s.IsLiked("album", "e2e_album_id_1") {
+		t.Errorf("the album is not liked")
+	}
+
+	got = run(t, s, dataHome, "like", "album", "e2e_album_id_1", "e2e_album_id_2", "--no-confirm", "--format", "json")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, `"id": "e2e_album_id_1"`, `"status": "skipped"`, `"id": "e2e_album_id_2"`, `"status": "liked"`)
+
+	got = run(t, s, dataHome, "like", "album", "e2e_album_id_1", "--no-confirm")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+
+	got = run(t, s, dataHome, "like", "album", "e2e_unknown_id", "--no-confirm")
+	if got.exitCode != 2 {
+		t.Errorf("exit code = %v, want 2 (stderr: %s)", got.exitCode, got.stderr)
+	}
+
+	got = run(t, s, dataHome, "get", "albums", "e2e_unknown_id")
+	if got.exitCode != 2 {
+		t.Errorf("exit code = %v, want 2 (stderr: %s)", got.exitCode, got.stderr)
+	}
+}
+
+func TestKeepGoingAndRetryFailed(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	s.InjectFault(http.StatusInternalServerError, 1)
+	got := run(t, s, dataHome, "like", "album", "e2e_album_id_1", "e2e_album_id_2", "--no-confirm", "--keep-going", "--format", "json")
+	if got.exitCode != 8 {
+		t.Errorf("exit code = %v, want 8 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, `"id": "e2e_album_id_1"`, `"status": "failed"`, `"id": "e2e_album_id_2"`, `"status": "liked"`)
+	assertContains(t, "stderr", got.stderr, "Failed to like 1 contents below", "e2e_album_id_1 : Internal Server Error")
+	if s.IsLiked("album", "e2e_album_id_1") || !s.IsLiked("album", "e2e_album_id_2") {
+		t.Errorf("only the album not failed should be liked")
+	}
+
+	report := filepath.Join(dataHome, "report.json")
+	if err := os.WriteFile(report, []byte(got.stdout), 0600); err != nil {
+		t.Fatalf("Failed to save the report: %v", err)
+	}
+	got = run(t, s, dataHome, "like", "album", "--retry-failed", report, "--no-confirm")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	if !s.IsLiked("album", "e2e_album_id_1") {
+		t.Errorf("the failed album is not liked by retrying")
+	}
+	assertNotContains(t, "stdout", got.stdout, "e2e_album_id_2")
+}
+
+func TestLogging(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	logFile := filepath.Join(dataHome, "spotlike.log")
+
+	got := run(t, s, dataHome, "like", "album", "e2e_album_id_1", "--no-confirm", "--debug", "--log-file", logFile)
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("Failed to read the log file: %v", err)
+	}
+	logs := string(data)
+	assertContains(t, "log", logs, "msg=request method=GET", "/v1/albums/e2e_album_id_1 status=200", "msg=request method=PUT", "retry=0", "albumRepository.Like", "Authorization:[REDACTED]")
+	assertNotContains(t, "log", logs, fakespotify.RefreshToken, "e2e_client_secret", "Bearer")
+	// the requests to the accounts service are logged with the real destination
+	assertContains(t, "log", logs, "endpoint="+s.AccountsBaseUrl()+"/api/token")
+	assertNotContains(t, "log", logs, "accounts.spotify.com")
+
+	got = run(t, s, dataHome, "get", "albums", "e2e_artist_id", "--verbose")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stderr", got.stderr, "msg=request method=GET")
+	assertNotContains(t, "stderr", got.stderr, "level=DEBUG")
+}
+
+func TestTokenRefresh(t *testing.T) {
+	s := newServer(t)
+	s.SetTokenLifetime(time.Second)
+	s.SetLatency(10 * time.Millisecond)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "like", "track", "--artist", "e2e_artist_id", "--no-confirm")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	for _, id := range []string{"e2e_track_id_1", "e2e_track_id_2", "e2e_track_id_3"} {
+		if !s.IsLiked("track", id) {
+			t.Errorf("the track %s is not liked", id)
+		}
+	}
+	if requests, tokenRequests := len(s.Requests()), s.TokenRequests(); tokenRequests < 2 || tokenRequests != requests {
+		t.Errorf("token requests = %v, want one per API request (%v) since the token expires immediately", tokenRequests, requests)
+	}
+}
+
+func TestDoctor(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "doctor", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stdout: %s)", got.exitCode, got.stdout)
+	}
+	assertContains(t, "stdout", got.stdout, "[pass] SPOTIFY_REFRESH_TOKEN", "[warn] SPOTIFY_API_BASE_URL", "[pass] redirect uri : http://localhost:8080/callback", "[pass] token refresh", "[pass] scopes (releases)", "[pass] scopes (diff, stats, check)", "[pass] scopes (watch, apply, undo)", "[pass] clock skew")
+	assertNotContains(t, "stdout", got.stdout, "[fail]", fakespotify.RefreshToken, "e2e_client_secret")
+
+	s.SetRefreshToken("e2e_revoked_refresh_token")
+	got = run(t, s, dataHome, "doctor", "--format", "plain")
+	if got.exitCode != 1 {
+		t.Errorf("exit code = %v, want 1 (stdout: %s)", got.exitCode, got.stdout)
+	}
+	assertContains(t, "stdout", got.stdout, "[fail] token refresh", "invalid_grant", "[warn] scopes (watch, apply, undo) : skipped")
+}
+
+func TestReleases(t *testing.T) {
+	s := newServer(t)
+	s.SetLiked("artist", "e2e_artist_id", true)
+	dataHome := t.TempDir()
+
+	got := run(t, s, dataHome, "releases", "--since", "2000-06-01", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_album_id_2] Album : e2e album two released at 2001-01-01 by e2e artist")
+	assertNotContains(t, "stdout", got.stdout, "e2e_album_id_1")
+
+	got = run(t, s, dataHome, "releases", "--since", "2000-06-01", "--like", "tracks", "--no-confirm", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_track_id_3] like track : e2e track three => liked")
+	if !s.IsLiked("track", "e2e_track_id_3") {
+		t.Errorf("the track of the release is not liked")
+	}
+	if s.IsLiked("track", "e2e_track_id_1") {
+		t.Errorf("the track of the old album is liked")
+	}
+	if _, err := os.Stat(filepath.Join(dataHome, "spotlike", "state.json")); err != nil {
+		t.Errorf("the last run is not stored : %v", err)
+	}
+
+	got = run(t, s, dataHome, "releases")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "No new releases found since "+time.Now().Format("2006-01-02"))
+}
+
+func TestWatch(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	rules := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(rules, []byte("rules:\n  - name: e2e rule\n    artists: [e2e_artist_id]\n    include_groups: [album]\n    released_after: 2000-06-01\n"), 0600); err != nil {
+		t.Fatalf("failed to write the rules file : %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dataHome, "spotlike"), 0700); err != nil {
+		t.Fatalf("failed to create the data directory : %v", err)
+	}
+	lastRuns, err := json.Marshal(map[string]map[string]time.Time{"last_runs": {"watch:" + rules: time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)}})
+	if err != nil {
+		t.Fatalf("failed to marshal the last run : %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dataHome, "spotlike", "state.json"), lastRuns, 0600); err != nil {
+		t.Fatalf("failed to write the last run : %v", err)
+	}
+
+	got := run(t, s, dataHome, "watch", rules, "--once")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "Liked the album e2e album two (e2e_album_id_2) released by e2e artist with the rule e2e rule", "cycle 1 : 1 liked, 0 skipped, 0 failed")
+	if !s.IsLiked("album", "e2e_album_id_2") {
+		t.Errorf("the album matched by the rule is not liked")
+	}
+	if s.IsLiked("album", "e2e_album_id_1") {
+		t.Errorf("the album released before the date of the rule is liked")
+	}
+
+	got = run(t, s, dataHome, "watch", rules, "--once")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "cycle 1 : 0 liked, 0 skipped, 0 failed")
+
+	got = run(t, s, dataHome, "watch", rules, "--once", "--format", "json")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	var results []map[string]any
+	if err := json.Unmarshal([]byte(got.stdout), &results); err != nil {
+		t.Errorf("stdout is not valid JSON : %v (stdout: %s)", err, got.stdout)
+	}
+	assertContains(t, "stderr", got.stderr, "cycle 1 : 0 liked, 0 skipped, 0 failed")
+}
+
+func TestApply(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	rules := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(rules, []byte("follow: [e2e_artist_id]\nnever: [\"(?i)one\"]\nrules:\n  - name: e2e rule\n    artists: [e2e_artist_id]\n    like: tracks\n"), 0600); err != nil {
+		t.Fatalf("failed to write the rules file : %v", err)
+	}
+
+	got := run(t, s, dataHome, "apply", rules, "--dry-run", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "+ artist e2e artist (e2e_artist_id) [rule: follow]", "+ track e2e track two (e2e_track_id_2)", "+ track e2e track three (e2e_track_id_3)", "3 to change, 0 unchanged", "[e2e_track_id_3] like track : e2e track three => planned")
+	assertNotContains(t, "stdout", got.stdout, "e2e_track_id_1")
+	if s.IsLiked("artist", "e2e_artist_id") {
+		t.Errorf("the artist is followed with the dry run")
+	}
+
+	got = run(t, s, dataHome, "apply", rules, "--no-confirm", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_artist_id] like artist : e2e artist => liked", "[e2e_track_id_2] like track : e2e track two => liked")
+	if !s.IsLiked("artist", "e2e_artist_id") || !s.IsLiked("track", "e2e_track_id_2") || !s.IsLiked("track", "e2e_track_id_3") {
+		t.Errorf("the rules are not applied")
+	}
+	if s.IsLiked("track", "e2e_track_id_1") {
+		t.Errorf("the track never to be liked is liked")
+	}
+
+	got = run(t, s, dataHome, "apply", rules)
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "The library already satisfies the rules... (3 contents matched)")
+}
+
+func TestPurge(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	s.SetLiked("artist", "e2e_artist_id", true)
+	s.SetLiked("album", "e2e_album_id_1", true)
+	s.SetLiked("track", "e2e_track_id_3", true)
+
+	got := run(t, s, dataHome, "unlike", "artist", "e2e_artist_id", "--purge", "--dry-run", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "- artist e2e artist (e2e_artist_id)", "- album e2e album one (e2e_album_id_1) by e2e artist", "- track e2e track three (e2e_track_id_3) by e2e artist", "1 artists, 1 albums and 1 tracks to unlike")
+	if !s.IsLiked("artist", "e2e_artist_id") || !s.IsLiked("album", "e2e_album_id_1") || !s.IsLiked("track", "e2e_track_id_3") {
+		t.Errorf("the artist is purged with the dry run")
+	}
+
+	got = run(t, s, dataHome, "unlike", "artist", "e2e_artist_id", "--purge", "--no-confirm", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_artist_id] unlike artist : e2e artist => unliked", "[e2e_track_id_3] unlike track : e2e track three => unliked")
+	if s.IsLiked("artist", "e2e_artist_id") || s.IsLiked("album", "e2e_album_id_1") || s.IsLiked("track", "e2e_track_id_3") {
+		t.Errorf("the artist is not purged")
+	}
+
+	got = run(t, s, dataHome, "unlike", "artist", "e2e_artist_id", "--purge", "--no-confirm")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "Nothing to unlike by the artists in your library...")
+}
+
+func TestDiff(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	snapshot := filepath.Join(t.TempDir(), "library.json")
+	s.SetLiked("artist", "e2e_artist_id", true)
+	s.SetLiked("track", "e2e_track_id_1", true)
+
+	got := run(t, s, dataHome, "diff", "--live", "--save", snapshot)
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "Successfully saved the snapshot of your library to "+snapshot)
+
+	got = run(t, s, dataHome, "diff", snapshot, "--live")
+	if got.exitCode != 7 {
+		t.Errorf("exit code = %v, want 7 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "No changes found between "+snapshot+" and your current library...")
+
+	s.SetLiked("track", "e2e_track_id_1", false)
+	s.SetLiked("album", "e2e_album_id_2", true)
+	got = run(t, s, dataHome, "diff", snapshot, "--live", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "+ [e2e_album_id_2] album : e2e album two released at 2001-01-01 by e2e artist (liked at 2020-01-01)", "- [e2e_track_id_1] track : e2e track one released at 2000-01-01 by e2e artist (liked at 2020-01-01)", "1 added, 1 removed")
+	assertNotContains(t, "stdout", got.stdout, "e2e_artist_id")
+
+	got = run(t, s, dataHome, "diff", snapshot, "--live", "--format", "json")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	var changes []map[string]any
+	if err := json.Unmarshal([]byte(got.stdout), &changes); err != nil || len(changes) != 2 {
+		t.Errorf("stdout = %s, want the json of 2 changes", got.stdout)
+	}
+}
+
+func TestStats(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	s.SetLiked("artist", "e2e_artist_id", true)
+	s.SetLiked("album", "e2e_album_id_1", true)
+	s.SetLiked("track", "e2e_track_id_1", true)
+	s.SetLiked("track", "e2e_track_id_3", true)
+
+	got := run(t, s, dataHome, "stats", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "followed artists : 1, liked albums : 1, liked tracks : 2", "artist e2e artist : 1 albums, 2 tracks", "year 2000 : 1 albums, 1 tracks", "decade 2000s : 1 albums, 2 tracks", "month 2020-01 : 1 albums, 2 tracks", "liked 2/3 tracks by e2e artist (67%)")
+
+	got = run(t, s, dataHome, "stats", "--format", "json")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	var stats map[string]any
+	if err := json.Unmarshal([]byte(got.stdout), &stats); err != nil || stats["liked_tracks"] != float64(2) {
+		t.Errorf("stdout = %s, want the json of the statistics", got.stdout)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	s := newServer(t)
+	dataHome := t.TempDir()
+	s.SetLiked("artist", "e2e_artist_id", true)
+	s.SetLiked("track", "e2e_track_id_1", true)
+	s.SetLiked("track", "e2e_track_id_2", true)
+
+	got := run(t, s, dataHome, "check", "track", "--album", "e2e_album_id_1", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_track_id_1] track : e2e track one => liked", "[e2e_track_id_2] track : e2e track two => liked")
+
+	got = run(t, s, dataHome, "check", "track", "--artist", "e2e_artist_id", "--format", "plain")
+	if got.exitCode != 9 {
+		t.Errorf("exit code = %v, want 9 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_track_id_3] track : e2e track three => not_liked")
+
+	got = run(t, s, dataHome, "check", "artist", "e2e_artist_id", "--format", "plain")
+	if got.exitCode != 0 {
+		t.Errorf("exit code = %v, want 0 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, "[e2e_artist_id] artist : e2e artist => followed")
+
+	got = run(t, s, dataHome, "check", "album", "e2e_album_id_1", "e2e_unknown_id", "--format", "json")
+	if got.exitCode != 2 {
+		t.Errorf("exit code = %v, want 2 (stderr: %s)", got.exitCode, got.stderr)
+	}
+	assertContains(t, "stdout", got.stdout, `"state": "not_liked"`, `"state": "not_found"`)
+	if s.IsLiked("album", "e2e_album_id_1") || !s.IsLiked("track", "e2e_track_id_1") {
+		t.Errorf("the library is changed by checking")
+	}
+}
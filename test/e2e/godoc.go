@@ -0,0 +1,2 @@
+// Package e2e provides the end-to-end tests of spotlike cli against the fake Spotify Web API.
+package e2e
@@ -0,0 +1,2 @@
+// Package fakespotify provides a stand-in server of the Spotify Web API and the Spotify accounts service for testing.
+package fakespotify
@@ -0,0 +1,591 @@
+package fakespotify
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// likedAt is the time when all contents in the library are added.
+	likedAt = "2020-01-01T00:00:00Z"
+	// UserID is the Spotify ID of the user by default.
+	UserID = "fake_user_id"
+)
+
+// artist is an artist in the catalog.
+type artist struct {
+	id   string
+	name string
+}
+
+// album is an album in the catalog.
+type album struct {
+	id          string
+	name        string
+	artistIds   []string
+	releaseDate string
+}
+
+// track is a track in the catalog.
+type track struct {
+	id          string
+	name        string
+	albumId     string
+	trackNumber int
+}
+
+// library is a struct that holds the in-memory catalog and the library of the user.
+type library struct {
+	mutex   *sync.RWMutex
+	artists []*artist
+	albums  []*album
+	tracks  []*track
+	liked   map[string]bool
+	userId  string
+}
+
+// newLibrary returns a new instance of the library struct.
+func newLibrary() *library {
+	return &library{
+		mutex:  &sync.RWMutex{},
+		liked:  map[string]bool{},
+		userId: UserID,
+	}
+}
+
+// addArtist adds the artist to the catalog.
+func (l *library) addArtist(id string, name string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.artists = append(l.artists, &artist{id: id, name: name})
+}
+
+// addAlbum adds the album to the catalog.
+func (l *library) addAlbum(id string, name string, artistIds []string, releaseDate string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.albums = append(l.albums, &album{id: id, name: name, artistIds: artistIds, releaseDate: releaseDate})
+}
+
+// addTrack adds the track to the catalog.
+func (l *library) addTrack(id string, name string, albumId string, trackNumber int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.tracks = append(l.tracks, &track{id: id, name: name, albumId: albumId, trackNumber: trackNumber})
+}
+
+// isLiked returns whether the content is liked.
+func (l *library) isLiked(contentType string, id string) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.liked[contentType+":"+id]
+}
+
+// setLiked likes or unlikes the content.
+func (l *library) setLiked(contentType string, id string, liked bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if liked {
+		l.liked[contentType+":"+id] = true
+	} else {
+		delete(l.liked, contentType+":"+id)
+	}
+}
+
+// setUser sets the user who owns the library.
+func (l *library) setUser(id string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.userId = id
+}
+
+// handler returns the handler of the fake Spotify Web API.
+func (l *library) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/search", l.handleSearch)
+	mux.HandleFunc("GET /v1/artists/{id}", l.handleGetArtist)
+	mux.HandleFunc("GET /v1/artists/{id}/albums", l.handleGetArtistAlbums)
+	mux.HandleFunc("GET /v1/albums/{id}", l.handleGetAlbum)
+	mux.HandleFunc("GET /v1/albums/{id}/tracks", l.handleGetAlbumTracks)
+	mux.HandleFunc("GET /v1/tracks/{id}", l.handleGetTrack)
+	mux.HandleFunc("GET /v1/me", l.handleGetCurrentUser)
+	mux.HandleFunc("GET /v1/me/following", l.handleGetFollowedArtists)
+	mux.HandleFunc("GET /v1/me/following/contains", l.handleContains("artist"))
+	mux.HandleFunc("PUT /v1/me/following", l.handleModify("artist", true))
+	mux.HandleFunc("DELETE /v1/me/following", l.handleModify("artist", false))
+	mux.HandleFunc("GET /v1/me/albums", l.handleGetSavedAlbums)
+	mux.HandleFunc("GET /v1/me/albums/contains", l.handleContains("album"))
+	mux.HandleFunc("PUT /v1/me/albums", l.handleModify("album", true))
+	mux.HandleFunc("DELETE /v1/me/albums", l.handleModify("album", false))
+	mux.HandleFunc("GET /v1/me/tracks", l.handleGetSavedTracks)
+	mux.HandleFunc("GET /v1/me/tracks/contains", l.handleContains("track"))
+	mux.HandleFunc("PUT /v1/me/tracks", l.handleModify("track", true))
+	mux.HandleFunc("DELETE /v1/me/tracks", l.handleModify("track", false))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Service not found")
+	})
+
+	return mux
+}
+
+// handleSearch searches the catalog for the contents whose names contain all keywords.
+func (l *library) handleSearch(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	query := r.URL.Query()
+	keywords := strings.Fields(strings.ToLower(query.Get("q")))
+	if len(keywords) == 0 {
+		writeError(w, http.StatusBadRequest, "No search query")
+		return
+	}
+	matches := func(name string) bool {
+		for _, keyword := range keywords {
+			if !strings.Contains(strings.ToLower(name), keyword) {
+				return false
+			}
+		}
+		return true
+	}
+
+	result := map[string]any{}
+	for _, searchType := range strings.Split(query.Get("type"), ",") {
+		switch searchType {
+		case "artist":
+			var items []any
+			for _, a := range l.artists {
+				if matches(a.name) {
+					items = append(items, l.artistJson(a))
+				}
+			}
+			result["artists"] = page(r, items)
+		case "album":
+			var items []any
+			for _, a := range l.albums {
+				if matches(a.name) {
+					items = append(items, l.albumJson(a))
+				}
+			}
+			result["albums"] = page(r, items)
+		case "track":
+			var items []any
+			for _, t := range l.tracks {
+				if matches(t.name) {
+					items = append(items, l.trackJson(t, true))
+				}
+			}
+			result["tracks"] = page(r, items)
+		default:
+			writeError(w, http.StatusBadRequest, "Bad search type field")
+			return
+		}
+	}
+
+	writeJson(w, http.StatusOK, result)
+}
+
+// handleGetArtist returns the artist.
+func (l *library) handleGetArtist(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	a := l.findArtist(r.PathValue("id"))
+	if a == nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	writeJson(w, http.StatusOK, l.artistJson(a))
+}
+
+// handleGetCurrentUser returns the user who owns the library.
+func (l *library) handleGetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	writeJson(w, http.StatusOK, map[string]any{
+		"id":           l.userId,
+		"display_name": l.userId,
+		"type":         "user",
+		"uri":          "spotify:user:" + l.userId,
+	})
+}
+
+// handleGetArtistAlbums returns the albums of the artist page by page.
+func (l *library) handleGetArtistAlbums(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	id := r.PathValue("id")
+	if l.findArtist(id) == nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	var items []any
+	for _, a := range l.albums {
+		if slices.Contains(a.artistIds, id) {
+			items = append(items, l.albumJson(a))
+		}
+	}
+
+	writeJson(w, http.StatusOK, page(r, items))
+}
+
+// handleGetFollowedArtists returns the artists followed by the user page by page with the cursor.
+func (l *library) handleGetFollowedArtists(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	query := r.URL.Query()
+	if query.Get("type") != "artist" {
+		writeError(w, http.StatusBadRequest, "Invalid type")
+		return
+	}
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit < 1 || limit > 50 {
+		limit = 20
+	}
+
+	var followed []*artist
+	for _, a := range l.artists {
+		if l.liked["artist:"+a.id] {
+			followed = append(followed, a)
+		}
+	}
+	start := 0
+	if after := query.Get("after"); after != "" {
+		start = len(followed)
+		for i, a := range followed {
+			if a.id == after {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := min(start+limit, len(followed))
+
+	items := []any{}
+	for _, a := range followed[start:end] {
+		items = append(items, l.artistJson(a))
+	}
+	var next, after any
+	if end < len(followed) {
+		after = followed[end-1].id
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		q := r.URL.Query()
+		q.Set("after", followed[end-1].id)
+		q.Set("limit", strconv.Itoa(limit))
+		u.RawQuery = q.Encode()
+		next = u.String()
+	}
+
+	writeJson(w, http.StatusOK, map[string]any{
+		"artists": map[string]any{
+			"href":  "http://" + r.Host + r.URL.RequestURI(),
+			"items": items,
+			"limit": limit,
+			"next":  next,
+			"cursors": map[string]any{
+				"after": after,
+			},
+			"total": len(followed),
+		},
+	})
+}
+
+// handleGetSavedAlbums returns the albums liked by the user page by page.
+func (l *library) handleGetSavedAlbums(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	var items []any
+	for _, a := range l.albums {
+		if l.liked["album:"+a.id] {
+			albumJson := l.albumJson(a)
+			albumJson["tracks"] = page(r, l.albumTracksJson(a.id))
+			items = append(items, map[string]any{"added_at": likedAt, "album": albumJson})
+		}
+	}
+
+	writeJson(w, http.StatusOK, page(r, items))
+}
+
+// handleGetSavedTracks returns the tracks liked by the user page by page.
+func (l *library) handleGetSavedTracks(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	var items []any
+	for _, t := range l.tracks {
+		if l.liked["track:"+t.id] {
+			items = append(items, map[string]any{"added_at": likedAt, "track": l.trackJson(t, true)})
+		}
+	}
+
+	writeJson(w, http.StatusOK, page(r, items))
+}
+
+// handleGetAlbum returns the album with the first page of its tracks.
+func (l *library) handleGetAlbum(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	a := l.findAlbum(r.PathValue("id"))
+	if a == nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	result := l.albumJson(a)
+	result["tracks"] = page(r, l.albumTracksJson(a.id))
+	writeJson(w, http.StatusOK, result)
+}
+
+// handleGetAlbumTracks returns the tracks on the album page by page.
+func (l *library) handleGetAlbumTracks(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	id := r.PathValue("id")
+	if l.findAlbum(id) == nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	writeJson(w, http.StatusOK, page(r, l.albumTracksJson(id)))
+}
+
+// handleGetTrack returns the track.
+func (l *library) handleGetTrack(w http.ResponseWriter, r *http.Request) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	t := l.findTrack(r.PathValue("id"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	writeJson(w, http.StatusOK, l.trackJson(t, true))
+}
+
+// handleContains returns whether the contents are liked.
+func (l *library) handleContains(contentType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ids, ok := l.parseIds(w, r, contentType)
+		if !ok {
+			return
+		}
+
+		l.mutex.RLock()
+		defer l.mutex.RUnlock()
+		result := make([]bool, len(ids))
+		for i, id := range ids {
+			result[i] = l.liked[contentType+":"+id]
+		}
+
+		writeJson(w, http.StatusOK, result)
+	}
+}
+
+// handleModify likes or unlikes the contents.
+func (l *library) handleModify(contentType string, like bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ids, ok := l.parseIds(w, r, contentType)
+		if !ok {
+			return
+		}
+
+		l.mutex.Lock()
+		for _, id := range ids {
+			if like {
+				l.liked[contentType+":"+id] = true
+			} else {
+				delete(l.liked, contentType+":"+id)
+			}
+		}
+		l.mutex.Unlock()
+
+		if contentType == "artist" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// parseIds parses the IDs in the query and writes the error response if they are invalid.
+func (l *library) parseIds(w http.ResponseWriter, r *http.Request, contentType string) ([]string, bool) {
+	query := r.URL.Query()
+	if contentType == "artist" && query.Get("type") != "artist" {
+		writeError(w, http.StatusBadRequest, "Invalid type")
+		return nil, false
+	}
+
+	ids := strings.Split(query.Get("ids"), ",")
+	if query.Get("ids") == "" || len(ids) > 50 {
+		writeError(w, http.StatusBadRequest, "Invalid ids")
+		return nil, false
+	}
+
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for _, id := range ids {
+		var found bool
+		switch contentType {
+		case "artist":
+			found = l.findArtist(id) != nil
+		case "album":
+			found = l.findAlbum(id) != nil
+		case "track":
+			found = l.findTrack(id) != nil
+		}
+		if !found {
+			writeError(w, http.StatusBadRequest, "Invalid base62 id")
+			return nil, false
+		}
+	}
+
+	return ids, true
+}
+
+// findArtist returns the artist with the ID or nil.
+func (l *library) findArtist(id string) *artist {
+	for _, a := range l.artists {
+		if a.id == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// findAlbum returns the album with the ID or nil.
+func (l *library) findAlbum(id string) *album {
+	for _, a := range l.albums {
+		if a.id == id {
+			return a
+		}
+	}
+	return nil
+}
+
+// findTrack returns the track with the ID or nil.
+func (l *library) findTrack(id string) *track {
+	for _, t := range l.tracks {
+		if t.id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// artistJson returns the simplified artist object.
+func (l *library) artistJson(a *artist) map[string]any {
+	return map[string]any{
+		"id":   a.id,
+		"name": a.name,
+		"type": "artist",
+		"uri":  "spotify:artist:" + a.id,
+	}
+}
+
+// albumJson returns the simplified album object.
+func (l *library) albumJson(a *album) map[string]any {
+	artists := []any{}
+	for _, id := range a.artistIds {
+		if ar := l.findArtist(id); ar != nil {
+			artists = append(artists, l.artistJson(ar))
+		}
+	}
+
+	return map[string]any{
+		"id":                     a.id,
+		"name":                   a.name,
+		"type":                   "album",
+		"uri":                    "spotify:album:" + a.id,
+		"album_type":             "album",
+		"artists":                artists,
+		"release_date":           a.releaseDate,
+		"release_date_precision": "day",
+	}
+}
+
+// trackJson returns the track object with or without the album.
+func (l *library) trackJson(t *track, withAlbum bool) map[string]any {
+	result := map[string]any{
+		"id":           t.id,
+		"name":         t.name,
+		"type":         "track",
+		"uri":          "spotify:track:" + t.id,
+		"track_number": t.trackNumber,
+		"artists":      []any{},
+	}
+	if a := l.findAlbum(t.albumId); a != nil {
+		albumJson := l.albumJson(a)
+		result["artists"] = albumJson["artists"]
+		if withAlbum {
+			result["album"] = albumJson
+		}
+	}
+
+	return result
+}
+
+// albumTracksJson returns the simplified track objects on the album.
+func (l *library) albumTracksJson(albumId string) []any {
+	var items []any
+	for _, t := range l.tracks {
+		if t.albumId == albumId {
+			items = append(items, l.trackJson(t, false))
+		}
+	}
+	return items
+}
+
+// page returns the paging object of the items with the offset and the limit in the query.
+func page(r *http.Request, items []any) map[string]any {
+	query := r.URL.Query()
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit < 1 || limit > 50 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	start := min(offset, len(items))
+	end := min(offset+limit, len(items))
+	pageItems := append([]any{}, items[start:end]...)
+
+	pageUrl := func(offset int) string {
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		q := r.URL.Query()
+		q.Set("offset", strconv.Itoa(offset))
+		q.Set("limit", strconv.Itoa(limit))
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	var next, previous any
+	if end < len(items) {
+		next = pageUrl(end)
+	}
+	if start > 0 {
+		previous = pageUrl(max(start-limit, 0))
+	}
+
+	return map[string]any{
+		"href":     pageUrl(offset),
+		"items":    pageItems,
+		"limit":    limit,
+		"offset":   offset,
+		"total":    len(items),
+		"next":     next,
+		"previous": previous,
+	}
+}
@@ -0,0 +1,317 @@
+package fakespotify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// RefreshToken is the refresh token accepted by the server by default.
+	RefreshToken = "fake_refresh_token"
+	// AuthorizationCode is the authorization code issued by the authorize endpoint of the server.
+	AuthorizationCode = "fake_authorization_code"
+)
+
+// Server is a struct that serves the fake Spotify Web API and the fake Spotify accounts service.
+type Server struct {
+	// server is the underlying test server.
+	server *httptest.Server
+	// library is the in-memory catalog and the library of the user.
+	library *library
+	// mutex protects the fields below.
+	mutex *sync.Mutex
+	// refreshToken is the refresh token accepted by the token endpoint.
+	refreshToken string
+	// tokenLifetime is the lifetime of the issued access tokens.
+	tokenLifetime time.Duration
+	// tokens is the issued access tokens and their expiry.
+	tokens map[string]time.Time
+	// tokenRequests is the number of the requests to the token endpoint.
+	tokenRequests int
+	// latency is the delay added to every request.
+	latency time.Duration
+	// faults is the queue of the status codes to respond to the next API requests with.
+	faults []int
+	// requests is the log of the API requests.
+	requests []string
+	// errors is the failures of the server to write the responses.
+	errors []error
+}
+
+// responseWriter is a struct that writes the response and records the failure to write it on the server.
+type responseWriter struct {
+	http.ResponseWriter
+	// server is the server to record the failure on.
+	server *Server
+}
+
+// NewServer starts and returns a new instance of the Server struct.
+func NewServer() *Server {
+	s := &Server{
+		library:       newLibrary(),
+		mutex:         &sync.Mutex{},
+		refreshToken:  RefreshToken,
+		tokenLifetime: time.Hour,
+		tokens:        map[string]time.Time{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /accounts/authorize", s.handleAuthorize)
+	mux.HandleFunc("POST /accounts/api/token", s.handleToken)
+	mux.Handle("/v1/", s.api(s.library.handler()))
+	s.server = httptest.NewServer(s.delay(mux))
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// ApiBaseUrl returns the base URL of the fake Spotify Web API.
+func (s *Server) ApiBaseUrl() string {
+	return s.server.URL + "/v1/"
+}
+
+// AccountsBaseUrl returns the base URL of the fake Spotify accounts service.
+func (s *Server) AccountsBaseUrl() string {
+	return s.server.URL + "/accounts"
+}
+
+// SetRefreshToken sets the refresh token accepted by the token endpoint.
+func (s *Server) SetRefreshToken(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.refreshToken = token
+}
+
+// SetTokenLifetime sets the lifetime of the access tokens issued from now on.
+func (s *Server) SetTokenLifetime(lifetime time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tokenLifetime = lifetime
+}
+
+// TokenRequests returns the number of the requests to the token endpoint.
+func (s *Server) TokenRequests() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.tokenRequests
+}
+
+// SetLatency sets the delay added to every request.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.latency = latency
+}
+
+// InjectFault makes the next count API requests fail with the status code.
+// The responses with 429 have the Retry-After header.
+func (s *Server) InjectFault(status int, count int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for range count {
+		s.faults = append(s.faults, status)
+	}
+}
+
+// Requests returns the log of the API requests such as "GET /v1/search?q=test&type=artist".
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+// AddArtist adds the artist to the catalog.
+func (s *Server) AddArtist(id string, name string) {
+	s.library.addArtist(id, name)
+}
+
+// AddAlbum adds the album released by the artists to the catalog.
+// The release date is formatted as "2006-01-02".
+func (s *Server) AddAlbum(id string, name string, artistIds []string, releaseDate string) {
+	s.library.addAlbum(id, name, artistIds, releaseDate)
+}
+
+// AddTrack adds the track on the album to the catalog.
+func (s *Server) AddTrack(id string, name string, albumId string, trackNumber int) {
+	s.library.addTrack(id, name, albumId, trackNumber)
+}
+
+// IsLiked returns whether the content is liked by the user.
+// The content type is one of "artist", "album" and "track".
+func (s *Server) IsLiked(contentType string, id string) bool {
+	return s.library.isLiked(contentType, id)
+}
+
+// SetUser sets the Spotify ID of the user who owns the library, as if another account is authenticated.
+func (s *Server) SetUser(id string) {
+	s.library.setUser(id)
+}
+
+// SetLiked likes or unlikes the content without any requests.
+func (s *Server) SetLiked(contentType string, id string, liked bool) {
+	s.library.setLiked(contentType, id, liked)
+}
+
+// Errors returns the failures of the server to write the responses.
+// The failures are complete after the server is closed.
+func (s *Server) Errors() []error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]error{}, s.errors...)
+}
+
+// recordError records the failure of the server to write the response.
+func (s *Server) recordError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.errors = append(s.errors, err)
+}
+
+// delay delays every request by the latency.
+func (s *Server) delay(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		latency := s.latency
+		s.mutex.Unlock()
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		next.ServeHTTP(&responseWriter{ResponseWriter: w, server: s}, r)
+	})
+}
+
+// api authorizes the API requests, logs them and injects the faults.
+func (s *Server) api(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+		var fault int
+		if len(s.faults) > 0 {
+			fault = s.faults[0]
+			s.faults = s.faults[1:]
+		}
+		const bearer = "Bearer "
+		auth := r.Header.Get("Authorization")
+		expiry, authorized := time.Time{}, false
+		if len(auth) > len(bearer) && auth[:len(bearer)] == bearer {
+			expiry, authorized = s.tokens[auth[len(bearer):]]
+		}
+		s.mutex.Unlock()
+
+		if !authorized {
+			writeError(w, http.StatusUnauthorized, "No token provided")
+			return
+		}
+		if time.Now().After(expiry) {
+			writeError(w, http.StatusUnauthorized, "The access token expired")
+			return
+		}
+		if fault != 0 {
+			if fault == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "1")
+			}
+			writeError(w, fault, http.StatusText(fault))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleAuthorize redirects the user to the redirect URI with the authorization code.
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectUri := query.Get("redirect_uri")
+	if redirectUri == "" {
+		writeError(w, http.StatusBadRequest, "Missing redirect_uri")
+		return
+	}
+
+	http.Redirect(w, r, redirectUri+"?code="+AuthorizationCode+"&state="+query.Get("state"), http.StatusFound)
+}
+
+// handleToken issues an access token for the refresh token or the authorization code.
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeTokenError(w, "invalid_request")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tokenRequests++
+
+	switch r.PostForm.Get("grant_type") {
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != s.refreshToken {
+			writeTokenError(w, "invalid_grant")
+			return
+		}
+	case "authorization_code":
+		if r.PostForm.Get("code") != AuthorizationCode {
+			writeTokenError(w, "invalid_grant")
+			return
+		}
+	default:
+		writeTokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	accessToken := "fake_access_token_" + strconv.Itoa(s.tokenRequests)
+	s.tokens[accessToken] = time.Now().Add(s.tokenLifetime)
+	writeJson(w, http.StatusOK, map[string]any{
+		"access_token":  accessToken,
+		"token_type":    "Bearer",
+		"expires_in":    int(s.tokenLifetime.Seconds()),
+		"refresh_token": s.refreshToken,
+		"scope":         "user-follow-read user-follow-modify user-library-read user-library-modify",
+	})
+}
+
+// writeJson writes the value as the JSON response.
+// The failure to write it is recorded on the server.
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		if rw, ok := w.(*responseWriter); ok {
+			rw.server.recordError(fmt.Errorf("failed to write the response : %w", err))
+		}
+	}
+}
+
+// writeError writes the error response in the format of the Spotify Web API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJson(w, status, map[string]any{
+		"error": map[string]any{
+			"status":  status,
+			"message": message,
+		},
+	})
+}
+
+// writeTokenError writes the error response in the format of the Spotify accounts service.
+func writeTokenError(w http.ResponseWriter, code string) {
+	writeJson(w, http.StatusBadRequest, map[string]any{
+		"error":             code,
+		"error_description": code,
+	})
+}
@@ -0,0 +1,265 @@
+package fakespotify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+// newTestClient returns a new Spotify client which talks to the server.
+func newTestClient(t *testing.T, s *Server) *spotify.Client {
+	t.Helper()
+	conf := &oauth2.Config{
+		ClientID:     "test_client_id",
+		ClientSecret: "test_client_secret",
+		Endpoint: oauth2.Endpoint{
+			TokenURL: s.AccountsBaseUrl() + "/api/token",
+		},
+	}
+	httpClient := conf.Client(context.Background(), &oauth2.Token{RefreshToken: RefreshToken})
+	return spotify.New(httpClient, spotify.WithBaseURL(s.ApiBaseUrl()))
+}
+
+// newTestServer returns a new server with the test catalog.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(func() {
+		s.Close()
+		for _, err := range s.Errors() {
+			t.Errorf("The server failed : %v", err)
+		}
+	})
+	s.AddArtist("test_artist_id", "test artist")
+	s.AddAlbum("test_album_id_1", "test album 1", []string{"test_artist_id"}, "2000-01-01")
+	s.AddAlbum("test_album_id_2", "test album 2", []string{"test_artist_id"}, "2001-01-01")
+	s.AddTrack("test_track_id_1", "test track 1", "test_album_id_1", 1)
+	s.AddTrack("test_track_id_2", "test track 2", "test_album_id_1", 2)
+	return s
+}
+
+func TestServer_Search(t *testing.T) {
+	s := newTestServer(t)
+	client := newTestClient(t, s)
+
+	result, err := client.Search(context.Background(), "test", spotify.SearchTypeArtist|spotify.SearchTypeAlbum|spotify.SearchTypeTrack, spotify.Limit(1))
+	if err != nil {
+		t.Fatalf("client.Search() error = %v", err)
+	}
+	if len(result.Artists.Artists) != 1 || result.Artists.Artists[0].ID != "test_artist_id" {
+		t.Errorf("client.Search() artists = %v, want test_artist_id", result.Artists.Artists)
+	}
+	if len(result.Albums.Albums) != 1 || result.Albums.Total != 2 || result.Albums.Next == "" {
+		t.Errorf("client.Search() albums = %v, want the first page of 2 albums", result.Albums)
+	}
+	if len(result.Tracks.Tracks) != 1 || result.Tracks.Tracks[0].Album.ID != "test_album_id_1" {
+		t.Errorf("client.Search() tracks = %v, want test_track_id_1 on test_album_id_1", result.Tracks.Tracks)
+	}
+	if err := client.NextAlbumResults(context.Background(), result); err != nil {
+		t.Errorf("client.NextAlbumResults() error = %v", err)
+	} else if len(result.Albums.Albums) != 1 || result.Albums.Albums[0].ID != "test_album_id_2" {
+		t.Errorf("client.NextAlbumResults() albums = %v, want test_album_id_2", result.Albums.Albums)
+	}
+}
+
+func TestServer_Get(t *testing.T) {
+	s := newTestServer(t)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	if artist, err := client.GetArtist(ctx, "test_artist_id"); err != nil || artist.Name != "test artist" {
+		t.Errorf("client.GetArtist() = %v, %v, want test artist", artist, err)
+	}
+	if albums, err := client.GetArtistAlbums(ctx, "test_artist_id", nil); err != nil || len(albums.Albums) != 2 {
+		t.Errorf("client.GetArtistAlbums() = %v, %v, want 2 albums", albums, err)
+	}
+	if album, err := client.GetAlbum(ctx, "test_album_id_1"); err != nil || album.ReleaseDateTime().Year() != 2000 || len(album.Tracks.Tracks) != 2 {
+		t.Errorf("client.GetAlbum() = %v, %v, want test album 1 with 2 tracks", album, err)
+	}
+	if tracks, err := client.GetAlbumTracks(ctx, "test_album_id_1", spotify.Limit(1), spotify.Offset(1)); err != nil || len(tracks.Tracks) != 1 || tracks.Tracks[0].ID != "test_track_id_2" {
+		t.Errorf("client.GetAlbumTracks() = %v, %v, want test_track_id_2", tracks, err)
+	}
+	if track, err := client.GetTrack(ctx, "test_track_id_1"); err != nil || track.TrackNumber != 1 {
+		t.Errorf("client.GetTrack() = %v, %v, want test track 1", track, err)
+	}
+
+	_, err := client.GetArtist(ctx, "unknown_artist_id")
+	var spotifyErr spotify.Error
+	if !errors.As(err, &spotifyErr) || spotifyErr.Status != http.StatusNotFound {
+		t.Errorf("client.GetArtist() error = %v, want 404", err)
+	}
+}
+
+func TestServer_Like(t *testing.T) {
+	s := newTestServer(t)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	if err := client.FollowArtist(ctx, "test_artist_id"); err != nil {
+		t.Errorf("client.FollowArtist() error = %v", err)
+	}
+	if err := client.AddAlbumsToLibrary(ctx, "test_album_id_1"); err != nil {
+		t.Errorf("client.AddAlbumsToLibrary() error = %v", err)
+	}
+	if err := client.AddTracksToLibrary(ctx, "test_track_id_1"); err != nil {
+		t.Errorf("client.AddTracksToLibrary() error = %v", err)
+	}
+	if follows, err := client.CurrentUserFollows(ctx, "artist", "test_artist_id"); err != nil || !follows[0] {
+		t.Errorf("client.CurrentUserFollows() = %v, %v, want true", follows, err)
+	}
+	if has, err := client.UserHasAlbums(ctx, "test_album_id_1", "test_album_id_2"); err != nil || !has[0] || has[1] {
+		t.Errorf("client.UserHasAlbums() = %v, %v, want [true false]", has, err)
+	}
+	if err := client.RemoveTracksFromLibrary(ctx, "test_track_id_1"); err != nil {
+		t.Errorf("client.RemoveTracksFromLibrary() error = %v", err)
+	}
+	if !s.IsLiked("artist", "test_artist_id") || !s.IsLiked("album", "test_album_id_1") || s.IsLiked("track", "test_track_id_1") {
+		t.Errorf("Server.IsLiked() does not reflect the requests")
+	}
+	if err := client.AddTracksToLibrary(ctx, "unknown_track_id"); err == nil {
+		t.Errorf("client.AddTracksToLibrary() error = nil, want the error for the unknown ID")
+	}
+}
+
+func TestServer_CurrentUser(t *testing.T) {
+	s := newTestServer(t)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	if user, err := client.CurrentUser(ctx); err != nil || user.ID != UserID {
+		t.Errorf("client.CurrentUser() = %v, %v, want %v", user, err, UserID)
+	}
+	s.SetUser("test_other_user_id")
+	if user, err := client.CurrentUser(ctx); err != nil || user.ID != "test_other_user_id" {
+		t.Errorf("client.CurrentUser() = %v, %v, want test_other_user_id", user, err)
+	}
+}
+
+func TestServer_FollowedArtists(t *testing.T) {
+	s := newTestServer(t)
+	s.AddArtist("test_artist_id_2", "test artist 2")
+	s.AddArtist("test_artist_id_3", "test artist 3")
+	s.SetLiked("artist", "test_artist_id", true)
+	s.SetLiked("artist", "test_artist_id_3", true)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	first, err := client.CurrentUsersFollowedArtists(ctx, spotify.Limit(1))
+	if err != nil || len(first.Artists) != 1 || first.Artists[0].ID != "test_artist_id" || first.Total != 2 || first.Cursor.After != "test_artist_id" {
+		t.Errorf("client.CurrentUsersFollowedArtists() = %v, %v, want the first followed artist", first, err)
+		return
+	}
+	second, err := client.CurrentUsersFollowedArtists(ctx, spotify.Limit(1), spotify.After(first.Cursor.After))
+	if err != nil || len(second.Artists) != 1 || second.Artists[0].ID != "test_artist_id_3" || second.Next != "" || second.Cursor.After != "" {
+		t.Errorf("client.CurrentUsersFollowedArtists() = %v, %v, want the last followed artist", second, err)
+	}
+}
+
+func TestServer_SavedContents(t *testing.T) {
+	s := newTestServer(t)
+	s.SetLiked("album", "test_album_id_2", true)
+	s.SetLiked("track", "test_track_id_1", true)
+	s.SetLiked("track", "test_track_id_2", true)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	albums, err := client.CurrentUsersAlbums(ctx)
+	if err != nil || len(albums.Albums) != 1 || albums.Albums[0].ID != "test_album_id_2" || albums.Albums[0].AddedAt != likedAt {
+		t.Errorf("client.CurrentUsersAlbums() = %v, %v, want the liked album", albums, err)
+	}
+	first, err := client.CurrentUsersTracks(ctx, spotify.Limit(1))
+	if err != nil || len(first.Tracks) != 1 || first.Tracks[0].ID != "test_track_id_1" || first.Total != 2 || first.Next == "" {
+		t.Errorf("client.CurrentUsersTracks() = %v, %v, want the first liked track", first, err)
+		return
+	}
+	second, err := client.CurrentUsersTracks(ctx, spotify.Limit(1), spotify.Offset(1))
+	if err != nil || len(second.Tracks) != 1 || second.Tracks[0].ID != "test_track_id_2" || second.Next != "" {
+		t.Errorf("client.CurrentUsersTracks() = %v, %v, want the last liked track", second, err)
+	}
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	s := newTestServer(t)
+	client := newTestClient(t, s)
+	ctx := context.Background()
+
+	s.InjectFault(http.StatusTooManyRequests, 1)
+	s.InjectFault(http.StatusInternalServerError, 1)
+	var spotifyErr spotify.Error
+	if _, err := client.GetArtist(ctx, "test_artist_id"); !errors.As(err, &spotifyErr) || spotifyErr.Status != http.StatusTooManyRequests {
+		t.Errorf("client.GetArtist() error = %v, want 429", err)
+	}
+	if _, err := client.GetArtist(ctx, "test_artist_id"); !errors.As(err, &spotifyErr) || spotifyErr.Status != http.StatusInternalServerError {
+		t.Errorf("client.GetArtist() error = %v, want 500", err)
+	}
+	if _, err := client.GetArtist(ctx, "test_artist_id"); err != nil {
+		t.Errorf("client.GetArtist() error = %v, want nil", err)
+	}
+	if got := len(s.Requests()); got != 3 {
+		t.Errorf("Server.Requests() length = %v, want 3", got)
+	}
+}
+
+func TestServer_Token(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	s.SetTokenLifetime(time.Second)
+	client := newTestClient(t, s)
+	for range 2 {
+		if _, err := client.GetArtist(ctx, "test_artist_id"); err != nil {
+			t.Errorf("client.GetArtist() error = %v", err)
+		}
+	}
+	if got := s.TokenRequests(); got != 2 {
+		t.Errorf("Server.TokenRequests() = %v, want 2 (the short-lived token is refreshed)", got)
+	}
+
+	s.SetRefreshToken("another_refresh_token")
+	if _, err := newTestClient(t, s).GetArtist(ctx, "test_artist_id"); err == nil {
+		t.Errorf("client.GetArtist() error = nil, want the error for the invalid refresh token")
+	}
+
+	s.SetLatency(50 * time.Millisecond)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetArtist(timeoutCtx, "test_artist_id"); err == nil {
+		t.Errorf("client.GetArtist() error = nil, want the error for the timeout")
+	}
+}
+
+func TestServer_Authorize(t *testing.T) {
+	s := newTestServer(t)
+	httpClient := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := httpClient.Get(s.AccountsBaseUrl() + "/authorize?redirect_uri=http://localhost:8080/callback&state=test_state")
+	if err != nil {
+		t.Fatalf("http.Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get("Location"); got != "http://localhost:8080/callback?code="+AuthorizationCode+"&state=test_state" {
+		t.Errorf("authorize redirect = %v", got)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	// the channel cannot be encoded as JSON
+	writeJson(&responseWriter{ResponseWriter: httptest.NewRecorder(), server: s}, http.StatusOK, make(chan int))
+	if got := s.Errors(); len(got) != 1 {
+		t.Errorf("Server.Errors() = %v, want the failure to write the response", got)
+	}
+}